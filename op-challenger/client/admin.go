@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	opclient "github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AdminClient is a typed client for the op-challenger admin RPC API.
+type AdminClient struct {
+	rpc opclient.RPC
+}
+
+func NewAdminClient(rpc opclient.RPC) *AdminClient {
+	return &AdminClient{rpc}
+}
+
+// DialAdminClient connects to the op-challenger admin RPC server at the specified URL.
+// To connect over a Unix domain socket, prefix the socket path with [SocketScheme].
+func DialAdminClient(ctx context.Context, url string) (*AdminClient, error) {
+	rpcCl, err := dialRPC(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial admin RPC %v: %w", url, err)
+	}
+	return NewAdminClient(opclient.NewBaseRPCClient(rpcCl)), nil
+}
+
+func (c *AdminClient) ListGames(ctx context.Context) ([]rpc.GameInfo, error) {
+	var games []rpc.GameInfo
+	err := c.rpc.CallContext(ctx, &games, "admin_listGames")
+	return games, err
+}
+
+func (c *AdminClient) RecentResolutions(ctx context.Context) ([]rpc.GameResolution, error) {
+	var resolutions []rpc.GameResolution
+	err := c.rpc.CallContext(ctx, &resolutions, "admin_recentResolutions")
+	return resolutions, err
+}
+
+func (c *AdminClient) Wallet(ctx context.Context) (rpc.Wallet, error) {
+	var wallet rpc.Wallet
+	err := c.rpc.CallContext(ctx, &wallet, "admin_wallet")
+	return wallet, err
+}
+
+func (c *AdminClient) Claims(ctx context.Context, game common.Address) ([]rpc.Claim, error) {
+	var claims []rpc.Claim
+	err := c.rpc.CallContext(ctx, &claims, "admin_claims", game)
+	return claims, err
+}
+
+func (c *AdminClient) PauseScheduler(ctx context.Context) error {
+	return c.rpc.CallContext(ctx, nil, "admin_pauseScheduler")
+}
+
+func (c *AdminClient) ResumeScheduler(ctx context.Context) error {
+	return c.rpc.CallContext(ctx, nil, "admin_resumeScheduler")
+}
+
+func (c *AdminClient) SchedulerPaused(ctx context.Context) (bool, error) {
+	var paused bool
+	err := c.rpc.CallContext(ctx, &paused, "admin_schedulerPaused")
+	return paused, err
+}
+
+// ResolveGame resolves the game, returning the resulting game status.
+func (c *AdminClient) ResolveGame(ctx context.Context, game common.Address) (types.GameStatus, error) {
+	var status types.GameStatus
+	err := c.rpc.CallContext(ctx, &status, "admin_resolveGame", game)
+	return status, err
+}
+
+// Diagnostics returns the diagnostic bundles recorded for claims in the game that the challenger disagrees with.
+func (c *AdminClient) Diagnostics(ctx context.Context, game common.Address) ([]diagnostics.Bundle, error) {
+	var bundles []diagnostics.Bundle
+	err := c.rpc.CallContext(ctx, &bundles, "admin_diagnostics", game)
+	return bundles, err
+}
+
+func (c *AdminClient) Close() {
+	c.rpc.Close()
+}
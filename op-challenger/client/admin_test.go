@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminClient(t *testing.T) {
+	game := common.Address{0xaa}
+	ctx := context.Background()
+
+	t.Run("ListGames", func(t *testing.T) {
+		expected := []rpc.GameInfo{{Address: game, ClaimCount: 3, BondedValue: (*hexutil.Big)(big.NewInt(7))}}
+		stub, client := setupClient(expected)
+		games, err := client.ListGames(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, games)
+		stub.requireCall(t, "admin_listGames")
+	})
+
+	t.Run("RecentResolutions", func(t *testing.T) {
+		expected := []rpc.GameResolution{{Address: game, Status: types.GameStatusChallengerWon, Won: true}}
+		stub, client := setupClient(expected)
+		resolutions, err := client.RecentResolutions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, resolutions)
+		stub.requireCall(t, "admin_recentResolutions")
+	})
+
+	t.Run("Wallet", func(t *testing.T) {
+		expected := rpc.Wallet{Address: common.Address{0xcc}, Balance: (*hexutil.Big)(big.NewInt(100))}
+		stub, client := setupClient(expected)
+		wallet, err := client.Wallet(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, wallet)
+		stub.requireCall(t, "admin_wallet")
+	})
+
+	t.Run("Claims", func(t *testing.T) {
+		expected := []rpc.Claim{{Index: 1, Value: common.Hash{0x01}, Position: (*hexutil.Big)(big.NewInt(2)), Depth: 1}}
+		stub, client := setupClient(expected)
+		claims, err := client.Claims(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, expected, claims)
+		stub.requireCall(t, "admin_claims", game)
+	})
+
+	t.Run("PauseScheduler", func(t *testing.T) {
+		stub, client := setupClient(nil)
+		require.NoError(t, client.PauseScheduler(ctx))
+		stub.requireCall(t, "admin_pauseScheduler")
+	})
+
+	t.Run("ResumeScheduler", func(t *testing.T) {
+		stub, client := setupClient(nil)
+		require.NoError(t, client.ResumeScheduler(ctx))
+		stub.requireCall(t, "admin_resumeScheduler")
+	})
+
+	t.Run("SchedulerPaused", func(t *testing.T) {
+		stub, client := setupClient(true)
+		paused, err := client.SchedulerPaused(ctx)
+		require.NoError(t, err)
+		require.True(t, paused)
+		stub.requireCall(t, "admin_schedulerPaused")
+	})
+
+	t.Run("ResolveGame", func(t *testing.T) {
+		stub, client := setupClient(types.GameStatusDefenderWon)
+		status, err := client.ResolveGame(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusDefenderWon, status)
+		stub.requireCall(t, "admin_resolveGame", game)
+	})
+
+	t.Run("Diagnostics", func(t *testing.T) {
+		expected := []diagnostics.Bundle{{Game: game, ClaimIndex: 2, Position: (*hexutil.Big)(big.NewInt(4)), TheirValue: common.Hash{0x01}, OurValue: common.Hash{0x02}}}
+		stub, client := setupClient(expected)
+		bundles, err := client.Diagnostics(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, expected, bundles)
+		stub.requireCall(t, "admin_diagnostics", game)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		stub, client := setupClient(nil)
+		stub.err = errors.New("boom")
+		_, err := client.ResolveGame(ctx, game)
+		require.ErrorIs(t, err, stub.err)
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		stub, client := setupClient(nil)
+		client.Close()
+		require.True(t, stub.closed)
+	})
+}
+
+func setupClient(result any) (*stubRPC, *AdminClient) {
+	stub := &stubRPC{result: result}
+	return stub, NewAdminClient(stub)
+}
+
+type stubRPC struct {
+	result any
+	err    error
+	method string
+	args   []any
+	closed bool
+}
+
+func (s *stubRPC) requireCall(t *testing.T, method string, args ...any) {
+	require.Equal(t, method, s.method)
+	require.Equal(t, len(args), len(s.args))
+	for i, arg := range args {
+		require.Equal(t, arg, s.args[i])
+	}
+}
+
+func (s *stubRPC) Close() {
+	s.closed = true
+}
+
+func (s *stubRPC) CallContext(_ context.Context, result any, method string, args ...any) error {
+	s.method = method
+	s.args = args
+	if s.err != nil {
+		return s.err
+	}
+	if result == nil {
+		return nil
+	}
+	// Round trip through JSON to match the behaviour of a real RPC client
+	data, err := json.Marshal(s.result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func (s *stubRPC) BatchCallContext(_ context.Context, _ []gethrpc.BatchElem) error {
+	panic("not implemented")
+}
+
+func (s *stubRPC) EthSubscribe(_ context.Context, _ any, _ ...any) (ethereum.Subscription, error) {
+	panic("not implemented")
+}
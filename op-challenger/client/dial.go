@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// SocketScheme is the URL scheme used to connect to an op-challenger RPC server on a Unix domain socket.
+// For example, unix:///var/run/op-challenger.sock connects to the socket at /var/run/op-challenger.sock.
+const SocketScheme = "unix://"
+
+// dialRPC connects to the op-challenger RPC server at url, which is either a HTTP URL or the path of a Unix domain
+// socket prefixed with [SocketScheme].
+// The server speaks HTTP JSON-RPC on its socket, so the socket can't be dialled as a geth IPC endpoint.
+func dialRPC(ctx context.Context, url string) (*gethrpc.Client, error) {
+	path, ok := strings.CutPrefix(url, SocketScheme)
+	if !ok {
+		return gethrpc.DialContext(ctx, url)
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", path)
+			},
+		},
+	}
+	// The host is ignored as all connections are made to the socket.
+	return gethrpc.DialOptions(ctx, "http://localhost", gethrpc.WithHTTPClient(httpClient))
+}
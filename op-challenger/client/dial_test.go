@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialSocket(t *testing.T) {
+	dir, err := os.MkdirTemp("", "client")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	path := filepath.Join(dir, "challenger.sock")
+
+	expected := rpc.Verdict{Game: common.Address{0xaa}, Position: (*hexutil.Big)(big.NewInt(1)), Agree: true}
+	server := rpc.NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+	require.NoError(t, server.EnableVerifyAPI(&stubVerifier{verdict: expected}))
+	server.EnableSocket(path, 0600)
+	server.DisableTCP()
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+
+	client, err := DialVerifyClient(context.Background(), SocketScheme+path)
+	require.NoError(t, err)
+	defer client.Close()
+	verdict, err := client.Claim(context.Background(), common.Address{0xaa}, 0)
+	require.NoError(t, err)
+	require.Equal(t, expected, verdict)
+}
+
+type stubVerifier struct {
+	verdict rpc.Verdict
+}
+
+func (s *stubVerifier) VerifyClaim(_ context.Context, _ common.Address, _ uint64) (rpc.Verdict, error) {
+	return s.verdict, nil
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	opclient "github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerifyClient is a typed client for the op-challenger verify RPC API.
+type VerifyClient struct {
+	rpc opclient.RPC
+}
+
+func NewVerifyClient(rpc opclient.RPC) *VerifyClient {
+	return &VerifyClient{rpc}
+}
+
+// DialVerifyClient connects to the op-challenger verify RPC server at the specified URL.
+// To connect over a Unix domain socket, prefix the socket path with [SocketScheme].
+func DialVerifyClient(ctx context.Context, url string) (*VerifyClient, error) {
+	rpcCl, err := dialRPC(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial verify RPC %v: %w", url, err)
+	}
+	return NewVerifyClient(opclient.NewBaseRPCClient(rpcCl)), nil
+}
+
+// Claim returns the challenger's verdict on the claim at claimIndex in the game.
+func (c *VerifyClient) Claim(ctx context.Context, game common.Address, claimIndex uint64) (rpc.Verdict, error) {
+	var verdict rpc.Verdict
+	err := c.rpc.CallContext(ctx, &verdict, "verify_claim", game, claimIndex)
+	return verdict, err
+}
+
+func (c *VerifyClient) Close() {
+	c.rpc.Close()
+}
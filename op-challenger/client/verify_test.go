@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyClient(t *testing.T) {
+	game := common.Address{0xaa}
+	ctx := context.Background()
+
+	t.Run("Claim", func(t *testing.T) {
+		expected := rpc.Verdict{
+			Game:          game,
+			ClaimIndex:    2,
+			Value:         common.Hash{0x01},
+			Position:      (*hexutil.Big)(big.NewInt(5)),
+			Depth:         2,
+			TraceIndex:    3,
+			ExpectedValue: common.Hash{0x01},
+			Agree:         true,
+		}
+		stub := &stubRPC{result: expected}
+		client := NewVerifyClient(stub)
+		verdict, err := client.Claim(ctx, game, 2)
+		require.NoError(t, err)
+		require.Equal(t, expected, verdict)
+		stub.requireCall(t, "verify_claim", game, uint64(2))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		stub := &stubRPC{err: errors.New("boom")}
+		client := NewVerifyClient(stub)
+		_, err := client.Claim(ctx, game, 2)
+		require.ErrorIs(t, err, stub.err)
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		stub := &stubRPC{}
+		NewVerifyClient(stub).Close()
+		require.True(t, stub.closed)
+	})
+}
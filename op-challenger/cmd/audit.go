@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+)
+
+var (
+	auditDatadirFlag = &cli.StringFlag{
+		Name:     "datadir",
+		Usage:    "Datadir of the op-challenger to export the audit log of.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "DATADIR"),
+		Required: true,
+	}
+	auditOutputFlag = &cli.StringFlag{
+		Name:    "output",
+		Usage:   "File to write the exported audit log to. If not set, the log is written to stdout.",
+		EnvVars: opservice.PrefixEnvVar("OP_CHALLENGER", "AUDIT_OUTPUT"),
+	}
+	auditGameFlag = &cli.StringFlag{
+		Name:    "game",
+		Usage:   "Address of a dispute game to export the entries of. If not set, all entries are exported.",
+		EnvVars: opservice.PrefixEnvVar("OP_CHALLENGER", "AUDIT_GAME"),
+	}
+)
+
+// AuditExportCommand verifies the audit log in a challenger's datadir and exports its entries as JSON.
+var AuditExportCommand = &cli.Command{
+	Name:  "audit-export",
+	Usage: "Verify and export the audit log of actions taken by the challenger",
+	Description: "Reads the audit log from the datadir, verifies the hash chain and any signatures of its entries " +
+		"and writes them as a JSON array. Fails without writing any entries if the log has been modified.",
+	Flags: []cli.Flag{
+		auditDatadirFlag,
+		auditOutputFlag,
+		auditGameFlag,
+	},
+	Action: auditExport,
+}
+
+func auditExport(ctx *cli.Context) error {
+	var game *common.Address
+	if ctx.IsSet(auditGameFlag.Name) {
+		value := ctx.String(auditGameFlag.Name)
+		if !common.IsHexAddress(value) {
+			return fmt.Errorf("invalid game address: %v", value)
+		}
+		addr := common.HexToAddress(value)
+		game = &addr
+	}
+	path := filepath.Join(ctx.String(auditDatadirFlag.Name), audit.DirName, audit.FileName)
+	in, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no audit log found at %v", path)
+	} else if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer in.Close()
+	entries, err := exportAudit(in, game)
+	if err != nil {
+		return err
+	}
+
+	out := ctx.App.Writer
+	if ctx.IsSet(auditOutputFlag.Name) {
+		f, err := os.Create(ctx.String(auditOutputFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// exportAudit reads and verifies the audit log, returning the entries for game or all entries if game is nil.
+// The whole log is verified even when filtering by game as the hash chain covers every entry.
+func exportAudit(in io.Reader, game *common.Address) ([]audit.Entry, error) {
+	entries, err := audit.Read(in)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit log: %w", err)
+	}
+	result := make([]audit.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if game == nil || entry.Game == *game {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditExportRequiresDatadir(t *testing.T) {
+	verifyArgsInvalid(t, "\"datadir\"", []string{"audit-export"})
+}
+
+func TestAuditExport(t *testing.T) {
+	gameA := common.Address{0xaa}
+	gameB := common.Address{0xbb}
+	datadir := t.TempDir()
+	auditLog, err := audit.Open(testlog.Logger(t, log.LvlInfo), clock.SystemClock, filepath.Join(datadir, audit.DirName), nil)
+	require.NoError(t, err)
+	auditLog.Record(audit.Entry{Action: audit.ActionMove, Game: gameA})
+	auditLog.Record(audit.Entry{Action: audit.ActionMove, Game: gameB})
+	auditLog.Record(audit.Entry{Action: audit.ActionResolve, Game: gameA})
+
+	export := func(t *testing.T, args ...string) []audit.Entry {
+		output := filepath.Join(t.TempDir(), "export.json")
+		_, _, err := runWithArgs(append([]string{"audit-export", "--datadir", datadir, "--output", output}, args...))
+		require.NoError(t, err)
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		var entries []audit.Entry
+		require.NoError(t, json.Unmarshal(data, &entries))
+		return entries
+	}
+
+	t.Run("AllEntries", func(t *testing.T) {
+		entries := export(t)
+		require.Len(t, entries, 3)
+		require.Equal(t, audit.ActionResolve, entries[2].Action)
+	})
+
+	t.Run("FilterByGame", func(t *testing.T) {
+		entries := export(t, "--game", gameA.Hex())
+		require.Len(t, entries, 2)
+		for _, entry := range entries {
+			require.Equal(t, gameA, entry.Game)
+		}
+	})
+
+	t.Run("InvalidGame", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid game address", []string{"audit-export", "--datadir", datadir, "--game", "foo"})
+	})
+
+	t.Run("NoLog", func(t *testing.T) {
+		verifyArgsInvalid(t, "no audit log found", []string{"audit-export", "--datadir", t.TempDir()})
+	})
+}
+
+func TestExportAuditRejectsModifiedLog(t *testing.T) {
+	dir := t.TempDir()
+	auditLog, err := audit.Open(testlog.Logger(t, log.LvlInfo), clock.SystemClock, dir, nil)
+	require.NoError(t, err)
+	auditLog.Record(audit.Entry{Action: audit.ActionMove, Game: common.Address{0xaa}})
+	data, err := os.ReadFile(filepath.Join(dir, audit.FileName))
+	require.NoError(t, err)
+
+	modified := bytes.Replace(data, []byte(audit.ActionMove), []byte(audit.ActionStep), 1)
+	_, err = exportAudit(bytes.NewReader(modified), nil)
+	require.ErrorIs(t, err, audit.ErrInvalidHash)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/client"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+// clearScreen is the ANSI escape sequence to move the cursor to the top left and clear the terminal.
+const clearScreen = "\033[H\033[2J"
+
+var (
+	dashboardAdminRpcFlag = &cli.StringFlag{
+		Name:    "admin-rpc",
+		Usage:   "HTTP URL of the op-challenger admin RPC server to connect to, or unix:// followed by the path of its socket.",
+		Value:   "http://127.0.0.1:8545",
+		EnvVars: opservice.PrefixEnvVar("OP_CHALLENGER", "ADMIN_RPC"),
+	}
+	dashboardRefreshFlag = &cli.DurationFlag{
+		Name:    "refresh",
+		Usage:   "Interval between refreshes of the dashboard.",
+		Value:   5 * time.Second,
+		EnvVars: opservice.PrefixEnvVar("OP_CHALLENGER", "DASHBOARD_REFRESH"),
+	}
+)
+
+// DashboardCommand renders a continuously updating terminal view of a running op-challenger,
+// loaded from its admin RPC server.
+var DashboardCommand = &cli.Command{
+	Name:  "dashboard",
+	Usage: "Display the in progress games, pending moves, wallet balance and recent resolutions of a running op-challenger",
+	Description: "Connects to the admin RPC server of a running op-challenger, which must be started with --" +
+		rpc.EnableAdminFlagName + ", and redraws a summary of its state until interrupted.",
+	Flags: []cli.Flag{
+		dashboardAdminRpcFlag,
+		dashboardRefreshFlag,
+	},
+	Action: dashboard,
+}
+
+type dashboardData struct {
+	Wallet      rpc.Wallet
+	Games       []rpc.GameInfo
+	Resolutions []rpc.GameResolution
+}
+
+func dashboard(ctx *cli.Context) error {
+	refresh := ctx.Duration(dashboardRefreshFlag.Name)
+	if refresh <= 0 {
+		return fmt.Errorf("%v must be greater than 0", dashboardRefreshFlag.Name)
+	}
+	url := ctx.String(dashboardAdminRpcFlag.Name)
+	adminClient, err := client.DialAdminClient(ctx.Context, url)
+	if err != nil {
+		return err
+	}
+	defer adminClient.Close()
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	out := ctx.App.Writer
+	for {
+		data, err := fetchDashboard(runCtx, adminClient)
+		fmt.Fprint(out, clearScreen)
+		if err != nil {
+			fmt.Fprintf(out, "Failed to load op-challenger status from %v: %v\n", url, err)
+		} else if err := renderDashboard(out, data, time.Now()); err != nil {
+			return err
+		}
+		select {
+		case <-runCtx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchDashboard(ctx context.Context, adminClient *client.AdminClient) (dashboardData, error) {
+	var data dashboardData
+	var err error
+	if data.Wallet, err = adminClient.Wallet(ctx); err != nil {
+		return dashboardData{}, fmt.Errorf("failed to fetch wallet: %w", err)
+	}
+	if data.Games, err = adminClient.ListGames(ctx); err != nil {
+		return dashboardData{}, fmt.Errorf("failed to fetch games: %w", err)
+	}
+	if data.Resolutions, err = adminClient.RecentResolutions(ctx); err != nil {
+		return dashboardData{}, fmt.Errorf("failed to fetch recent resolutions: %w", err)
+	}
+	return data, nil
+}
+
+// renderDashboard writes a summary of the challenger state to out, with times relative to now.
+func renderDashboard(out io.Writer, data dashboardData, now time.Time) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "op-challenger dashboard\t%v\n", now.UTC().Format(time.RFC3339))
+	fmt.Fprintf(w, "Wallet\t%v\n", data.Wallet.Address)
+	fmt.Fprintf(w, "Balance\t%v ETH\n\n", formatEther(data.Wallet.Balance.ToInt()))
+
+	fmt.Fprintf(w, "IN PROGRESS GAMES (%d)\n", len(data.Games))
+	fmt.Fprintln(w, "GAME\tCLAIMS\tTIME REMAINING\tPENDING MOVES\tBONDED (ETH)\tLAST UPDATED")
+	for _, game := range data.Games {
+		claims := fmt.Sprintf("%d", game.ClaimCount)
+		if game.PendingClaims > 0 {
+			claims = fmt.Sprintf("%d (+%d pending)", game.ClaimCount, game.PendingClaims)
+		}
+		remaining := formatRemaining(time.Unix(int64(game.Deadline), 0).Sub(now))
+		if game.Pending {
+			remaining = "pending confirmation"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%d\t%v\t%v ago\n",
+			game.Address,
+			claims,
+			remaining,
+			game.PendingMoves,
+			formatEther(game.BondedValue.ToInt()),
+			formatAge(now.Sub(time.Unix(int64(game.UpdatedAt), 0))))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "RECENT RESOLUTIONS (%d)\n", len(data.Resolutions))
+	fmt.Fprintln(w, "GAME\tSTATUS\tRESULT\tRESOLVED")
+	for _, resolution := range data.Resolutions {
+		result := "LOST"
+		if resolution.Won {
+			result = "won"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v ago\n",
+			resolution.Address,
+			resolution.Status,
+			result,
+			formatAge(now.Sub(time.Unix(int64(resolution.ResolvedAt), 0))))
+	}
+	return w.Flush()
+}
+
+func formatEther(wei *big.Int) string {
+	if wei == nil {
+		return "0"
+	}
+	return fmt.Sprintf("%.4f", opmetrics.WeiToEther(wei))
+}
+
+func formatRemaining(d time.Duration) string {
+	if d <= 0 {
+		return "expired"
+	}
+	return d.Round(time.Second).String()
+}
+
+func formatAge(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return d.Round(time.Second).String()
+}
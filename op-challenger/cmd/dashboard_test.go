@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/client"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardInvalidRefresh(t *testing.T) {
+	verifyArgsInvalid(t, "refresh must be greater than 0", []string{"dashboard", "--refresh=0s"})
+}
+
+func TestFetchDashboard(t *testing.T) {
+	status := &stubChallengerStatus{
+		games: []rpc.GameInfo{{
+			Address:     common.Address{0xaa},
+			ClaimCount:  4,
+			Deadline:    2000,
+			BondedValue: (*hexutil.Big)(big.NewInt(100)),
+		}},
+		resolutions: []rpc.GameResolution{{Address: common.Address{0xbb}, Status: types.GameStatusChallengerWon, Won: true}},
+		wallet:      rpc.Wallet{Address: common.Address{0xcc}, Balance: (*hexutil.Big)(big.NewInt(5))},
+	}
+	server := rpc.NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+	require.NoError(t, server.EnableAdminAPI(status))
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+	adminClient, err := client.DialAdminClient(context.Background(), "http://"+server.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	data, err := fetchDashboard(context.Background(), adminClient)
+	require.NoError(t, err)
+	require.Equal(t, dashboardData{
+		Wallet:      status.wallet,
+		Games:       status.games,
+		Resolutions: status.resolutions,
+	}, data)
+}
+
+func TestRenderDashboard(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	data := dashboardData{
+		Wallet: rpc.Wallet{Address: common.Address{0xcc}, Balance: (*hexutil.Big)(big.NewInt(1_500_000_000_000_000_000))},
+		Games: []rpc.GameInfo{
+			{
+				Address:       common.Address{0xaa},
+				ClaimCount:    7,
+				Deadline:      uint64(now.Add(90 * time.Minute).Unix()),
+				PendingMoves:  2,
+				BondedValue:   (*hexutil.Big)(big.NewInt(250_000_000_000_000_000)),
+				UpdatedAt:     uint64(now.Add(-12 * time.Second).Unix()),
+				PendingClaims: 3,
+			},
+			{
+				Address:   common.Address{0xab},
+				Deadline:  uint64(now.Add(-time.Minute).Unix()),
+				UpdatedAt: uint64(now.Unix()),
+			},
+			{
+				Address:   common.Address{0xac},
+				UpdatedAt: uint64(now.Unix()),
+				Pending:   true,
+			},
+		},
+		Resolutions: []rpc.GameResolution{
+			{Address: common.Address{0xbb}, Status: types.GameStatusChallengerWon, Won: true, ResolvedAt: uint64(now.Add(-time.Hour).Unix())},
+			{Address: common.Address{0xbc}, Status: types.GameStatusDefenderWon, Won: false, ResolvedAt: uint64(now.Unix())},
+		},
+	}
+	var out bytes.Buffer
+	require.NoError(t, renderDashboard(&out, data, now))
+	text := out.String()
+	require.Contains(t, text, data.Wallet.Address.Hex())
+	require.Contains(t, text, "1.5000 ETH")
+	require.Contains(t, text, "IN PROGRESS GAMES (3)")
+	require.Contains(t, text, common.Address{0xaa}.Hex())
+	require.Contains(t, text, "7 (+3 pending)")
+	require.Contains(t, text, "pending confirmation")
+	require.Contains(t, text, "1h30m0s")
+	require.Contains(t, text, "0.2500")
+	require.Contains(t, text, "12s ago")
+	require.Contains(t, text, "expired")
+	require.Contains(t, text, "RECENT RESOLUTIONS (2)")
+	require.Contains(t, text, types.GameStatusChallengerWon.String())
+	require.Contains(t, text, "1h0m0s ago")
+	require.Contains(t, text, "LOST")
+}
+
+type stubChallengerStatus struct {
+	games       []rpc.GameInfo
+	resolutions []rpc.GameResolution
+	wallet      rpc.Wallet
+}
+
+func (s *stubChallengerStatus) Games() []rpc.GameInfo {
+	return s.games
+}
+
+func (s *stubChallengerStatus) RecentResolutions() []rpc.GameResolution {
+	return s.resolutions
+}
+
+func (s *stubChallengerStatus) Wallet(_ context.Context) (rpc.Wallet, error) {
+	return s.wallet, nil
+}
+
+func (s *stubChallengerStatus) Claims(_ context.Context, _ common.Address) ([]rpc.Claim, error) {
+	return nil, nil
+}
+
+func (s *stubChallengerStatus) PauseScheduler() {}
+
+func (s *stubChallengerStatus) ResumeScheduler() {}
+
+func (s *stubChallengerStatus) SchedulerPaused() bool {
+	return false
+}
+
+func (s *stubChallengerStatus) ResolveGame(_ context.Context, _ common.Address) (types.GameStatus, error) {
+	return types.GameStatusInProgress, nil
+}
+
+func (s *stubChallengerStatus) Diagnostics(_ context.Context, _ common.Address) ([]diagnostics.Bundle, error) {
+	return nil, nil
+}
@@ -66,6 +66,12 @@ func run(args []string, action ConfigAction) error {
 		}
 		return action(ctx.Context, logger, cfg)
 	}
+	app.Commands = []*cli.Command{
+		ValidatePrestateCommand,
+		DashboardCommand,
+		TraceCommand,
+		AuditExportCommand,
+	}
 	return app.Run(args)
 }
 
@@ -3,10 +3,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"os"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -45,17 +49,17 @@ func TestLogLevel(t *testing.T) {
 
 func TestDefaultCLIOptionsMatchDefaultConfig(t *testing.T) {
 	cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
-	defaultCfg := config.NewConfig(common.HexToAddress(gameFactoryAddressValue), l1EthRpc, config.TraceTypeAlphabet, true, datadir)
+	defaultCfg := config.NewConfig(common.HexToAddress(gameFactoryAddressValue), l1EthRpc, true, datadir, config.TraceTypeAlphabet)
 	// Add in the extra CLI options required when using alphabet trace type
-	defaultCfg.AlphabetTrace = alphabetTrace
+	defaultCfg.Alphabet.Trace = alphabetTrace
 	require.Equal(t, defaultCfg, cfg)
 }
 
 func TestDefaultConfigIsValid(t *testing.T) {
-	cfg := config.NewConfig(common.HexToAddress(gameFactoryAddressValue), l1EthRpc, config.TraceTypeAlphabet, true, datadir)
+	cfg := config.NewConfig(common.HexToAddress(gameFactoryAddressValue), l1EthRpc, true, datadir, config.TraceTypeAlphabet)
 	// Add in options that are required based on the specific trace type
 	// To avoid needing to specify unused options, these aren't included in the params for NewConfig
-	cfg.AlphabetTrace = alphabetTrace
+	cfg.Alphabet.Trace = alphabetTrace
 	require.NoError(t, cfg.Check())
 }
 
@@ -81,13 +85,34 @@ func TestTraceType(t *testing.T) {
 		traceType := traceType
 		t.Run("Valid_"+traceType.String(), func(t *testing.T) {
 			cfg := configForArgs(t, addRequiredArgs(traceType))
-			require.Equal(t, traceType, cfg.TraceType)
+			require.Equal(t, []config.TraceType{traceType}, cfg.TraceTypes)
 		})
 	}
 
+	t.Run("Multiple", func(t *testing.T) {
+		args := requiredArgs(config.TraceTypeCannon)
+		for name, value := range requiredArgs(config.TraceTypeAlphabet) {
+			args[name] = value
+		}
+		delete(args, "--trace-type")
+		cfg := configForArgs(t, append(toArgList(args), "--trace-type=cannon", "--trace-type=alphabet"))
+		require.Equal(t, []config.TraceType{config.TraceTypeCannon, config.TraceTypeAlphabet}, cfg.TraceTypes)
+		require.Equal(t, alphabetTrace, cfg.Alphabet.Trace)
+		require.Equal(t, cannonBin, cfg.Cannon.Bin)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("MultipleRequiresEachTypesFlags", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag cannon-network or cannon-rollup-config and cannon-l2-genesis is required", addRequiredArgs(config.TraceTypeAlphabet, "--trace-type=cannon"))
+	})
+
 	t.Run("Invalid", func(t *testing.T) {
 		verifyArgsInvalid(t, "unknown trace type: \"foo\"", addRequiredArgsExcept(config.TraceTypeAlphabet, "--trace-type", "--trace-type=foo"))
 	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		verifyArgsInvalid(t, "duplicate trace type: \"alphabet\"", addRequiredArgs(config.TraceTypeAlphabet, "--trace-type=alphabet"))
+	})
 }
 
 func TestGameFactoryAddress(t *testing.T) {
@@ -157,7 +182,7 @@ func TestMaxConcurrency(t *testing.T) {
 	t.Run("Invalid", func(t *testing.T) {
 		verifyArgsInvalid(
 			t,
-			"invalid value \"abc\" for flag -max-concurrency",
+			"invalid max-concurrency: abc",
 			addRequiredArgs(config.TraceTypeAlphabet, "--max-concurrency", "abc"))
 	})
 
@@ -167,6 +192,73 @@ func TestMaxConcurrency(t *testing.T) {
 			"max-concurrency must not be 0",
 			addRequiredArgs(config.TraceTypeAlphabet, "--max-concurrency", "0"))
 	})
+
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, uint(runtime.NumCPU()), cfg.MaxConcurrency)
+		require.False(t, cfg.AutoConcurrency)
+	})
+
+	t.Run("Auto", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--max-concurrency", "auto"))
+		require.Equal(t, uint(runtime.NumCPU()), cfg.MaxConcurrency)
+		require.True(t, cfg.AutoConcurrency)
+	})
+}
+
+func TestMaxScheduledGames(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.MaxScheduledGames)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--max-scheduled-games=20"))
+		require.Equal(t, uint(20), cfg.MaxScheduledGames)
+	})
+}
+
+func TestActTimeSlice(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.ActTimeSlice)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--act-time-slice=30s"))
+		require.Equal(t, 30*time.Second, cfg.ActTimeSlice)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--act-time-slice=-1s"))
+		require.ErrorIs(t, cfg.Check(), config.ErrNegativeActTimeSlice)
+	})
+}
+
+func TestMaxBond(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultMaxBond, cfg.MaxBond)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--max-bond", "12345"))
+		require.Equal(t, big.NewInt(12345), cfg.MaxBond)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid max-bond: abc",
+			addRequiredArgs(config.TraceTypeAlphabet, "--max-bond", "abc"))
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid max-bond: -1",
+			addRequiredArgs(config.TraceTypeAlphabet, "--max-bond", "-1"))
+	})
 }
 
 func TestCannonBin(t *testing.T) {
@@ -180,7 +272,7 @@ func TestCannonBin(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-bin", "--cannon-bin=./cannon"))
-		require.Equal(t, "./cannon", cfg.CannonBin)
+		require.Equal(t, "./cannon", cfg.Cannon.Bin)
 	})
 }
 
@@ -195,7 +287,7 @@ func TestCannonServer(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-server", "--cannon-server=./op-program"))
-		require.Equal(t, "./op-program", cfg.CannonServer)
+		require.Equal(t, "./op-program", cfg.Cannon.Server)
 	})
 }
 
@@ -210,7 +302,7 @@ func TestCannonAbsolutePrestate(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-prestate", "--cannon-prestate=./pre.json"))
-		require.Equal(t, "./pre.json", cfg.CannonAbsolutePreState)
+		require.Equal(t, "./pre.json", cfg.Cannon.AbsolutePreState)
 	})
 }
 
@@ -240,19 +332,143 @@ func TestCannonL2(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
-		require.Equal(t, cannonL2, cfg.CannonL2)
+		require.Equal(t, cannonL2, cfg.Cannon.L2)
 	})
 }
 
 func TestCannonSnapshotFreq(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
-		require.Equal(t, config.DefaultCannonSnapshotFreq, cfg.CannonSnapshotFreq)
+		require.Equal(t, config.DefaultCannonSnapshotFreq, cfg.Cannon.SnapshotFreq)
 	})
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-snapshot-freq=1234"))
-		require.Equal(t, uint(1234), cfg.CannonSnapshotFreq)
+		require.Equal(t, uint(1234), cfg.Cannon.SnapshotFreq)
+	})
+}
+
+func TestCannonSupervision(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.Zero(t, cfg.Cannon.MaxMemory)
+		require.Zero(t, cfg.Cannon.MaxCPUTime)
+		require.Zero(t, cfg.Cannon.Timeout)
+		require.Equal(t, config.DefaultCannonMaxRestarts, cfg.Cannon.MaxRestarts)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon,
+			"--cannon-max-memory=4096", "--cannon-max-cpu-time=2h", "--cannon-timeout=30m", "--cannon-max-restarts=5"))
+		require.Equal(t, uint(4096), cfg.Cannon.MaxMemory)
+		require.Equal(t, 2*time.Hour, cfg.Cannon.MaxCPUTime)
+		require.Equal(t, 30*time.Minute, cfg.Cannon.Timeout)
+		require.Equal(t, uint(5), cfg.Cannon.MaxRestarts)
+	})
+}
+
+func TestCannonStallThreshold(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.Zero(t, cfg.Cannon.StallThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-stall-threshold=20m"))
+		require.Equal(t, 20*time.Minute, cfg.Cannon.StallThreshold)
+	})
+}
+
+func TestAnomalyProfiles(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.AnomalyProfiles)
+		require.Equal(t, config.DefaultAnomalySlowCycle, cfg.AnomalySlowCycle)
+		require.Zero(t, cfg.AnomalyMemoryLimit)
+		require.Equal(t, config.DefaultAnomalyMaxProfiles, cfg.AnomalyMaxProfiles)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--anomaly-profiles", "--anomaly-slow-cycle=30s", "--anomaly-memory-limit=8192", "--anomaly-max-profiles=3"))
+		require.True(t, cfg.AnomalyProfiles)
+		require.Equal(t, 30*time.Second, cfg.AnomalySlowCycle)
+		require.Equal(t, uint(8192), cfg.AnomalyMemoryLimit)
+		require.Equal(t, uint(3), cfg.AnomalyMaxProfiles)
+	})
+
+	t.Run("MaxProfilesRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--anomaly-profiles", "--anomaly-max-profiles=0"))
+		require.ErrorIs(t, cfg.Check(), config.ErrMissingAnomalyMaxProfiles)
+	})
+}
+
+func TestAuditSign(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.AuditSign)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--audit-sign", "--private-key=0x1234"))
+		require.True(t, cfg.AuditSign)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RequiresPrivateKey", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--audit-sign"))
+		require.ErrorIs(t, cfg.Check(), config.ErrAuditSignWithoutPrivateKey)
+	})
+}
+
+func TestPanicMode(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.PanicGameThreshold)
+		require.Zero(t, cfg.PanicClaimThreshold)
+		require.Equal(t, config.DefaultPanicWindow, cfg.PanicWindow)
+		require.Equal(t, config.DefaultPanicDuration, cfg.PanicDuration)
+		require.Zero(t, cfg.PanicMaxConcurrency)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--panic-game-threshold=20", "--panic-claim-threshold=50", "--panic-window=5m", "--panic-duration=1h", "--panic-max-concurrency=16"))
+		require.Equal(t, uint(20), cfg.PanicGameThreshold)
+		require.Equal(t, uint(50), cfg.PanicClaimThreshold)
+		require.Equal(t, 5*time.Minute, cfg.PanicWindow)
+		require.Equal(t, time.Hour, cfg.PanicDuration)
+		require.Equal(t, uint(16), cfg.PanicMaxConcurrency)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("WindowRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--panic-claim-threshold=50", "--panic-window=0"))
+		require.ErrorIs(t, cfg.Check(), config.ErrMissingPanicWindow)
+	})
+
+	t.Run("DurationRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--panic-game-threshold=20", "--panic-duration=0"))
+		require.ErrorIs(t, cfg.Check(), config.ErrMissingPanicDuration)
+	})
+}
+
+func TestTxRelayRpc(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.TxRelayRpc)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--tx-relay-rpc=https://relay.example.com"))
+		require.Equal(t, "https://relay.example.com", cfg.TxRelayRpc)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RejectRebroadcast", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--tx-relay-rpc=https://relay.example.com", "--tx-rebroadcast-rpc=http://example.com:8545"))
+		require.ErrorIs(t, cfg.Check(), config.ErrTxRelayWithRebroadcast)
 	})
 }
 
@@ -273,6 +489,383 @@ func TestGameWindow(t *testing.T) {
 	})
 }
 
+func TestBackfillFromBlock(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.BackfillFromBlock)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--backfill-from-block=1234"))
+		require.Equal(t, uint64(1234), cfg.BackfillFromBlock)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -backfill-from-block", addRequiredArgs(config.TraceTypeAlphabet, "--backfill-from-block=abc"))
+	})
+}
+
+func TestConfirmationDepth(t *testing.T) {
+	t.Run("DefaultsToLatest", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.ConfirmationDepth)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--confirmation-depth=12"))
+		require.Equal(t, uint64(12), cfg.ConfirmationDepth)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -confirmation-depth", addRequiredArgs(config.TraceTypeAlphabet, "--confirmation-depth=abc"))
+	})
+}
+
+func TestOutputRootAgreement(t *testing.T) {
+	t.Run("MultipleRollupRpcs", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--rollup-rpc", "http://example.org:7545",
+			"--output-root-agreement"))
+		require.Equal(t, []string{"http://example.com:7545", "http://example.org:7545"}, cfg.RollupRpcs)
+		require.True(t, cfg.OutputRootAgreement)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RequiresTwoRollupRpcs", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--output-root-agreement"))
+		require.ErrorIs(t, cfg.Check(), config.ErrOutputRootAgreementRollupRpcs)
+	})
+}
+
+func TestL2GethRpc(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.L2GethRpc)
+	})
+
+	t.Run("SingleRollupRpc", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--l2-geth-rpc", "http://example.com:9545",
+			"--output-root-agreement"))
+		require.Equal(t, "http://example.com:9545", cfg.L2GethRpc)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RequiresOutputRootAgreement", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--l2-geth-rpc", "http://example.com:9545"))
+		require.ErrorIs(t, cfg.Check(), config.ErrL2GethRpcWithoutAgreement)
+	})
+}
+
+func TestDefenseWatch(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.DefenseWatch)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--rollup-rpc", "http://example.org:7545",
+			"--output-root-agreement",
+			"--defense-watch"))
+		require.True(t, cfg.DefenseWatch)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RequiresOutputRootAgreement", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--defense-watch"))
+		require.ErrorIs(t, cfg.Check(), config.ErrDefenseWatchWithoutAgreement)
+	})
+}
+
+func TestHaltDetection(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.RollupRpcs)
+		require.False(t, cfg.OutputRootAgreement)
+		require.Equal(t, config.DefaultL1HaltThreshold, cfg.L1HaltThreshold)
+		require.Equal(t, config.DefaultL2HaltThreshold, cfg.L2HaltThreshold)
+		require.Equal(t, config.DefaultUrgentClaimAge, cfg.UrgentClaimAge)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--rollup-rpc", "http://example.com:7545",
+			"--l1-halt-threshold", "1m",
+			"--l2-halt-threshold", "2m",
+			"--urgent-claim-age", "3h"))
+		require.Equal(t, []string{"http://example.com:7545"}, cfg.RollupRpcs)
+		require.Equal(t, time.Minute, cfg.L1HaltThreshold)
+		require.Equal(t, 2*time.Minute, cfg.L2HaltThreshold)
+		require.Equal(t, 3*time.Hour, cfg.UrgentClaimAge)
+	})
+}
+
+func TestFeeUrgency(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultUrgentMoveWindow, cfg.UrgentMoveWindow)
+		require.Equal(t, config.DefaultEconomicalResolutionWindow, cfg.EconomicalResolutionWindow)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--urgent-move-window", "30m",
+			"--economical-resolution-window", "0"))
+		require.Equal(t, 30*time.Minute, cfg.UrgentMoveWindow)
+		require.Equal(t, time.Duration(0), cfg.EconomicalResolutionWindow)
+	})
+}
+
+func TestStuckTxs(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultStuckTxFraction, cfg.StuckTxFraction)
+		require.Empty(t, cfg.TxRebroadcastRpcs)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--stuck-tx-fraction", "0.25",
+			"--tx-rebroadcast-rpc", "http://example.com:8545",
+			"--tx-rebroadcast-rpc", "http://example.org:8545"))
+		require.Equal(t, 0.25, cfg.StuckTxFraction)
+		require.Equal(t, []string{"http://example.com:8545", "http://example.org:8545"}, cfg.TxRebroadcastRpcs)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--stuck-tx-fraction", "1"))
+		require.ErrorIs(t, cfg.Check(), config.ErrInvalidStuckTxFraction)
+	})
+}
+
+func TestOutcomeReport(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.OutcomeReportURL)
+		require.Empty(t, cfg.OutcomeReportSecret)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--outcome-report-url", "https://example.com/outcomes",
+			"--outcome-report-secret", "shh"))
+		require.Equal(t, "https://example.com/outcomes", cfg.OutcomeReportURL)
+		require.Equal(t, "shh", cfg.OutcomeReportSecret)
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--outcome-report-url", "ftp://example.com"))
+		require.ErrorIs(t, cfg.Check(), config.ErrInvalidOutcomeReportURL)
+	})
+
+	t.Run("SecretRequiresURL", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--outcome-report-secret", "shh"))
+		require.ErrorIs(t, cfg.Check(), config.ErrOutcomeReportSecretWithoutURL)
+	})
+}
+
+func TestAlerts(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.AlertSlackWebhook)
+		require.Empty(t, cfg.AlertOpsgenieAPIKey)
+		require.Equal(t, config.DefaultAlertOpsgenieURL, cfg.AlertOpsgenieURL)
+		require.Nil(t, cfg.MinBalance)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--alert-slack-webhook", "https://hooks.slack.com/services/T0/B0/secret",
+			"--alert-opsgenie-api-key", "key",
+			"--alert-opsgenie-url", "https://api.eu.opsgenie.com",
+			"--min-balance", "1000"))
+		require.Equal(t, "https://hooks.slack.com/services/T0/B0/secret", cfg.AlertSlackWebhook)
+		require.Equal(t, "key", cfg.AlertOpsgenieAPIKey)
+		require.Equal(t, "https://api.eu.opsgenie.com", cfg.AlertOpsgenieURL)
+		require.Equal(t, big.NewInt(1000), cfg.MinBalance)
+	})
+
+	t.Run("InvalidMinBalance", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid min-balance: abc",
+			addRequiredArgs(config.TraceTypeAlphabet, "--min-balance", "abc"))
+	})
+
+	t.Run("InvalidSlackWebhook", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--alert-slack-webhook", "not-a-url"))
+		require.ErrorIs(t, cfg.Check(), config.ErrInvalidAlertSlackWebhook)
+	})
+}
+
+func TestStepCorpusDir(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.StepCorpusDir)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--step-corpus-dir", "/tmp/corpus"))
+		require.Equal(t, "/tmp/corpus", cfg.StepCorpusDir)
+	})
+}
+
+func TestMempoolLookahead(t *testing.T) {
+	t.Run("DefaultsToFalse", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.MempoolLookahead)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--mempool-lookahead"))
+		require.True(t, cfg.MempoolLookahead)
+	})
+}
+
+func TestRuntimeConfigAddress(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, common.Address{}, cfg.RuntimeConfigAddress)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xbb, 0xcc, 0xdd}
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--runtime-config-address", addr.Hex()))
+		require.Equal(t, addr, cfg.RuntimeConfigAddress)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address: foo", addRequiredArgs(config.TraceTypeAlphabet, "--runtime-config-address", "foo"))
+	})
+}
+
+func TestGameLogs(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultGameLogMaxSize, cfg.GameLogMaxSize)
+		require.Equal(t, config.DefaultGameLogMaxBackups, cfg.GameLogMaxBackups)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--game-log-max-size", "0", "--game-log-max-backups", "7"))
+		require.Equal(t, 0, cfg.GameLogMaxSize)
+		require.Equal(t, 7, cfg.GameLogMaxBackups)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--game-log-max-size", "-1"))
+		require.ErrorIs(t, cfg.Check(), config.ErrNegativeGameLogSettings)
+	})
+}
+
+func TestL1Quorum(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.L1QuorumRpcs)
+		require.Zero(t, cfg.L1QuorumThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--l1-quorum-rpc=http://example.com:8546", "--l1-quorum-rpc=http://example.com:8547", "--l1-quorum-threshold=2"))
+		require.Equal(t, []string{"http://example.com:8546", "http://example.com:8547"}, cfg.L1QuorumRpcs)
+		require.Equal(t, uint(2), cfg.L1QuorumThreshold)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("ThresholdTooHigh", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--l1-quorum-rpc=http://example.com:8546", "--l1-quorum-threshold=3"))
+		require.ErrorIs(t, cfg.Check(), config.ErrL1QuorumThresholdTooHigh)
+	})
+}
+
+func TestAdminRPC(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, rpc.DefaultCLIConfig(), cfg.RPCConfig)
+		require.False(t, cfg.RPCConfig.ServerEnabled())
+	})
+
+	t.Run("HealthOnly", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enabled"))
+		require.True(t, cfg.RPCConfig.ServerEnabled())
+		require.False(t, cfg.RPCConfig.EnableAdmin)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.addr=0.0.0.0", "--rpc.port=9000"))
+		require.Equal(t, rpc.CLIConfig{EnableAdmin: true, ListenAddr: "0.0.0.0", ListenPort: 9000, VerifyListenAddr: "127.0.0.1", VerifyListenPort: 8546, SocketMode: 0600}, cfg.RPCConfig)
+	})
+
+	t.Run("Socket", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.socket=/tmp/challenger.sock", "--rpc.socket-mode=0660", "--rpc.disable-tcp"))
+		require.Equal(t, "/tmp/challenger.sock", cfg.RPCConfig.SocketPath)
+		require.Equal(t, os.FileMode(0660), cfg.RPCConfig.SocketMode)
+		require.True(t, cfg.RPCConfig.DisableTCP)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("DefaultSocketMode", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enabled", "--rpc.socket=/tmp/challenger.sock"))
+		require.Equal(t, os.FileMode(0600), cfg.RPCConfig.SocketMode)
+		require.False(t, cfg.RPCConfig.DisableTCP)
+	})
+
+	t.Run("InvalidSocketMode", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enabled", "--rpc.socket=/tmp/challenger.sock", "--rpc.socket-mode=660"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrInvalidSocketMode)
+	})
+
+	t.Run("DisableTCPWithoutSocket", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enabled", "--rpc.disable-tcp"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrMissingSocket)
+	})
+
+	t.Run("VerifyEnabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-verify"))
+		require.False(t, cfg.RPCConfig.ServerEnabled(), "should serve verify API separately")
+		require.True(t, cfg.RPCConfig.EnableVerify)
+		require.False(t, cfg.RPCConfig.EnableAdmin)
+		require.Equal(t, "127.0.0.1", cfg.RPCConfig.VerifyListenAddr)
+		require.Equal(t, 8546, cfg.RPCConfig.VerifyListenPort)
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("VerifyAddress", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-verify", "--rpc.verify-addr=0.0.0.0", "--rpc.verify-port=9001"))
+		require.Equal(t, "0.0.0.0", cfg.RPCConfig.VerifyListenAddr)
+		require.Equal(t, 9001, cfg.RPCConfig.VerifyListenPort)
+	})
+
+	t.Run("InvalidVerifyPort", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-verify", "--rpc.verify-port=70000"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrInvalidPort)
+	})
+
+	t.Run("VerifyPortInUse", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.enable-verify", "--rpc.port=9000", "--rpc.verify-port=9000"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrVerifyPortInUse)
+	})
+
+	t.Run("InvalidPort", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.port=70000"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrInvalidPort)
+	})
+
+	t.Run("InvalidPortHealthOnly", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enabled", "--rpc.port=70000"))
+		require.ErrorIs(t, cfg.Check(), rpc.ErrInvalidPort)
+	})
+}
+
 func TestRequireEitherCannonNetworkOrRollupAndGenesis(t *testing.T) {
 	verifyArgsInvalid(
 		t,
@@ -308,7 +901,7 @@ func TestCannonNetwork(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-network", "--cannon-network", otherCannonNetwork))
-		require.Equal(t, otherCannonNetwork, cfg.CannonNetwork)
+		require.Equal(t, otherCannonNetwork, cfg.Cannon.Network)
 	})
 }
 
@@ -319,7 +912,7 @@ func TestCannonRollupConfig(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-network", "--cannon-rollup-config=rollup.json", "--cannon-l2-genesis=genesis.json"))
-		require.Equal(t, "rollup.json", cfg.CannonRollupConfigPath)
+		require.Equal(t, "rollup.json", cfg.Cannon.RollupConfigPath)
 	})
 }
 
@@ -330,7 +923,7 @@ func TestCannonL2Genesis(t *testing.T) {
 
 	t.Run("Valid", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--cannon-network", "--cannon-rollup-config=rollup.json", "--cannon-l2-genesis=genesis.json"))
-		require.Equal(t, "genesis.json", cfg.CannonL2GenesisPath)
+		require.Equal(t, "genesis.json", cfg.Cannon.L2GenesisPath)
 	})
 }
 
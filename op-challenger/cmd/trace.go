@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+// traceDirPrefix is the prefix of the directory in the datadir used to store data generated by trace queries.
+// It is deliberately different to the game directories so it isn't shared with, or deleted by, a running challenger.
+const traceDirPrefix = "trace-"
+
+var ErrIndexBeyondTrace = errors.New("trace index is beyond the end of the trace")
+
+var (
+	traceGameFlag = &cli.StringFlag{
+		Name:     "game",
+		Usage:    "Address of the fault dispute game to query the trace of.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "TRACE_GAME"),
+		Required: true,
+	}
+	traceIndexFlag = &cli.Uint64Flag{
+		Name:     "index",
+		Usage:    "Trace index to query.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "TRACE_INDEX"),
+		Required: true,
+	}
+)
+
+// TraceCommand prints the challenger's view of the trace at an arbitrary index of a game,
+// alongside any on-chain claims committing to that index.
+var TraceCommand = &cli.Command{
+	Name:  "trace",
+	Usage: "Print the claim value and step data at a trace index of a game",
+	Description: "Uses the same trace provider as the challenger, configured with the usual op-challenger flags, " +
+		"to print the claim value, pre-state and proof data at the specified trace index. On-chain claims that " +
+		"commit to the same trace index are listed and compared against the expected value.",
+	Flags:  append(append([]cli.Flag{}, flags.Flags...), traceGameFlag, traceIndexFlag),
+	Action: traceQuery,
+}
+
+type traceClaim struct {
+	types.Claim
+	Matches bool
+}
+
+type traceData struct {
+	Game       common.Address
+	Index      uint64
+	Value      common.Hash
+	PreState   []byte
+	ProofData  []byte
+	OracleData *types.PreimageOracleData
+	Claims     []traceClaim
+}
+
+func traceQuery(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	game, err := opservice.ParseAddress(ctx.String(traceGameFlag.Name))
+	if err != nil {
+		return err
+	}
+	l1Client, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	loader, err := fault.NewLoaderFromBindings(game, l1Client)
+	if err != nil {
+		return err
+	}
+	gameDepth, err := loader.FetchGameDepth(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the game depth: %w", err)
+	}
+	gameType, err := loader.FetchGameType(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the game type: %w", err)
+	}
+	traceType, err := cfg.TraceTypeForGame(gameType)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(cfg.Datadir, traceDirPrefix+game.Hex())
+	provider, err := fault.NewTraceProvider(ctx.Context, logger, metrics.NoopMetrics, clock.SystemClock, cfg, traceType, l1Client, dir, game, gameDepth)
+	if err != nil {
+		return err
+	}
+	data, err := fetchTrace(ctx.Context, provider, loader, int(gameDepth), ctx.Uint64(traceIndexFlag.Name))
+	if err != nil {
+		return err
+	}
+	data.Game = game
+	return renderTrace(ctx.App.Writer, data)
+}
+
+// fetchTrace loads the trace data at index from provider and the on-chain claims that commit to the same index.
+func fetchTrace(ctx context.Context, provider types.TraceProvider, loader fault.ClaimLoader, maxDepth int, index uint64) (traceData, error) {
+	maxIndex := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(maxDepth)), big.NewInt(1))
+	if new(big.Int).SetUint64(index).Cmp(maxIndex) > 0 {
+		return traceData{}, fmt.Errorf("%w: index %v, max %v", ErrIndexBeyondTrace, index, maxIndex)
+	}
+	value, err := provider.Get(ctx, index)
+	if err != nil {
+		return traceData{}, fmt.Errorf("failed to get claim value: %w", err)
+	}
+	preState, proofData, oracleData, err := provider.GetStepData(ctx, index)
+	if err != nil {
+		return traceData{}, fmt.Errorf("failed to get step data: %w", err)
+	}
+	claims, err := loader.FetchClaims(ctx)
+	if err != nil {
+		return traceData{}, fmt.Errorf("failed to fetch claims: %w", err)
+	}
+	data := traceData{
+		Index:      index,
+		Value:      value,
+		PreState:   preState,
+		ProofData:  proofData,
+		OracleData: oracleData,
+	}
+	for _, claim := range claims {
+		if !claim.TraceIndex(maxDepth).IsUint64() || claim.TraceIndex(maxDepth).Uint64() != index {
+			continue
+		}
+		data.Claims = append(data.Claims, traceClaim{Claim: claim, Matches: claim.Value == value})
+	}
+	return data, nil
+}
+
+func renderTrace(out io.Writer, data traceData) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Game\t%v\n", data.Game)
+	fmt.Fprintf(w, "Trace index\t%d\n", data.Index)
+	fmt.Fprintf(w, "Claim\t%v\n", data.Value)
+	fmt.Fprintf(w, "Pre-state\t%v\n", hexutil.Bytes(data.PreState))
+	fmt.Fprintf(w, "Proof data\t%v\n", hexutil.Bytes(data.ProofData))
+	if data.OracleData != nil {
+		fmt.Fprintf(w, "Oracle key\t%v\n", hexutil.Bytes(data.OracleData.OracleKey))
+		fmt.Fprintf(w, "Oracle data\t%v\n", hexutil.Bytes(data.OracleData.OracleData))
+		fmt.Fprintf(w, "Oracle offset\t%d\n", data.OracleData.OracleOffset)
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "ON-CHAIN CLAIMS AT TRACE INDEX %d (%d)\n", data.Index, len(data.Claims))
+	fmt.Fprintln(w, "INDEX\tDEPTH\tINDEX AT DEPTH\tVALUE\tRESULT")
+	for _, claim := range data.Claims {
+		result := "matches"
+		if !claim.Matches {
+			result = "DIFFERS"
+		}
+		fmt.Fprintf(w, "%d\t%d\t%v\t%v\t%v\n", claim.ContractIndex, claim.Depth(), claim.IndexAtDepth(), claim.Value, result)
+	}
+	return w.Flush()
+}
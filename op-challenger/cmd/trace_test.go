@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceRequiresGameAndIndex(t *testing.T) {
+	verifyArgsInvalid(t, "\"game\"", append([]string{"trace", "--index=1"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	verifyArgsInvalid(t, "\"index\"", append([]string{"trace", "--game=" + common.Address{0xaa}.Hex()}, addRequiredArgs(config.TraceTypeAlphabet)...))
+}
+
+func TestFetchTrace(t *testing.T) {
+	maxDepth := 3
+	provider := alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth))
+	builder := test.NewClaimBuilder(t, maxDepth, provider)
+	root := builder.CreateRootClaim(false)
+	attack := builder.AttackClaim(root, true)
+	attack.ContractIndex = 1
+	leaf := builder.CreateLeafClaim(3, false)
+	leaf.ContractIndex = 2
+	loader := &stubTraceClaimLoader{claims: []types.Claim{root, attack, leaf}}
+
+	t.Run("ComparesClaimsAtIndex", func(t *testing.T) {
+		data, err := fetchTrace(context.Background(), provider, loader, maxDepth, 3)
+		require.NoError(t, err)
+		expected, err := provider.Get(context.Background(), 3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), data.Index)
+		require.Equal(t, expected, data.Value)
+		require.NotEmpty(t, data.PreState)
+		require.Equal(t, []traceClaim{{Claim: attack, Matches: true}, {Claim: leaf, Matches: false}}, data.Claims)
+	})
+
+	t.Run("NoClaimsAtIndex", func(t *testing.T) {
+		data, err := fetchTrace(context.Background(), provider, loader, maxDepth, 2)
+		require.NoError(t, err)
+		require.Empty(t, data.Claims)
+	})
+
+	t.Run("IndexBeyondTrace", func(t *testing.T) {
+		_, err := fetchTrace(context.Background(), provider, loader, maxDepth, 8)
+		require.ErrorIs(t, err, ErrIndexBeyondTrace)
+	})
+}
+
+func TestRenderTrace(t *testing.T) {
+	builder := test.NewClaimBuilder(t, 3, alphabet.NewTraceProvider("abcdefgh", 3))
+	matching := builder.CreateLeafClaim(5, true)
+	matching.ContractIndex = 4
+	differing := builder.CreateLeafClaim(5, false)
+	differing.ContractIndex = 7
+	data := traceData{
+		Game:       common.Address{0xaa},
+		Index:      5,
+		Value:      common.Hash{0x01},
+		PreState:   []byte{0x02},
+		ProofData:  []byte{0x03},
+		OracleData: types.NewPreimageOracleData([]byte{0x04}, []byte{0x05}, 6),
+		Claims:     []traceClaim{{Claim: matching, Matches: true}, {Claim: differing}},
+	}
+	var out bytes.Buffer
+	require.NoError(t, renderTrace(&out, data))
+	text := out.String()
+	require.Contains(t, text, common.Address{0xaa}.Hex())
+	require.Contains(t, text, common.Hash{0x01}.Hex())
+	require.Contains(t, text, "0x02")
+	require.Contains(t, text, "0x03")
+	require.Contains(t, text, "0x04")
+	require.Contains(t, text, "ON-CHAIN CLAIMS AT TRACE INDEX 5 (2)")
+	require.Contains(t, text, "matches")
+	require.Contains(t, text, "DIFFERS")
+}
+
+type stubTraceClaimLoader struct {
+	claims []types.Claim
+}
+
+func (s *stubTraceClaimLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+)
+
+var ErrPrestateMismatch = errors.New("prestate does not match all registered game implementations")
+
+var (
+	validateL1EthRpcFlag = &cli.StringFlag{
+		Name:     "l1-eth-rpc",
+		Usage:    "HTTP provider URL for L1.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "L1_ETH_RPC"),
+		Required: true,
+	}
+	validateFactoryAddressFlag = &cli.StringFlag{
+		Name:     "game-factory-address",
+		Usage:    "Address of the fault game factory contract.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "GAME_FACTORY_ADDRESS"),
+		Required: true,
+	}
+	validatePrestateFlag = &cli.StringFlag{
+		Name:     "prestate",
+		Usage:    "Path to the cannon absolute prestate file to validate.",
+		EnvVars:  opservice.PrefixEnvVar("OP_CHALLENGER", "CANNON_PRESTATE"),
+		Required: true,
+	}
+)
+
+// ValidatePrestateCommand checks a cannon absolute prestate file against the on-chain absolute prestate of every
+// cannon game implementation registered in the dispute game factory.
+var ValidatePrestateCommand = &cli.Command{
+	Name:  "validate-prestate",
+	Usage: "Validate a cannon absolute prestate against the cannon game implementations registered in the dispute game factory",
+	Description: "Loads the implementation registered in the dispute game factory for each cannon game type and reports " +
+		"whether its absolute prestate matches the supplied file. Exits with an error if any game type does not match.",
+	Flags: []cli.Flag{
+		validateL1EthRpcFlag,
+		validateFactoryAddressFlag,
+		validatePrestateFlag,
+	},
+	Action: validatePrestate,
+}
+
+func validatePrestate(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	factoryAddr, err := opservice.ParseAddress(ctx.String(validateFactoryAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	l1Client, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, ctx.String(validateL1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	factory, err := bindings.NewDisputeGameFactoryCaller(factoryAddr, l1Client)
+	if err != nil {
+		return fmt.Errorf("failed to bind the dispute game factory contract: %w", err)
+	}
+	prestate := cannon.NewPrestateProvider(ctx.String(validatePrestateFlag.Name))
+	results, err := fault.ValidatePrestateForGameTypes(ctx.Context, config.TraceTypeCannon, prestate, factory, fault.NewPrestateLoaderCreator(l1Client))
+	if err != nil {
+		return err
+	}
+	return printPrestateReport(ctx.App.Writer, results)
+}
+
+// printPrestateReport writes a per-game-type match report to out.
+// Returns ErrPrestateMismatch if any game type with a registered implementation does not match.
+func printPrestateReport(out io.Writer, results []fault.PrestateResult) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "GAME TYPE\tNAME\tIMPLEMENTATION\tRESULT")
+	mismatch := false
+	for _, result := range results {
+		status := "match"
+		if errors.Is(result.Err, fault.ErrNoImplementation) {
+			status = "no implementation"
+		} else if !result.Matches() {
+			status = "MISMATCH: " + result.Err.Error()
+			mismatch = true
+		}
+		fmt.Fprintf(w, "%d\t%v\t%v\t%v\n", result.GameType, result.Name, result.Impl, status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if mismatch {
+		return ErrPrestateMismatch
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePrestateRequiredFlags(t *testing.T) {
+	for _, name := range []string{"l1-eth-rpc", "game-factory-address", "prestate"} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			args := map[string]string{
+				"--l1-eth-rpc":           l1EthRpc,
+				"--game-factory-address": gameFactoryAddressValue,
+				"--prestate":             cannonPreState,
+			}
+			delete(args, "--"+name)
+			cliArgs := append([]string{"validate-prestate"}, toArgList(args)...)
+			verifyArgsInvalid(t, "Required flag \""+name+"\" not set", cliArgs)
+		})
+	}
+}
+
+func TestPrintPrestateReport(t *testing.T) {
+	t.Run("AllMatch", func(t *testing.T) {
+		var out bytes.Buffer
+		err := printPrestateReport(&out, []fault.PrestateResult{
+			{GameType: 0, Name: "Cannon", Impl: common.Address{0xaa}},
+			{GameType: 255, Name: "Alphabet", Err: fault.ErrNoImplementation},
+		})
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "match")
+		require.Contains(t, out.String(), "no implementation")
+		require.NotContains(t, out.String(), "MISMATCH")
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		var out bytes.Buffer
+		err := printPrestateReport(&out, []fault.PrestateResult{
+			{GameType: 0, Name: "Cannon", Impl: common.Address{0xaa}, Err: errors.New("wrong prestate")},
+		})
+		require.ErrorIs(t, err, ErrPrestateMismatch)
+		require.Contains(t, out.String(), "MISMATCH: wrong prestate")
+	})
+}
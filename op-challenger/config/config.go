@@ -3,11 +3,16 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"net/url"
 	"runtime"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"golang.org/x/exp/slices"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
@@ -16,6 +21,8 @@ import (
 
 var (
 	ErrMissingTraceType              = errors.New("missing trace type")
+	ErrDuplicateTraceType            = errors.New("duplicate trace type")
+	ErrUnsupportedGameType           = errors.New("game type has no configured trace type")
 	ErrMissingDatadir                = errors.New("missing datadir")
 	ErrMaxConcurrencyZero            = errors.New("max concurrency must not be 0")
 	ErrMissingCannonL2               = errors.New("missing cannon L2")
@@ -31,6 +38,23 @@ var (
 	ErrCannonNetworkAndRollupConfig  = errors.New("only specify one of network or rollup config path")
 	ErrCannonNetworkAndL2Genesis     = errors.New("only specify one of network or l2 genesis path")
 	ErrCannonNetworkUnknown          = errors.New("unknown cannon network")
+	ErrMissingMaxBond                = errors.New("missing max bond")
+	ErrNegativeGameLogSettings       = errors.New("game log max size and max backups must not be negative")
+	ErrL1QuorumThresholdTooHigh      = errors.New("l1 quorum threshold must not exceed the number of l1 endpoints")
+	ErrInvalidOutcomeReportURL       = errors.New("outcome report url must be an http or https url")
+	ErrOutcomeReportSecretWithoutURL = errors.New("outcome report secret requires an outcome report url")
+	ErrOutputRootAgreementRollupRpcs = errors.New("output root agreement requires at least two rollup rpcs, or one with an l2 geth rpc")
+	ErrL2GethRpcWithoutAgreement     = errors.New("l2 geth rpc requires output root agreement")
+	ErrInvalidStuckTxFraction        = errors.New("stuck tx fraction must be at least 0 and less than 1")
+	ErrDefenseWatchWithoutAgreement  = errors.New("defense watch requires output root agreement")
+	ErrInvalidAlertSlackWebhook      = errors.New("alert slack webhook must be an http or https url")
+	ErrInvalidAlertOpsgenieURL       = errors.New("alert opsgenie url must be an http or https url")
+	ErrMissingAnomalyMaxProfiles     = errors.New("anomaly profiles require a max profiles of at least 1")
+	ErrAuditSignWithoutPrivateKey    = errors.New("signing the audit log requires a private key")
+	ErrTxRelayWithRebroadcast        = errors.New("tx relay rpc cannot be used with tx rebroadcast rpcs")
+	ErrMissingPanicWindow            = errors.New("panic mode requires a non-zero panic window")
+	ErrMissingPanicDuration          = errors.New("panic mode requires a non-zero panic duration")
+	ErrNegativeActTimeSlice          = errors.New("act time slice must not be negative")
 )
 
 type TraceType string
@@ -54,6 +78,12 @@ var GameIdToString = map[uint8]string{
 	AlphabetFaultGameID: "Alphabet",
 }
 
+// GameIdToTraceType maps game IDs to the trace type used to play them.
+var GameIdToTraceType = map[uint8]TraceType{
+	CannonFaultGameID:   TraceTypeCannon,
+	AlphabetFaultGameID: TraceTypeAlphabet,
+}
+
 func (t TraceType) String() string {
 	return string(t)
 }
@@ -78,51 +108,199 @@ func ValidTraceType(value TraceType) bool {
 
 const (
 	DefaultCannonSnapshotFreq = uint(1_000_000_000)
+	// DefaultCannonMaxRestarts is the default number of times a failed cannon execution is restarted.
+	DefaultCannonMaxRestarts = uint(2)
 	// DefaultGameWindow is the default maximum time duration in the past
 	// that the challenger will look for games to progress.
 	// The default value is 11 days, which is a 4 day resolution buffer
 	// plus the 7 day game finalization window.
 	DefaultGameWindow = time.Duration(11 * 24 * time.Hour)
+	// DefaultL1HaltThreshold is the default time without a new L1 block before the chain is considered halted.
+	DefaultL1HaltThreshold = 5 * time.Minute
+	// DefaultL2HaltThreshold is the default time without a change in the rollup node's unsafe head
+	// before the chain is considered halted.
+	DefaultL2HaltThreshold = 10 * time.Minute
+	// DefaultGameLogMaxSize is the default maximum size in megabytes of a game's log file before it is rotated.
+	DefaultGameLogMaxSize = 10
+	// DefaultGameLogMaxBackups is the default number of rotated log files retained for each game.
+	DefaultGameLogMaxBackups = 3
+	// DefaultUrgentClaimAge is the default age after which a claim is responded to even while soft-paused.
+	DefaultUrgentClaimAge = 12 * time.Hour
+	// DefaultUrgentMoveWindow is the default time before a game's deadline from which moves are sent with urgent fees.
+	DefaultUrgentMoveWindow = 2 * time.Hour
+	// DefaultEconomicalResolutionWindow is the default time after a game's deadline during which
+	// resolutions are sent with economical fees.
+	DefaultEconomicalResolutionWindow = 24 * time.Hour
+	// DefaultStuckTxFraction is the default fraction of the time remaining on a claim's clock that a move or step may
+	// be pending for before it is considered stuck.
+	DefaultStuckTxFraction = 0.5
+	// DefaultAnomalySlowCycle is the default time a monitor cycle may take before profiles are captured.
+	DefaultAnomalySlowCycle = time.Minute
+	// DefaultAnomalyMaxProfiles is the default number of profile captures retained.
+	DefaultAnomalyMaxProfiles = uint(10)
+	// DefaultPanicWindow is the default window new games and hostile claims are counted over to detect an attack.
+	DefaultPanicWindow = 10 * time.Minute
+	// DefaultPanicDuration is the default time panic mode lasts after an attack was last detected.
+	DefaultPanicDuration = 30 * time.Minute
+	// AutoConcurrency is the max concurrency value that derives the concurrency from the available system resources.
+	AutoConcurrency = "auto"
 )
 
+// DefaultAlertOpsgenieURL is the Opsgenie API alerts are created through unless another region's API is configured.
+const DefaultAlertOpsgenieURL = "https://api.opsgenie.com"
+
+// DefaultMaxBond is the default maximum bond in wei the challenger will attach to a single move.
+var DefaultMaxBond = big.NewInt(params.Ether)
+
 // Config is a well typed config that is parsed from the CLI params.
 // This also contains config options for auxiliary services.
 // It is used to initialize the challenger.
 type Config struct {
 	L1EthRpc                string           // L1 RPC Url
+	L1QuorumRpcs            []string         // Additional L1 RPC Urls that game data reads are cross-checked against
+	L1QuorumThreshold       uint             // Number of L1 endpoints that must agree on game data. 0 requires all
 	GameFactoryAddress      common.Address   // Address of the dispute game factory
 	GameAllowlist           []common.Address // Allowlist of fault game addresses
 	GameWindow              time.Duration    // Maximum time duration to look for games to progress
+	BackfillFromBlock       uint64           // L1 block to also progress games created since, beyond the GameWindow. 0 disables backfilling
+	ConfirmationDepth       uint64           // Number of L1 blocks games and claims must be buried by before they are acted on. 0 acts on the latest block
 	AgreeWithProposedOutput bool             // Temporary config if we agree or disagree with the posted output
 	Datadir                 string           // Data Directory
 	MaxConcurrency          uint             // Maximum number of threads to use when progressing games
+	AutoConcurrency         bool             // Whether to limit MaxConcurrency based on system resources and adjust it at runtime
+	MaxScheduledGames       uint             // Maximum number of games to progress in each update. 0 is unlimited
+	ActTimeSlice            time.Duration    // Maximum time to spend acting on a game's claims in each update before resuming in the next. 0 is unlimited
+	MaxBond                 *big.Int         // Maximum bond in wei to attach to a single move
+
+	UrgentMoveWindow           time.Duration // Time before the game deadline from which moves use urgent fees. 0 disables
+	EconomicalResolutionWindow time.Duration // Time after the game deadline during which resolutions use economical fees. 0 disables
+
+	StuckTxFraction   float64  // Fraction of the remaining clock time a move or step may be pending before it is escalated. 0 disables
+	TxRebroadcastRpcs []string // Optional additional L1 RPC Urls stuck transactions are rebroadcast through
+	TxRelayRpc        string   // Optional private relay RPC Url transactions are sent through instead of the L1 node
+
+	RollupRpcs          []string      // Optional rollup node RPC Urls, in order of preference, used to detect L2 halts and fetch output roots
+	OutputRootAgreement bool          // Whether to agree or disagree with each game's proposed output based on the rollup nodes' output roots
+	L2GethRpc           string        // Optional archive op-geth RPC Url output roots from the rollup nodes are verified against
+	DefenseWatch        bool          // Whether to only watch games the challenger agrees with the root claim of until a supported claim is countered
+	L1HaltThreshold     time.Duration // Time without a new L1 block before soft-pausing. 0 disables L1 halt detection
+	L2HaltThreshold     time.Duration // Time without a new unsafe L2 block before soft-pausing. 0 disables L2 halt detection
+	UrgentClaimAge      time.Duration // Age after which claims are responded to even while soft-paused
+
+	RuntimeConfigAddress common.Address // Optional address of the runtime config contract that can pause the challenger
+
+	GameLogMaxSize    int // Maximum size in megabytes of a game's log file before it is rotated. 0 disables per-game logs
+	GameLogMaxBackups int // Maximum number of rotated log files to retain for each game
 
-	TraceType TraceType // Type of trace
+	OutcomeReportURL    string // Optional HTTP endpoint the outcome of completed games is POSTed to. Empty disables reporting
+	OutcomeReportSecret string // Optional secret used to sign outcome reports
 
-	// Specific to the alphabet trace provider
-	AlphabetTrace string // String for the AlphabetTraceProvider
+	AlertSlackWebhook   string   // Optional Slack incoming webhook URL alerts are posted to
+	AlertOpsgenieAPIKey string   // Optional Opsgenie API key alerts are created with
+	AlertOpsgenieURL    string   // Opsgenie API URL alerts are created through
+	MinBalance          *big.Int // Balance in wei below which an alert is raised. Nil or 0 disables balance alerts
 
-	// Specific to the cannon trace provider
-	CannonBin              string // Path to the cannon executable to run when generating trace data
-	CannonServer           string // Path to the op-program executable that provides the pre-image oracle server
-	CannonAbsolutePreState string // File to load the absolute pre-state for Cannon traces from
-	CannonNetwork          string
-	CannonRollupConfigPath string
-	CannonL2GenesisPath    string
-	CannonL2               string // L2 RPC Url
-	CannonSnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
+	AnomalyProfiles    bool          // Whether to capture pprof profiles when an anomaly is detected
+	AnomalySlowCycle   time.Duration // Monitor cycle duration above which profiles are captured. 0 disables
+	AnomalyMemoryLimit uint          // Memory in megabytes above which profiles are captured. 0 disables
+	AnomalyMaxProfiles uint          // Maximum number of profile captures to retain
+
+	AuditSign bool // Whether to sign audit log entries with the challenger's private key
+
+	PanicGameThreshold  uint          // Number of games created within the panic window that triggers panic mode. 0 disables
+	PanicClaimThreshold uint          // Number of hostile claims posted within the panic window that triggers panic mode. 0 disables
+	PanicWindow         time.Duration // Window new games and hostile claims are counted over
+	PanicDuration       time.Duration // Time panic mode lasts after the thresholds were last exceeded
+	PanicMaxConcurrency uint          // Concurrency to raise to while in panic mode. 0 leaves the concurrency unchanged
+
+	StepCorpusDir string // Optional directory to record computed steps in for fault proof VM testing. Empty disables recording
+
+	MempoolLookahead bool // Whether to precompute responses to moves waiting in the L1 node's mempool
+
+	TraceTypes []TraceType // Types of trace to support
+
+	Alphabet AlphabetConfig // Configuration of the alphabet trace type
+	Cannon   CannonConfig   // Configuration of the cannon trace type
 
 	TxMgrConfig   txmgr.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	RPCConfig     rpc.CLIConfig
+}
+
+// AlphabetConfig configures the alphabet trace type, used for testing.
+type AlphabetConfig struct {
+	Trace string // String for the AlphabetTraceProvider
+}
+
+func (c AlphabetConfig) Check() error {
+	if c.Trace == "" {
+		return ErrMissingAlphabetTrace
+	}
+	return nil
+}
+
+// CannonConfig configures the cannon trace type.
+type CannonConfig struct {
+	Bin              string // Path to the cannon executable to run when generating trace data
+	Server           string // Path to the op-program executable that provides the pre-image oracle server
+	AbsolutePreState string // File to load the absolute pre-state for Cannon traces from
+	Network          string
+	RollupConfigPath string
+	L2GenesisPath    string
+	L2               string // L2 RPC Url
+	SnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
+
+	MaxMemory      uint          // Maximum virtual memory in megabytes for each cannon execution. 0 is unlimited
+	MaxCPUTime     time.Duration // Maximum CPU time for each cannon execution. 0 is unlimited
+	Timeout        time.Duration // Maximum wall clock time for each cannon execution. 0 is unlimited
+	MaxRestarts    uint          // Number of times a failed cannon execution is restarted before giving up
+	StallThreshold time.Duration // Wall clock time after which a running cannon execution is reported as stalled. 0 disables
+}
+
+func (c CannonConfig) Check() error {
+	if c.Bin == "" {
+		return ErrMissingCannonBin
+	}
+	if c.Server == "" {
+		return ErrMissingCannonServer
+	}
+	if c.Network == "" {
+		if c.RollupConfigPath == "" {
+			return ErrMissingCannonRollupConfig
+		}
+		if c.L2GenesisPath == "" {
+			return ErrMissingCannonL2Genesis
+		}
+	} else {
+		if c.RollupConfigPath != "" {
+			return ErrCannonNetworkAndRollupConfig
+		}
+		if c.L2GenesisPath != "" {
+			return ErrCannonNetworkAndL2Genesis
+		}
+		if ch := chaincfg.ChainByName(c.Network); ch == nil {
+			return fmt.Errorf("%w: %v", ErrCannonNetworkUnknown, c.Network)
+		}
+	}
+	if c.AbsolutePreState == "" {
+		return ErrMissingCannonAbsolutePreState
+	}
+	if c.L2 == "" {
+		return ErrMissingCannonL2
+	}
+	if c.SnapshotFreq == 0 {
+		return ErrMissingCannonSnapshotFreq
+	}
+	return nil
 }
 
 func NewConfig(
 	gameFactoryAddress common.Address,
 	l1EthRpc string,
-	traceType TraceType,
 	agreeWithProposedOutput bool,
 	datadir string,
+	supportedTraceTypes ...TraceType,
 ) Config {
 	return Config{
 		L1EthRpc:           l1EthRpc,
@@ -131,17 +309,57 @@ func NewConfig(
 
 		AgreeWithProposedOutput: agreeWithProposedOutput,
 
-		TraceType: traceType,
+		TraceTypes: supportedTraceTypes,
 
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		RPCConfig:     rpc.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
-		CannonSnapshotFreq: DefaultCannonSnapshotFreq,
-		GameWindow:         DefaultGameWindow,
+		Cannon: CannonConfig{
+			SnapshotFreq: DefaultCannonSnapshotFreq,
+			MaxRestarts:  DefaultCannonMaxRestarts,
+		},
+		GameWindow:        DefaultGameWindow,
+		MaxBond:           new(big.Int).Set(DefaultMaxBond),
+		L1HaltThreshold:   DefaultL1HaltThreshold,
+		L2HaltThreshold:   DefaultL2HaltThreshold,
+		UrgentClaimAge:    DefaultUrgentClaimAge,
+		GameLogMaxSize:    DefaultGameLogMaxSize,
+		GameLogMaxBackups: DefaultGameLogMaxBackups,
+
+		UrgentMoveWindow:           DefaultUrgentMoveWindow,
+		EconomicalResolutionWindow: DefaultEconomicalResolutionWindow,
+		StuckTxFraction:            DefaultStuckTxFraction,
+
+		AlertOpsgenieURL: DefaultAlertOpsgenieURL,
+
+		AnomalySlowCycle:   DefaultAnomalySlowCycle,
+		AnomalyMaxProfiles: DefaultAnomalyMaxProfiles,
+
+		PanicWindow:   DefaultPanicWindow,
+		PanicDuration: DefaultPanicDuration,
+	}
+}
+
+// TraceTypeEnabled returns true if the trace type is one of the supported trace types.
+func (c Config) TraceTypeEnabled(t TraceType) bool {
+	return slices.Contains(c.TraceTypes, t)
+}
+
+// TraceTypeForGame returns the configured trace type to use for games of the specified game type.
+// If only a single trace type is configured it is used for all games.
+func (c Config) TraceTypeForGame(gameType uint8) (TraceType, error) {
+	if len(c.TraceTypes) == 1 {
+		return c.TraceTypes[0], nil
 	}
+	traceType, ok := GameIdToTraceType[gameType]
+	if !ok || !c.TraceTypeEnabled(traceType) {
+		return "", fmt.Errorf("%w: %v", ErrUnsupportedGameType, gameType)
+	}
+	return traceType, nil
 }
 
 func (c Config) Check() error {
@@ -151,7 +369,7 @@ func (c Config) Check() error {
 	if c.GameFactoryAddress == (common.Address{}) {
 		return ErrMissingGameFactoryAddress
 	}
-	if c.TraceType == "" {
+	if len(c.TraceTypes) == 0 {
 		return ErrMissingTraceType
 	}
 	if c.Datadir == "" {
@@ -160,43 +378,84 @@ func (c Config) Check() error {
 	if c.MaxConcurrency == 0 {
 		return ErrMaxConcurrencyZero
 	}
-	if c.TraceType == TraceTypeCannon {
-		if c.CannonBin == "" {
-			return ErrMissingCannonBin
-		}
-		if c.CannonServer == "" {
-			return ErrMissingCannonServer
+	if c.ActTimeSlice < 0 {
+		return ErrNegativeActTimeSlice
+	}
+	if c.MaxBond == nil {
+		return ErrMissingMaxBond
+	}
+	if c.GameLogMaxSize < 0 || c.GameLogMaxBackups < 0 {
+		return ErrNegativeGameLogSettings
+	}
+	if c.L1QuorumThreshold > uint(len(c.L1QuorumRpcs)+1) {
+		return ErrL1QuorumThresholdTooHigh
+	}
+	if c.OutcomeReportURL != "" {
+		if !isHTTPURL(c.OutcomeReportURL) {
+			return fmt.Errorf("%w: %v", ErrInvalidOutcomeReportURL, c.OutcomeReportURL)
 		}
-		if c.CannonNetwork == "" {
-			if c.CannonRollupConfigPath == "" {
-				return ErrMissingCannonRollupConfig
-			}
-			if c.CannonL2GenesisPath == "" {
-				return ErrMissingCannonL2Genesis
-			}
-		} else {
-			if c.CannonRollupConfigPath != "" {
-				return ErrCannonNetworkAndRollupConfig
-			}
-			if c.CannonL2GenesisPath != "" {
-				return ErrCannonNetworkAndL2Genesis
-			}
-			if ch := chaincfg.ChainByName(c.CannonNetwork); ch == nil {
-				return fmt.Errorf("%w: %v", ErrCannonNetworkUnknown, c.CannonNetwork)
-			}
+	} else if c.OutcomeReportSecret != "" {
+		return ErrOutcomeReportSecretWithoutURL
+	}
+	if c.StuckTxFraction < 0 || c.StuckTxFraction >= 1 {
+		return ErrInvalidStuckTxFraction
+	}
+	// The webhook URL is a secret so is not included in the error
+	if c.AlertSlackWebhook != "" && !isHTTPURL(c.AlertSlackWebhook) {
+		return ErrInvalidAlertSlackWebhook
+	}
+	if c.AlertOpsgenieAPIKey != "" && !isHTTPURL(c.AlertOpsgenieURL) {
+		return fmt.Errorf("%w: %v", ErrInvalidAlertOpsgenieURL, c.AlertOpsgenieURL)
+	}
+	if c.AnomalyProfiles && c.AnomalyMaxProfiles == 0 {
+		return ErrMissingAnomalyMaxProfiles
+	}
+	if c.PanicGameThreshold > 0 || c.PanicClaimThreshold > 0 {
+		if c.PanicWindow == 0 {
+			return ErrMissingPanicWindow
 		}
-		if c.CannonAbsolutePreState == "" {
-			return ErrMissingCannonAbsolutePreState
+		if c.PanicDuration == 0 {
+			return ErrMissingPanicDuration
 		}
-		if c.CannonL2 == "" {
-			return ErrMissingCannonL2
+	}
+	// Audit entries are signed locally so a mnemonic or remote signer can't be used
+	if c.AuditSign && c.TxMgrConfig.PrivateKey == "" {
+		return ErrAuditSignWithoutPrivateKey
+	}
+	if c.OutputRootAgreement {
+		// op-geth provides a second source of output roots, independent of the rollup node software
+		minRollupRpcs := 2
+		if c.L2GethRpc != "" {
+			minRollupRpcs = 1
 		}
-		if c.CannonSnapshotFreq == 0 {
-			return ErrMissingCannonSnapshotFreq
+		if len(c.RollupRpcs) < minRollupRpcs {
+			return ErrOutputRootAgreementRollupRpcs
 		}
 	}
-	if c.TraceType == TraceTypeAlphabet && c.AlphabetTrace == "" {
-		return ErrMissingAlphabetTrace
+	if c.L2GethRpc != "" && !c.OutputRootAgreement {
+		return ErrL2GethRpcWithoutAgreement
+	}
+	if c.DefenseWatch && !c.OutputRootAgreement {
+		return ErrDefenseWatchWithoutAgreement
+	}
+	// Rebroadcasting would publish transactions sent through the relay to the public mempool
+	if c.TxRelayRpc != "" && len(c.TxRebroadcastRpcs) > 0 {
+		return ErrTxRelayWithRebroadcast
+	}
+	for i, traceType := range c.TraceTypes {
+		if slices.Contains(c.TraceTypes[:i], traceType) {
+			return fmt.Errorf("%w: %v", ErrDuplicateTraceType, traceType)
+		}
+		var err error
+		switch traceType {
+		case TraceTypeCannon:
+			err = c.Cannon.Check()
+		case TraceTypeAlphabet:
+			err = c.Alphabet.Check()
+		}
+		if err != nil {
+			return err
+		}
 	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
@@ -207,5 +466,14 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.RPCConfig.Check(); err != nil {
+		return err
+	}
 	return nil
 }
+
+// isHTTPURL returns true if s is an absolute http or https URL.
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
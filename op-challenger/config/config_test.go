@@ -3,6 +3,7 @@ package config
 import (
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -23,17 +24,19 @@ var (
 	agreeWithProposedOutput    = true
 )
 
-func validConfig(traceType TraceType) Config {
-	cfg := NewConfig(validGameFactoryAddress, validL1EthRpc, traceType, agreeWithProposedOutput, validDatadir)
-	switch traceType {
-	case TraceTypeAlphabet:
-		cfg.AlphabetTrace = validAlphabetTrace
-	case TraceTypeCannon:
-		cfg.CannonBin = validCannonBin
-		cfg.CannonServer = validCannonOpProgramBin
-		cfg.CannonAbsolutePreState = validCannonAbsolutPreState
-		cfg.CannonL2 = validCannonL2
-		cfg.CannonNetwork = validCannonNetwork
+func validConfig(traceTypes ...TraceType) Config {
+	cfg := NewConfig(validGameFactoryAddress, validL1EthRpc, agreeWithProposedOutput, validDatadir, traceTypes...)
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeAlphabet:
+			cfg.Alphabet.Trace = validAlphabetTrace
+		case TraceTypeCannon:
+			cfg.Cannon.Bin = validCannonBin
+			cfg.Cannon.Server = validCannonOpProgramBin
+			cfg.Cannon.AbsolutePreState = validCannonAbsolutPreState
+			cfg.Cannon.L2 = validCannonL2
+			cfg.Cannon.Network = validCannonNetwork
+		}
 	}
 	return cfg
 }
@@ -49,6 +52,65 @@ func TestValidConfigIsValid(t *testing.T) {
 	}
 }
 
+func TestTraceTypeRequired(t *testing.T) {
+	config := validConfig()
+	require.ErrorIs(t, config.Check(), ErrMissingTraceType)
+}
+
+func TestMultipleTraceTypes(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon, TraceTypeAlphabet)
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("EachTypeValidated", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon, TraceTypeAlphabet)
+		config.Alphabet.Trace = ""
+		require.ErrorIs(t, config.Check(), ErrMissingAlphabetTrace)
+
+		config = validConfig(TraceTypeCannon, TraceTypeAlphabet)
+		config.Cannon.Bin = ""
+		require.ErrorIs(t, config.Check(), ErrMissingCannonBin)
+	})
+
+	t.Run("UnusedTypesNotValidated", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Cannon = CannonConfig{}
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("NoDuplicates", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet, TraceTypeAlphabet)
+		require.ErrorIs(t, config.Check(), ErrDuplicateTraceType)
+	})
+}
+
+func TestTraceTypeForGame(t *testing.T) {
+	t.Run("SingleTypeUsedForAllGames", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		traceType, err := config.TraceTypeForGame(AlphabetFaultGameID)
+		require.NoError(t, err)
+		require.Equal(t, TraceTypeCannon, traceType)
+	})
+
+	t.Run("SelectByGameType", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon, TraceTypeAlphabet)
+		traceType, err := config.TraceTypeForGame(CannonFaultGameID)
+		require.NoError(t, err)
+		require.Equal(t, TraceTypeCannon, traceType)
+
+		traceType, err = config.TraceTypeForGame(AlphabetFaultGameID)
+		require.NoError(t, err)
+		require.Equal(t, TraceTypeAlphabet, traceType)
+	})
+
+	t.Run("UnknownGameType", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon, TraceTypeAlphabet)
+		_, err := config.TraceTypeForGame(42)
+		require.ErrorIs(t, err, ErrUnsupportedGameType)
+	})
+}
+
 func TestTxMgrConfig(t *testing.T) {
 	t.Run("Invalid", func(t *testing.T) {
 		config := validConfig(TraceTypeCannon)
@@ -77,25 +139,25 @@ func TestGameAllowlistNotRequired(t *testing.T) {
 
 func TestAlphabetTraceRequired(t *testing.T) {
 	config := validConfig(TraceTypeAlphabet)
-	config.AlphabetTrace = ""
+	config.Alphabet.Trace = ""
 	require.ErrorIs(t, config.Check(), ErrMissingAlphabetTrace)
 }
 
 func TestCannonBinRequired(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
-	config.CannonBin = ""
+	config.Cannon.Bin = ""
 	require.ErrorIs(t, config.Check(), ErrMissingCannonBin)
 }
 
 func TestCannonServerRequired(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
-	config.CannonServer = ""
+	config.Cannon.Server = ""
 	require.ErrorIs(t, config.Check(), ErrMissingCannonServer)
 }
 
 func TestCannonAbsolutePreStateRequired(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
-	config.CannonAbsolutePreState = ""
+	config.Cannon.AbsolutePreState = ""
 	require.ErrorIs(t, config.Check(), ErrMissingCannonAbsolutePreState)
 }
 
@@ -118,54 +180,229 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestMaxBondRequired(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.MaxBond = nil
+	require.ErrorIs(t, config.Check(), ErrMissingMaxBond)
+}
+
+func TestGameLogSettingsNotNegative(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.GameLogMaxSize = -1
+	require.ErrorIs(t, config.Check(), ErrNegativeGameLogSettings)
+
+	config = validConfig(TraceTypeAlphabet)
+	config.GameLogMaxBackups = -1
+	require.ErrorIs(t, config.Check(), ErrNegativeGameLogSettings)
+
+	config = validConfig(TraceTypeAlphabet)
+	config.GameLogMaxSize = 0
+	require.NoError(t, config.Check())
+}
+
+func TestL1QuorumThreshold(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.L1QuorumThreshold = 2
+	require.ErrorIs(t, config.Check(), ErrL1QuorumThresholdTooHigh)
+
+	config.L1QuorumRpcs = []string{"http://localhost:8546"}
+	require.NoError(t, config.Check())
+
+	config.L1QuorumThreshold = 0
+	require.NoError(t, config.Check())
+}
+
+func TestOutcomeReportURL(t *testing.T) {
+	for _, valid := range []string{"http://localhost:8080/outcomes", "https://example.com"} {
+		config := validConfig(TraceTypeAlphabet)
+		config.OutcomeReportURL = valid
+		config.OutcomeReportSecret = "secret"
+		require.NoError(t, config.Check(), valid)
+	}
+	for _, invalid := range []string{"localhost:8080", "ftp://example.com", "https://", "://bad"} {
+		config := validConfig(TraceTypeAlphabet)
+		config.OutcomeReportURL = invalid
+		require.ErrorIs(t, config.Check(), ErrInvalidOutcomeReportURL, invalid)
+	}
+}
+
+func TestOutcomeReportSecretRequiresURL(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.OutcomeReportSecret = "secret"
+	require.ErrorIs(t, config.Check(), ErrOutcomeReportSecretWithoutURL)
+}
+
+func TestOutputRootAgreementRequiresTwoRollupRpcs(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.OutputRootAgreement = true
+	config.RollupRpcs = []string{"http://localhost:7545"}
+	require.ErrorIs(t, config.Check(), ErrOutputRootAgreementRollupRpcs)
+
+	config.RollupRpcs = append(config.RollupRpcs, "http://localhost:8545")
+	require.NoError(t, config.Check())
+}
+
+func TestL2GethRpc(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.L2GethRpc = "http://localhost:9545"
+	require.ErrorIs(t, config.Check(), ErrL2GethRpcWithoutAgreement)
+
+	config.OutputRootAgreement = true
+	require.ErrorIs(t, config.Check(), ErrOutputRootAgreementRollupRpcs, "should require a rollup rpc")
+
+	config.RollupRpcs = []string{"http://localhost:7545"}
+	require.NoError(t, config.Check(), "should allow a single rollup rpc")
+}
+
+func TestDefenseWatchRequiresOutputRootAgreement(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.DefenseWatch = true
+	require.ErrorIs(t, config.Check(), ErrDefenseWatchWithoutAgreement)
+
+	config.OutputRootAgreement = true
+	config.RollupRpcs = []string{"http://localhost:7545", "http://localhost:8545"}
+	require.NoError(t, config.Check())
+}
+
+func TestStuckTxFraction(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.StuckTxFraction = 0
+	require.NoError(t, config.Check())
+
+	config.StuckTxFraction = -0.1
+	require.ErrorIs(t, config.Check(), ErrInvalidStuckTxFraction)
+
+	config.StuckTxFraction = 1
+	require.ErrorIs(t, config.Check(), ErrInvalidStuckTxFraction)
+}
+
+func TestAlertDestinations(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.AlertSlackWebhook = "https://hooks.slack.com/services/T0/B0/secret"
+	config.AlertOpsgenieAPIKey = "key"
+	require.NoError(t, config.Check())
+
+	config.AlertSlackWebhook = "hooks.slack.com/services/T0/B0/secret"
+	err := config.Check()
+	require.ErrorIs(t, err, ErrInvalidAlertSlackWebhook)
+	require.NotContains(t, err.Error(), "secret", "should not include webhook in error")
+
+	config.AlertSlackWebhook = ""
+	config.AlertOpsgenieURL = "api.opsgenie.com"
+	require.ErrorIs(t, config.Check(), ErrInvalidAlertOpsgenieURL)
+
+	config.AlertOpsgenieAPIKey = ""
+	require.NoError(t, config.Check(), "should not check opsgenie url without an api key")
+}
+
+func TestAnomalyMaxProfiles(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.AnomalyMaxProfiles = 0
+	require.NoError(t, config.Check(), "should not check max profiles when disabled")
+
+	config.AnomalyProfiles = true
+	require.ErrorIs(t, config.Check(), ErrMissingAnomalyMaxProfiles)
+
+	config.AnomalyMaxProfiles = 1
+	require.NoError(t, config.Check())
+}
+
+func TestAuditSignRequiresPrivateKey(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.AuditSign = true
+	require.ErrorIs(t, config.Check(), ErrAuditSignWithoutPrivateKey)
+
+	config.TxMgrConfig.PrivateKey = "0x1234"
+	require.NoError(t, config.Check())
+}
+
+func TestActTimeSliceNotNegative(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.ActTimeSlice = -time.Second
+	require.ErrorIs(t, config.Check(), ErrNegativeActTimeSlice)
+
+	config.ActTimeSlice = time.Second
+	require.NoError(t, config.Check())
+}
+
+func TestPanicModeRequiresWindowAndDuration(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.PanicWindow = 0
+	config.PanicDuration = 0
+	require.NoError(t, config.Check(), "should not check panic window or duration when disabled")
+
+	config.PanicGameThreshold = 10
+	require.ErrorIs(t, config.Check(), ErrMissingPanicWindow)
+
+	config.PanicWindow = time.Minute
+	require.ErrorIs(t, config.Check(), ErrMissingPanicDuration)
+
+	config.PanicDuration = time.Hour
+	require.NoError(t, config.Check())
+
+	config.PanicGameThreshold = 0
+	config.PanicClaimThreshold = 10
+	config.PanicWindow = 0
+	require.ErrorIs(t, config.Check(), ErrMissingPanicWindow)
+}
+
+func TestTxRelayWithRebroadcast(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.TxRelayRpc = "https://relay.example.com"
+	require.NoError(t, config.Check())
+
+	config.TxRebroadcastRpcs = []string{"http://example.com:8545"}
+	require.ErrorIs(t, config.Check(), ErrTxRelayWithRebroadcast)
+}
+
 func TestCannonL2Required(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
-	config.CannonL2 = ""
+	config.Cannon.L2 = ""
 	require.ErrorIs(t, config.Check(), ErrMissingCannonL2)
 }
 
 func TestCannonSnapshotFreq(t *testing.T) {
 	t.Run("MustNotBeZero", func(t *testing.T) {
 		cfg := validConfig(TraceTypeCannon)
-		cfg.CannonSnapshotFreq = 0
+		cfg.Cannon.SnapshotFreq = 0
 		require.ErrorIs(t, cfg.Check(), ErrMissingCannonSnapshotFreq)
 	})
 }
 
 func TestCannonNetworkOrRollupConfigRequired(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
-	cfg.CannonNetwork = ""
-	cfg.CannonRollupConfigPath = ""
-	cfg.CannonL2GenesisPath = "genesis.json"
+	cfg.Cannon.Network = ""
+	cfg.Cannon.RollupConfigPath = ""
+	cfg.Cannon.L2GenesisPath = "genesis.json"
 	require.ErrorIs(t, cfg.Check(), ErrMissingCannonRollupConfig)
 }
 
 func TestCannonNetworkOrL2GenesisRequired(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
-	cfg.CannonNetwork = ""
-	cfg.CannonRollupConfigPath = "foo.json"
-	cfg.CannonL2GenesisPath = ""
+	cfg.Cannon.Network = ""
+	cfg.Cannon.RollupConfigPath = "foo.json"
+	cfg.Cannon.L2GenesisPath = ""
 	require.ErrorIs(t, cfg.Check(), ErrMissingCannonL2Genesis)
 }
 
 func TestMustNotSpecifyNetworkAndRollup(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
-	cfg.CannonNetwork = validCannonNetwork
-	cfg.CannonRollupConfigPath = "foo.json"
-	cfg.CannonL2GenesisPath = ""
+	cfg.Cannon.Network = validCannonNetwork
+	cfg.Cannon.RollupConfigPath = "foo.json"
+	cfg.Cannon.L2GenesisPath = ""
 	require.ErrorIs(t, cfg.Check(), ErrCannonNetworkAndRollupConfig)
 }
 
 func TestMustNotSpecifyNetworkAndL2Genesis(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
-	cfg.CannonNetwork = validCannonNetwork
-	cfg.CannonRollupConfigPath = ""
-	cfg.CannonL2GenesisPath = "foo.json"
+	cfg.Cannon.Network = validCannonNetwork
+	cfg.Cannon.RollupConfigPath = ""
+	cfg.Cannon.L2GenesisPath = "foo.json"
 	require.ErrorIs(t, cfg.Check(), ErrCannonNetworkAndL2Genesis)
 }
 
 func TestNetworkMustBeValid(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
-	cfg.CannonNetwork = "unknown"
+	cfg.Cannon.Network = "unknown"
 	require.ErrorIs(t, cfg.Check(), ErrCannonNetworkUnknown)
 }
@@ -0,0 +1,151 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// adminPause allows scheduling of games to be paused through the admin API.
+type adminPause struct {
+	paused atomic.Bool
+}
+
+func (p *adminPause) PauseScheduler() {
+	p.paused.Store(true)
+}
+
+func (p *adminPause) ResumeScheduler() {
+	p.paused.Store(false)
+}
+
+func (p *adminPause) SchedulerPaused() bool {
+	return p.paused.Load()
+}
+
+// Paused implements the pause check used by the [gameMonitor].
+func (p *adminPause) Paused() bool {
+	return p.SchedulerPaused()
+}
+
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+type GameResolver interface {
+	CallResolve(ctx context.Context) (types.GameStatus, error)
+	Resolve(ctx context.Context) error
+}
+
+// AdminGameLoader loads the claims and duration of a game for the admin API.
+type AdminGameLoader interface {
+	ClaimLoader
+	FetchGameDuration(ctx context.Context) (time.Duration, error)
+}
+
+type adminLoaderCreator func(game common.Address) (AdminGameLoader, error)
+type resolverCreator func(game common.Address) (GameResolver, error)
+
+// adminBackend provides the operations available through the admin RPC API.
+type adminBackend struct {
+	*statusRegistry
+	*adminPause
+	logger log.Logger
+	clock  clock.Clock
+	client BalanceReader
+	from   common.Address
+	// games checks that games to resolve were created by the dispute game factory.
+	games          GameChecker
+	createLoader   adminLoaderCreator
+	createResolver resolverCreator
+	// gameDir returns the data directory of the game.
+	gameDir func(game common.Address) string
+}
+
+func (b *adminBackend) Wallet(ctx context.Context) (rpc.Wallet, error) {
+	balance, err := b.client.BalanceAt(ctx, b.from, nil)
+	if err != nil {
+		return rpc.Wallet{}, fmt.Errorf("failed to fetch balance of %v: %w", b.from, err)
+	}
+	return rpc.Wallet{
+		Address: b.from,
+		Balance: (*hexutil.Big)(balance),
+	}, nil
+}
+
+func (b *adminBackend) Claims(ctx context.Context, game common.Address) ([]rpc.Claim, error) {
+	loader, err := b.createLoader(game)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loader for game %v: %w", game, err)
+	}
+	claims, err := loader.FetchClaims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch claims for game %v: %w", game, err)
+	}
+	duration, err := loader.FetchGameDuration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch duration of game %v: %w", game, err)
+	}
+	chessClock := types.NewChessClock(duration)
+	now := b.clock.Now()
+	result := make([]rpc.Claim, 0, len(claims))
+	for _, claim := range claims {
+		var parentClock *types.Clock
+		if !claim.IsRoot() && claim.ParentContractIndex < len(claims) {
+			parentClock = &claims[claim.ParentContractIndex].Clock
+		}
+		result = append(result, rpc.Claim{
+			Index:         uint64(claim.ContractIndex),
+			ParentIndex:   uint64(claim.ParentContractIndex),
+			Value:         claim.Value,
+			Position:      (*hexutil.Big)(claim.Position.ToGIndex()),
+			Depth:         uint64(claim.Depth()),
+			Countered:     claim.Countered,
+			Clock:         uint64(claim.Clock.Timestamp.Unix()),
+			ClockDuration: uint64(claim.Clock.Duration / time.Second),
+			RemainingTime: uint64(chessClock.RemainingToCounter(claim.Clock, parentClock, now) / time.Second),
+		})
+	}
+	return result, nil
+}
+
+// ResolveGame resolves the game if it can be resolved.
+// Returns [ErrUnknownGame] without sending a transaction if the game was not created by the dispute game factory.
+func (b *adminBackend) ResolveGame(ctx context.Context, game common.Address) (types.GameStatus, error) {
+	if err := b.games.CheckGame(ctx, game); err != nil {
+		return types.GameStatusInProgress, err
+	}
+	resolver, err := b.createResolver(game)
+	if err != nil {
+		return types.GameStatusInProgress, fmt.Errorf("failed to create resolver for game %v: %w", game, err)
+	}
+	status, err := resolver.CallResolve(ctx)
+	if err != nil {
+		return types.GameStatusInProgress, fmt.Errorf("game %v cannot be resolved: %w", game, err)
+	}
+	b.logger.Info("Resolving game requested through admin API", "game", game, "status", status)
+	if err := resolver.Resolve(ctx); err != nil {
+		return types.GameStatusInProgress, fmt.Errorf("failed to resolve game %v: %w", game, err)
+	}
+	return status, nil
+}
+
+// Diagnostics returns the diagnostic bundles recorded for claims in the game that the challenger disagrees with.
+// Bundles are only available while the game's data is retained.
+func (b *adminBackend) Diagnostics(_ context.Context, game common.Address) ([]diagnostics.Bundle, error) {
+	bundles, err := diagnostics.Load(b.gameDir(game))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load diagnostics for game %v: %w", game, err)
+	}
+	return bundles, nil
+}
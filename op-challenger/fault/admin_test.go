@@ -0,0 +1,208 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminPause(t *testing.T) {
+	pause := &adminPause{}
+	require.False(t, pause.Paused())
+	pause.PauseScheduler()
+	require.True(t, pause.Paused())
+	require.True(t, pause.SchedulerPaused())
+	pause.ResumeScheduler()
+	require.False(t, pause.Paused())
+}
+
+func TestAdminBackend_Wallet(t *testing.T) {
+	backend, client, _, _ := setupAdminBackendTest(t)
+	client.balance = big.NewInt(123)
+
+	wallet, err := backend.Wallet(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, rpc.Wallet{Address: backend.from, Balance: (*hexutil.Big)(big.NewInt(123))}, wallet)
+	require.Equal(t, backend.from, client.account)
+
+	client.err = errors.New("boom")
+	_, err = backend.Wallet(context.Background())
+	require.ErrorIs(t, err, client.err)
+}
+
+func TestAdminBackend_Claims(t *testing.T) {
+	backend, _, loader, _ := setupAdminBackendTest(t)
+	game := common.Address{0xaa}
+	root := types.ClaimData{Value: common.Hash{0x01}, Position: positions.NewPositionFromGIndex(big.NewInt(1))}
+	child := types.ClaimData{Value: common.Hash{0x02}, Position: positions.NewPositionFromGIndex(big.NewInt(2))}
+	loader.claims = []types.Claim{
+		{ClaimData: root, Parent: root, Clock: types.NewClock(0, 10)},
+		{ClaimData: child, Parent: root, Countered: true, ContractIndex: 1, ParentContractIndex: 0, Clock: types.NewClock(15, 20)},
+	}
+	loader.duration = 200 * time.Second
+
+	claims, err := backend.Claims(context.Background(), game)
+	require.NoError(t, err)
+	require.Equal(t, game, loader.game)
+	require.Equal(t, []rpc.Claim{
+		{Index: 0, ParentIndex: 0, Value: root.Value, Position: (*hexutil.Big)(big.NewInt(1)), Depth: 0, Clock: 10, ClockDuration: 0, RemainingTime: 10},
+		{Index: 1, ParentIndex: 0, Value: child.Value, Position: (*hexutil.Big)(big.NewInt(2)), Depth: 1, Countered: true, Clock: 20, ClockDuration: 15, RemainingTime: 20},
+	}, claims)
+
+	loader.err = errors.New("boom")
+	_, err = backend.Claims(context.Background(), game)
+	require.ErrorIs(t, err, loader.err)
+}
+
+func TestAdminBackend_ResolveGame(t *testing.T) {
+	game := common.Address{0xaa}
+
+	t.Run("Resolvable", func(t *testing.T) {
+		backend, _, _, resolver := setupAdminBackendTest(t)
+		resolver.status = types.GameStatusChallengerWon
+		status, err := backend.ResolveGame(context.Background(), game)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusChallengerWon, status)
+		require.Equal(t, game, resolver.game)
+		require.Equal(t, 1, resolver.resolveCount)
+	})
+
+	t.Run("UnknownGame", func(t *testing.T) {
+		backend, _, _, resolver := setupAdminBackendTest(t)
+		resolver.status = types.GameStatusChallengerWon
+		_, err := backend.ResolveGame(context.Background(), common.Address{0xbb})
+		require.ErrorIs(t, err, ErrUnknownGame)
+		require.Equal(t, common.Address{}, resolver.game, "should not create resolver")
+		require.Zero(t, resolver.resolveCount, "should not send resolve tx")
+	})
+
+	t.Run("NotResolvable", func(t *testing.T) {
+		backend, _, _, resolver := setupAdminBackendTest(t)
+		resolver.callErr = errors.New("game not over")
+		_, err := backend.ResolveGame(context.Background(), game)
+		require.ErrorIs(t, err, resolver.callErr)
+		require.Zero(t, resolver.resolveCount, "should not send resolve tx")
+	})
+
+	t.Run("ResolveFails", func(t *testing.T) {
+		backend, _, _, resolver := setupAdminBackendTest(t)
+		resolver.resolveErr = errors.New("tx failed")
+		_, err := backend.ResolveGame(context.Background(), game)
+		require.ErrorIs(t, err, resolver.resolveErr)
+	})
+}
+
+func TestAdminBackend_Diagnostics(t *testing.T) {
+	game := common.Address{0xaa}
+
+	t.Run("NoDiagnostics", func(t *testing.T) {
+		backend, _, _, _ := setupAdminBackendTest(t)
+		bundles, err := backend.Diagnostics(context.Background(), game)
+		require.NoError(t, err)
+		require.Empty(t, bundles)
+	})
+
+	t.Run("RecordedDiagnostics", func(t *testing.T) {
+		backend, _, _, _ := setupAdminBackendTest(t)
+		recorder := diagnostics.NewRecorder(testlog.Logger(t, log.LvlInfo), clock.SystemClock, game, backend.gameDir(game), alphabet.NewTraceProvider("abcdefgh", 3), 3)
+		claim := types.Claim{
+			ClaimData:     types.ClaimData{Value: common.Hash{0x01}, Position: positions.NewPosition(1, big.NewInt(0))},
+			ContractIndex: 1,
+		}
+		recorder.RecordDisagreement(context.Background(), claim)
+
+		bundles, err := backend.Diagnostics(context.Background(), game)
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		require.Equal(t, game, bundles[0].Game)
+		require.Equal(t, uint64(1), bundles[0].ClaimIndex)
+		require.Equal(t, claim.Value, bundles[0].TheirValue)
+	})
+}
+
+func setupAdminBackendTest(t *testing.T) (*adminBackend, *stubBalanceReader, *stubAdminLoader, *stubResolver) {
+	client := &stubBalanceReader{}
+	loader := &stubAdminLoader{}
+	resolver := &stubResolver{}
+	cl := clock.NewDeterministicClock(time.Unix(100, 0))
+	datadir := t.TempDir()
+	backend := &adminBackend{
+		statusRegistry: newStatusRegistry(cl),
+		adminPause:     &adminPause{},
+		logger:         testlog.Logger(t, log.LvlInfo),
+		clock:          cl,
+		client:         client,
+		from:           common.Address{0xcc},
+		games:          &stubGameChecker{known: []common.Address{{0xaa}}},
+		createLoader: func(game common.Address) (AdminGameLoader, error) {
+			loader.game = game
+			return loader, nil
+		},
+		createResolver: func(game common.Address) (GameResolver, error) {
+			resolver.game = game
+			return resolver, nil
+		},
+		gameDir: func(game common.Address) string {
+			return filepath.Join(datadir, game.Hex())
+		},
+	}
+	return backend, client, loader, resolver
+}
+
+type stubBalanceReader struct {
+	account common.Address
+	balance *big.Int
+	err     error
+}
+
+func (s *stubBalanceReader) BalanceAt(_ context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	s.account = account
+	return s.balance, s.err
+}
+
+type stubAdminLoader struct {
+	game     common.Address
+	claims   []types.Claim
+	duration time.Duration
+	err      error
+}
+
+func (s *stubAdminLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, s.err
+}
+
+func (s *stubAdminLoader) FetchGameDuration(_ context.Context) (time.Duration, error) {
+	return s.duration, nil
+}
+
+type stubResolver struct {
+	game         common.Address
+	status       types.GameStatus
+	callErr      error
+	resolveErr   error
+	resolveCount int
+}
+
+func (s *stubResolver) CallResolve(_ context.Context) (types.GameStatus, error) {
+	return s.status, s.callErr
+}
+
+func (s *stubResolver) Resolve(_ context.Context) error {
+	s.resolveCount++
+	return s.resolveErr
+}
@@ -4,9 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/corpus"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/mempool"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -19,6 +26,33 @@ type Responder interface {
 	Step(ctx context.Context, stepData types.StepCallData) error
 }
 
+type AgentMetricer interface {
+	RecordDuplicateMoveSkipped()
+	RecordActTimeSliceExhausted()
+}
+
+// StepRecorder records the steps computed by the agent.
+type StepRecorder interface {
+	RecordStep(step corpus.Step)
+}
+
+// LookaheadSource provides the moves against the game that have been broadcast but not yet included in a block.
+type LookaheadSource interface {
+	PendingMoves() []mempool.PendingMove
+}
+
+// DisagreementRecorder records diagnostics for claims the agent disagrees with.
+type DisagreementRecorder interface {
+	RecordDisagreement(ctx context.Context, claim types.Claim)
+}
+
+// AttackDetector is notified of the claims the agent disagrees with so coordinated attacks can be detected, and
+// determines whether resolving the game should be deferred while under attack.
+type AttackDetector interface {
+	RecordHostileClaim(claim types.Claim)
+	DeferResolution() bool
+}
+
 type ClaimLoader interface {
 	FetchClaims(ctx context.Context) ([]types.Claim, error)
 }
@@ -30,10 +64,58 @@ type Agent struct {
 	updater                 types.OracleUpdater
 	maxDepth                int
 	agreeWithProposedOutput bool
+	pause                   SoftPause
+	chessClock              *types.ChessClock
+	steps                   StepRecorder
+	lookahead               LookaheadSource
+	disagreements           DisagreementRecorder
+	attacks                 AttackDetector
+	timeSlice               time.Duration
+	progress                ActProgressStore
+	clock                   clock.Clock
+	metrics                 AgentMetricer
 	log                     log.Logger
+
+	// rootClock is the clock of the game's root claim, once loaded.
+	rootClock *types.Clock
+
+	// posted records the IDs of the claims posted by this agent, to distinguish them from
+	// identical claims already posted by other parties.
+	posted map[common.Hash]bool
+
+	// processed records the contract indices of the claims acted on since the last time every claim in the game was
+	// processed, so an update stopped by the time slice resumes with the remaining claims.
+	// It is loaded from the progress store on the first sliced update.
+	processed map[int]bool
+
+	// cancelPrecompute stops the responses to pending moves being precomputed in the background, and precomputeDone
+	// is closed once they have stopped. Both are nil when no precompute is running.
+	cancelPrecompute context.CancelFunc
+	precomputeDone   chan struct{}
+}
+
+// AgentOptions are the optional collaborators and settings of an [Agent]. Any may be left unset.
+type AgentOptions struct {
+	// Pause defers responses to claims. If nil, responses are never deferred.
+	Pause SoftPause
+	// ChessClock is the game's chess clock. If nil, claim clocks are not checked before moving or resolving.
+	ChessClock *types.ChessClock
+	// Steps records the steps computed by the agent. If nil, steps are not recorded.
+	Steps StepRecorder
+	// Lookahead provides pending moves to precompute responses to. If nil, no responses are precomputed.
+	Lookahead LookaheadSource
+	// Disagreements records diagnostics for disputed claims. If nil, no diagnostics are recorded.
+	Disagreements DisagreementRecorder
+	// Attacks is notified of hostile claims. If nil, hostile claims are not reported and resolution is never deferred.
+	Attacks AttackDetector
+	// TimeSlice limits the time spent in each call to Act. If 0, every claim is processed in each call.
+	TimeSlice time.Duration
+	// Progress stores the claims processed within a time slice. If nil, progress is only tracked in memory.
+	Progress ActProgressStore
 }
 
-func NewAgent(loader ClaimLoader, maxDepth int, trace types.TraceProvider, responder Responder, updater types.OracleUpdater, agreeWithProposedOutput bool, log log.Logger) *Agent {
+// NewAgent creates a new [Agent]. Claim clocks are checked against the current time reported by cl.
+func NewAgent(loader ClaimLoader, maxDepth int, trace types.TraceProvider, responder Responder, updater types.OracleUpdater, agreeWithProposedOutput bool, cl clock.Clock, m AgentMetricer, log log.Logger, opts AgentOptions) *Agent {
 	return &Agent{
 		solver:                  solver.NewSolver(maxDepth, trace),
 		loader:                  loader,
@@ -41,12 +123,29 @@ func NewAgent(loader ClaimLoader, maxDepth int, trace types.TraceProvider, respo
 		updater:                 updater,
 		maxDepth:                maxDepth,
 		agreeWithProposedOutput: agreeWithProposedOutput,
+		pause:                   opts.Pause,
+		chessClock:              opts.ChessClock,
+		steps:                   opts.Steps,
+		lookahead:               opts.Lookahead,
+		disagreements:           opts.Disagreements,
+		attacks:                 opts.Attacks,
+		timeSlice:               opts.TimeSlice,
+		progress:                opts.Progress,
+		clock:                   cl,
+		metrics:                 m,
 		log:                     log,
+		posted:                  make(map[common.Hash]bool),
 	}
 }
 
 // Act iterates the game & performs all of the next actions.
+// If a time slice is set, claims are processed in order of the deadline to counter them and Act returns once the
+// slice is used, even if some claims haven't been processed. The next call resumes with the remaining claims so one
+// game with many claims can't hold a worker for long enough to starve other games.
+// Responses to moves pending in the mempool are precomputed in the background once every claim has been processed, and
+// are cancelled when Act is next called so they never delay responses to included claims.
 func (a *Agent) Act(ctx context.Context) error {
+	a.stopPrecompute()
 	if a.tryResolve(ctx) {
 		return nil
 	}
@@ -54,19 +153,176 @@ func (a *Agent) Act(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("create game from contracts: %w", err)
 	}
+	var completed bool
+	if a.timeSlice > 0 {
+		completed = a.actWithinTimeSlice(ctx, game)
+	} else {
+		completed = a.actOnAllClaims(ctx, game)
+	}
+	if completed {
+		a.startPrecompute(ctx, game)
+	}
+	return nil
+}
+
+// Close stops precomputing responses to pending moves. The agent must not be used after it is closed.
+func (a *Agent) Close() error {
+	a.stopPrecompute()
+	return nil
+}
+
+// startPrecompute precomputes the responses to pending moves in the background until they are complete, ctx is done
+// or the precompute is stopped.
+func (a *Agent) startPrecompute(ctx context.Context, game types.Game) {
+	if a.lookahead == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	a.cancelPrecompute = cancel
+	a.precomputeDone = done
+	go func() {
+		defer close(done)
+		a.precomputePendingMoves(ctx, game)
+	}()
+}
+
+// stopPrecompute cancels any responses to pending moves being precomputed and waits for them to stop, so the trace
+// provider isn't used by the precompute while the agent responds to claims.
+func (a *Agent) stopPrecompute() {
+	if a.cancelPrecompute == nil {
+		return
+	}
+	a.cancelPrecompute()
+	<-a.precomputeDone
+	a.cancelPrecompute = nil
+	a.precomputeDone = nil
+}
+
+// actOnAllClaims counters every claim and then steps on all leaf claims.
+// Returns false if the game is no longer in progress.
+func (a *Agent) actOnAllClaims(ctx context.Context, game types.Game) bool {
 	// Create counter claims
 	for _, claim := range game.Claims() {
-		if err := a.move(ctx, claim, game); err != nil && !errors.Is(err, types.ErrGameDepthReached) {
+		err := a.move(ctx, claim, game)
+		if errors.Is(err, responder.ErrGameNotInProgress) {
+			a.log.Info("Game no longer in progress, skipping remaining moves")
+			return false
+		} else if err != nil && !errors.Is(err, types.ErrGameDepthReached) {
 			log.Error("Failed to move", "err", err)
 		}
 	}
 	// Step on all leaf claims
 	for _, claim := range game.Claims() {
-		if err := a.step(ctx, claim, game); err != nil {
+		err := a.step(ctx, claim, game)
+		if errors.Is(err, responder.ErrGameNotInProgress) {
+			a.log.Info("Game no longer in progress, skipping remaining steps")
+			return false
+		} else if err != nil {
 			log.Error("Failed to step", "err", err)
 		}
 	}
-	return nil
+	return true
+}
+
+// actWithinTimeSlice counters or steps on each claim that hasn't been processed in the current pass, most urgent
+// first, until the time slice is used.
+// Returns false if the time slice was used before every claim was processed or the game is no longer in progress.
+func (a *Agent) actWithinTimeSlice(ctx context.Context, game types.Game) bool {
+	a.loadProgress()
+	claims := a.remainingClaims(game)
+	start := a.clock.Now()
+	for i, claim := range claims {
+		// Always process at least one claim so every game makes progress
+		if i > 0 && a.clock.Now().Sub(start) >= a.timeSlice {
+			a.log.Info("Time slice used, resuming remaining claims on next update", "processed", i, "remaining", len(claims)-i)
+			a.metrics.RecordActTimeSliceExhausted()
+			a.saveProgress()
+			return false
+		}
+		// Counter the claim, or step on it if it is a leaf claim
+		err := a.move(ctx, claim, game)
+		if errors.Is(err, responder.ErrGameNotInProgress) {
+			a.log.Info("Game no longer in progress, skipping remaining moves")
+			return false
+		} else if err != nil && !errors.Is(err, types.ErrGameDepthReached) {
+			log.Error("Failed to move", "err", err)
+		}
+		err = a.step(ctx, claim, game)
+		if errors.Is(err, responder.ErrGameNotInProgress) {
+			a.log.Info("Game no longer in progress, skipping remaining steps")
+			return false
+		} else if err != nil {
+			log.Error("Failed to step", "err", err)
+		}
+		a.processed[claim.ContractIndex] = true
+	}
+	// Every claim has been processed so the next update starts from the most urgent claim again
+	a.processed = make(map[int]bool)
+	a.saveProgress()
+	return true
+}
+
+// loadProgress loads the claims processed in the current pass from the progress store, if not already loaded.
+// If the progress can't be loaded, the pass starts again from the most urgent claim.
+func (a *Agent) loadProgress() {
+	if a.processed != nil {
+		return
+	}
+	a.processed = make(map[int]bool)
+	if a.progress == nil {
+		return
+	}
+	processed, err := a.progress.Load()
+	if err != nil {
+		a.log.Warn("Failed to load act progress, processing every claim", "err", err)
+		return
+	}
+	for _, idx := range processed {
+		a.processed[idx] = true
+	}
+}
+
+// saveProgress stores the claims processed in the current pass, if a progress store is configured.
+func (a *Agent) saveProgress() {
+	if a.progress == nil {
+		return
+	}
+	processed := make([]int, 0, len(a.processed))
+	for idx := range a.processed {
+		processed = append(processed, idx)
+	}
+	sort.Ints(processed)
+	if err := a.progress.Save(processed); err != nil {
+		a.log.Warn("Failed to save act progress", "err", err)
+	}
+}
+
+// remainingClaims returns the claims that haven't been processed since every claim in the game was last processed,
+// ordered by the time remaining to counter them, most urgent first.
+func (a *Agent) remainingClaims(game types.Game) []types.Claim {
+	var claims []types.Claim
+	for _, claim := range game.Claims() {
+		if !a.processed[claim.ContractIndex] {
+			claims = append(claims, claim)
+		}
+	}
+	if a.chessClock == nil {
+		return claims
+	}
+	now := a.clock.Now()
+	remaining := make(map[int]time.Duration, len(claims))
+	for _, claim := range claims {
+		parentClock, err := claimParentClock(claim, game)
+		if err != nil {
+			continue
+		}
+		remaining[claim.ContractIndex] = a.chessClock.RemainingToCounter(claim.Clock, parentClock, now)
+	}
+	sort.SliceStable(claims, func(i, j int) bool {
+		return remaining[claims[i].ContractIndex] < remaining[claims[j].ContractIndex]
+	})
+	return claims
 }
 
 // shouldResolve returns true if the agent should resolve the game.
@@ -85,6 +341,14 @@ func (a *Agent) shouldResolve(ctx context.Context, status types.GameStatus) bool
 // tryResolve resolves the game if it is in a terminal state
 // and returns true if the game resolves successfully.
 func (a *Agent) tryResolve(ctx context.Context) bool {
+	if a.chessClock != nil && a.rootClock != nil && !a.chessClock.Expired(*a.rootClock, a.clock.Now()) {
+		// No clock in the game expires before the root claim's clock, so the game can't be resolved yet.
+		return false
+	}
+	if a.attacks != nil && a.attacks.DeferResolution() {
+		a.log.Debug("Under attack, deferring resolution")
+		return false
+	}
 	status, err := a.responder.CallResolve(ctx)
 	if err != nil {
 		return false
@@ -109,6 +373,7 @@ func (a *Agent) newGameFromContracts(ctx context.Context) (types.Game, error) {
 	if len(claims) == 0 {
 		return nil, errors.New("no claims")
 	}
+	a.rootClock = &claims[0].Clock
 	game := types.NewGameState(a.agreeWithProposedOutput, claims[0], uint64(a.maxDepth))
 	if err := game.PutAll(claims[1:]); err != nil {
 		return nil, fmt.Errorf("failed to load claims into the local state: %w", err)
@@ -116,8 +381,68 @@ func (a *Agent) newGameFromContracts(ctx context.Context) (types.Game, error) {
 	return game, nil
 }
 
+// deferred returns true if responding to the claim should be deferred because the challenger is paused.
+func (a *Agent) deferred(claim types.Claim) bool {
+	if a.pause == nil || !a.pause.DeferClaim(claim) {
+		return false
+	}
+	a.log.Debug("Paused, deferring response to claim", "depth", claim.Depth(), "index_at_depth", claim.IndexAtDepth())
+	return true
+}
+
+// counterExpired returns true if the clock to counter the claim has expired, so any move against it would revert.
+func (a *Agent) counterExpired(claim types.Claim, game types.Game) bool {
+	if a.chessClock == nil {
+		return false
+	}
+	parentClock, err := claimParentClock(claim, game)
+	if err != nil {
+		return false
+	}
+	if a.chessClock.CanCounter(claim.Clock, parentClock, a.clock.Now()) {
+		return false
+	}
+	a.log.Debug("Clock expired, unable to counter claim", "depth", claim.Depth(), "index_at_depth", claim.IndexAtDepth())
+	return true
+}
+
+// withClockDeadline returns a copy of ctx carrying the time the clock to counter the claim expires, so the responder
+// can escalate the transaction countering it if it is stuck.
+func (a *Agent) withClockDeadline(ctx context.Context, claim types.Claim, game types.Game) context.Context {
+	if a.chessClock == nil {
+		return ctx
+	}
+	parentClock, err := claimParentClock(claim, game)
+	if err != nil {
+		return ctx
+	}
+	now := a.clock.Now()
+	return responder.WithClockDeadline(ctx, now.Add(a.chessClock.RemainingToCounter(claim.Clock, parentClock, now)))
+}
+
+// claimParentClock returns the clock of the claim's parent or nil if the claim is the root claim.
+func claimParentClock(claim types.Claim, game types.Game) (*types.Clock, error) {
+	if claim.IsRoot() {
+		return nil, nil
+	}
+	parent, err := game.GetParent(claim)
+	if err != nil {
+		return nil, err
+	}
+	return &parent.Clock, nil
+}
+
 // move determines & executes the next move given a claim
 func (a *Agent) move(ctx context.Context, claim types.Claim, game types.Game) error {
+	if game.AgreeWithClaimLevel(claim) {
+		return nil
+	}
+	if a.attacks != nil {
+		a.attacks.RecordHostileClaim(claim)
+	}
+	if a.deferred(claim) || a.counterExpired(claim, game) {
+		return nil
+	}
 	nextMove, err := a.solver.NextMove(ctx, claim, game.AgreeWithClaimLevel(claim))
 	if err != nil {
 		return fmt.Errorf("execute next move: %w", err)
@@ -127,15 +452,37 @@ func (a *Agent) move(ctx context.Context, claim types.Claim, game types.Game) er
 		return nil
 	}
 	move := *nextMove
+	if !move.DefendsParent() {
+		a.recordDisagreement(ctx, claim)
+	}
 	log := a.log.New("is_defend", move.DefendsParent(), "depth", move.Depth(), "index_at_depth", move.IndexAtDepth(),
 		"value", move.Value, "trace_index", move.TraceIndex(a.maxDepth),
 		"parent_value", claim.Value, "parent_trace_index", claim.TraceIndex(a.maxDepth))
 	if game.IsDuplicate(move) {
-		log.Debug("Skipping duplicate move")
+		a.skipDuplicate(log, move)
 		return nil
 	}
 	log.Info("Performing move")
-	return a.responder.Respond(ctx, move)
+	if err := a.responder.Respond(a.withClockDeadline(ctx, claim, game), move); err != nil {
+		return err
+	}
+	a.posted[move.ID()] = true
+	return nil
+}
+
+// skipDuplicate records that the move was skipped because an identical claim already exists in the game.
+// Claims posted by this agent are expected to be duplicates once they are included so are only logged at debug.
+// Otherwise another party has already made the move, saving the bond and gas required to post it again.
+// Claims posted before the challenger restarted are treated as posted by another party.
+func (a *Agent) skipDuplicate(log log.Logger, move types.Claim) {
+	if a.posted[move.ID()] {
+		log.Debug("Skipping duplicate move")
+		return
+	}
+	log.Info("Claim already countered by an identical claim from another party, skipping move")
+	a.metrics.RecordDuplicateMoveSkipped()
+	// Only count the skipped move once
+	a.posted[move.ID()] = true
 }
 
 // step determines & executes the next step against a leaf claim through the responder
@@ -155,6 +502,10 @@ func (a *Agent) step(ctx context.Context, claim types.Claim, game types.Game) er
 		return nil
 	}
 
+	if a.deferred(claim) {
+		return nil
+	}
+
 	a.log.Info("Attempting step", "claim_depth", claim.Depth(), "maxDepth", a.maxDepth)
 	step, err := a.solver.AttemptStep(ctx, claim, agreeWithClaimLevel)
 	if err != nil {
@@ -168,6 +519,9 @@ func (a *Agent) step(ctx context.Context, claim types.Claim, game types.Game) er
 		}
 	}
 
+	if step.IsAttack {
+		a.recordDisagreement(ctx, claim)
+	}
 	a.log.Info("Performing step", "is_attack", step.IsAttack,
 		"depth", step.LeafClaim.Depth(), "index_at_depth", step.LeafClaim.IndexAtDepth(), "value", step.LeafClaim.Value)
 	callData := types.StepCallData{
@@ -176,5 +530,90 @@ func (a *Agent) step(ctx context.Context, claim types.Claim, game types.Game) er
 		StateData:  step.PreState,
 		Proof:      step.ProofData,
 	}
-	return a.responder.Step(ctx, callData)
+	a.recordStep(ctx, step)
+	return a.responder.Step(a.withClockDeadline(ctx, claim, game), callData)
+}
+
+// recordDisagreement records diagnostics for a claim the agent disagrees with, if a recorder is configured.
+func (a *Agent) recordDisagreement(ctx context.Context, claim types.Claim) {
+	if a.disagreements == nil {
+		return
+	}
+	a.disagreements.RecordDisagreement(ctx, claim)
+}
+
+// recordStep records the step and the post-state it is expected to result in, if a step recorder is configured.
+func (a *Agent) recordStep(ctx context.Context, step solver.StepData) {
+	if a.steps == nil {
+		return
+	}
+	postState, err := a.solver.StepPostState(ctx, step)
+	if err != nil {
+		a.log.Warn("Unable to record step, failed to get post-state", "err", err)
+		return
+	}
+	a.steps.RecordStep(corpus.Step{
+		ClaimIndex: uint64(step.LeafClaim.ContractIndex),
+		IsAttack:   step.IsAttack,
+		PreState:   step.PreState,
+		Proof:      step.ProofData,
+		OracleData: step.OracleData,
+		PostState:  postState,
+	})
+}
+
+// precomputePendingMoves computes the responses to opponent moves that are waiting in the mempool, so the trace data
+// required to counter them is already available when they are included in a block.
+// The responses are not sent as the pending moves may never be included.
+func (a *Agent) precomputePendingMoves(ctx context.Context, game types.Game) {
+	if a.lookahead == nil {
+		return
+	}
+	for _, pending := range a.lookahead.PendingMoves() {
+		if ctx.Err() != nil {
+			return
+		}
+		claim, ok := pendingClaim(game, pending)
+		if !ok || game.IsDuplicate(claim) || game.AgreeWithClaimLevel(claim) || a.deferred(claim) {
+			continue
+		}
+		log := a.log.New("tx", pending.TxHash, "depth", claim.Depth(), "index_at_depth", claim.IndexAtDepth(), "value", claim.Value)
+		var err error
+		if claim.Depth() == a.maxDepth {
+			_, err = a.solver.AttemptStep(ctx, claim, false)
+		} else {
+			_, err = a.solver.NextMove(ctx, claim, false)
+		}
+		if ctx.Err() != nil {
+			log.Debug("Stopped precomputing responses to pending moves")
+			return
+		} else if err != nil {
+			log.Warn("Failed to precompute response to pending move", "err", err)
+			continue
+		}
+		log.Debug("Precomputed response to pending move")
+	}
+}
+
+// pendingClaim returns the claim that the pending move will add to the game once included.
+// Returns false if the move's parent is not in the game or the move would be rejected.
+func pendingClaim(game types.Game, pending mempool.PendingMove) (types.Claim, bool) {
+	for _, parent := range game.Claims() {
+		if uint64(parent.ContractIndex) != pending.ParentIndex {
+			continue
+		}
+		position := parent.Position.Attack()
+		if !pending.IsAttack {
+			if parent.IsRoot() {
+				return types.Claim{}, false
+			}
+			position = parent.Position.Defend()
+		}
+		return types.Claim{
+			ClaimData:           types.ClaimData{Value: pending.Claim, Position: position},
+			Parent:              parent.ClaimData,
+			ParentContractIndex: parent.ContractIndex,
+		}, true
+	}
+	return types.Claim{}, false
 }
@@ -2,13 +2,25 @@ package fault
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/corpus"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/mempool"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 )
 
 // TestShouldResolve tests the resolution logic.
@@ -16,16 +28,594 @@ func TestShouldResolve(t *testing.T) {
 	log := testlog.Logger(t, log.LvlCrit)
 
 	t.Run("AgreeWithProposedOutput", func(t *testing.T) {
-		agent := NewAgent(nil, 0, nil, nil, nil, true, log)
+		agent := NewAgent(nil, 0, nil, nil, nil, true, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
 		require.False(t, agent.shouldResolve(context.Background(), types.GameStatusDefenderWon))
 		require.True(t, agent.shouldResolve(context.Background(), types.GameStatusChallengerWon))
 		require.False(t, agent.shouldResolve(context.Background(), types.GameStatusInProgress))
 	})
 
 	t.Run("DisagreeWithProposedOutput", func(t *testing.T) {
-		agent := NewAgent(nil, 0, nil, nil, nil, false, log)
+		agent := NewAgent(nil, 0, nil, nil, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
 		require.True(t, agent.shouldResolve(context.Background(), types.GameStatusDefenderWon))
 		require.False(t, agent.shouldResolve(context.Background(), types.GameStatusChallengerWon))
 		require.False(t, agent.shouldResolve(context.Background(), types.GameStatusInProgress))
 	})
 }
+
+func TestActStopsWhenGameNotInProgress(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(false)
+	first := builder.AttackClaim(root, true)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, false)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	third := builder.DefendClaim(first, false)
+	third.ContractIndex = 3
+	third.ParentContractIndex = 1
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second, third}}
+
+	t.Run("RespondsToAllClaims", func(t *testing.T) {
+		resp := &stubResponder{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 2, resp.responses)
+	})
+
+	t.Run("DefersWhenPaused", func(t *testing.T) {
+		resp := &stubResponder{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Pause: &stubSoftPause{deferAll: true}})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 0, resp.responses)
+	})
+
+	t.Run("StopsAfterGameNotInProgress", func(t *testing.T) {
+		resp := &stubResponder{respondErr: responder.ErrGameNotInProgress}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.responses)
+	})
+}
+
+func TestSkipMovesAlreadyPostedByOthers(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(false)
+	counter := builder.AttackClaim(root, true)
+	counter.ContractIndex = 1
+
+	t.Run("PostedByOtherParty", func(t *testing.T) {
+		loader := &stubClaimLoader{claims: []types.Claim{root, counter}}
+		resp := &stubResponder{}
+		m := &stubAgentMetrics{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.SystemClock, m, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 0, resp.responses, "should not post duplicate counter")
+		require.Equal(t, 1, m.duplicatesSkipped)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 0, resp.responses)
+		require.Equal(t, 1, m.duplicatesSkipped, "should only count skipped move once")
+	})
+
+	t.Run("PostedByAgent", func(t *testing.T) {
+		loader := &stubClaimLoader{claims: []types.Claim{root}}
+		resp := &stubResponder{}
+		m := &stubAgentMetrics{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.SystemClock, m, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.responses)
+
+		// Our counter is now included in the game
+		loader.claims = []types.Claim{root, counter}
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.responses)
+		require.Zero(t, m.duplicatesSkipped, "should not count our own moves")
+	})
+}
+
+func TestChessClockChecks(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(false)
+	root.Clock = types.NewClock(0, 1000)
+	chessClock := types.NewChessClock(200 * time.Second)
+
+	setup := func(now int64) (*Agent, *stubResponder, *clock.DeterministicClock) {
+		loader := &stubClaimLoader{claims: []types.Claim{root}}
+		resp := &stubResponder{}
+		cl := clock.NewDeterministicClock(time.Unix(now, 0))
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, cl, metrics.NoopMetrics, log, AgentOptions{ChessClock: &chessClock})
+		return agent, resp, cl
+	}
+
+	t.Run("CounterBeforeClockExpires", func(t *testing.T) {
+		agent, resp, _ := setup(1100)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.responses)
+	})
+
+	t.Run("PassClockDeadline", func(t *testing.T) {
+		agent, resp, _ := setup(1050)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.responses)
+		require.NotNil(t, resp.deadline)
+		require.Equal(t, time.Unix(1100, 0), *resp.deadline)
+	})
+
+	t.Run("SkipCounterAfterClockExpires", func(t *testing.T) {
+		agent, resp, _ := setup(1101)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Zero(t, resp.responses)
+	})
+
+	t.Run("SkipResolveUntilRootClockExpires", func(t *testing.T) {
+		agent, resp, cl := setup(1050)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.callResolves, "should check resolution before root clock is known")
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.callResolves, "should not check resolution before root clock expires")
+
+		cl.AdvanceTime(51 * time.Second)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 2, resp.callResolves, "should check resolution after root clock expires")
+	})
+
+	t.Run("NoChessClock", func(t *testing.T) {
+		loader := &stubClaimLoader{claims: []types.Claim{root}}
+		resp := &stubResponder{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, clock.NewDeterministicClock(time.Unix(5000, 0)), metrics.NoopMetrics, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 2, resp.callResolves)
+		require.Equal(t, 2, resp.responses)
+		require.Nil(t, resp.deadline, "should not pass a clock deadline without a chess clock")
+	})
+}
+
+func TestActTimeSlice(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(false)
+	root.Clock = types.NewClock(0, 900)
+	first := builder.AttackClaim(root, true)
+	first.ContractIndex = 1
+	first.Clock = types.NewClock(0, 950)
+	second := builder.AttackClaim(first, false)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	second.Clock = types.NewClock(0, 970)
+	third := builder.DefendClaim(first, false)
+	third.ContractIndex = 3
+	third.ParentContractIndex = 1
+	third.Clock = types.NewClock(0, 960)
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second, third}}
+	chessClock := types.NewChessClock(2000 * time.Second)
+
+	setupWithProgress := func(timeSlice time.Duration, progress ActProgressStore) (*Agent, *timedResponder, *stubAgentMetrics) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		resp := &timedResponder{stubResponder: &stubResponder{}, clock: cl, delay: 10 * time.Second}
+		m := &stubAgentMetrics{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, true, cl, m, log, AgentOptions{ChessClock: &chessClock, TimeSlice: timeSlice, Progress: progress})
+		return agent, resp, m
+	}
+	setup := func(timeSlice time.Duration) (*Agent, *timedResponder, *stubAgentMetrics) {
+		return setupWithProgress(timeSlice, nil)
+	}
+
+	t.Run("Unlimited", func(t *testing.T) {
+		agent, resp, m := setup(0)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{2, 3}, resp.countered, "should process claims in game order")
+		require.Zero(t, m.slicesExhausted)
+	})
+
+	t.Run("ResumeAfterRecreated", func(t *testing.T) {
+		progress := newFileActProgress(t.TempDir())
+		agent, resp, _ := setupWithProgress(10*time.Second, progress)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{3}, resp.countered)
+
+		agent, resp, _ = setupWithProgress(10*time.Second, progress)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{2}, resp.countered, "should resume with the claims not processed by the previous agent")
+
+		processed, err := progress.Load()
+		require.NoError(t, err)
+		require.Empty(t, processed, "should clear progress once every claim was processed")
+	})
+
+	t.Run("ResumeRemainingClaims", func(t *testing.T) {
+		agent, resp, m := setup(10 * time.Second)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{3}, resp.countered, "should counter the most urgent claim first")
+		require.Equal(t, 1, m.slicesExhausted)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{3, 2}, resp.countered, "should resume with the remaining claim")
+		require.Equal(t, 1, m.slicesExhausted)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{3, 2, 3}, resp.countered, "should start again once every claim was processed")
+		require.Equal(t, 2, m.slicesExhausted)
+	})
+
+	t.Run("ProcessAtLeastOneClaim", func(t *testing.T) {
+		agent, resp, _ := setup(time.Nanosecond)
+		require.NoError(t, agent.Act(context.Background()))
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []int{3, 2}, resp.countered)
+	})
+}
+
+func TestActMovesBeforeStepsWithoutTimeSlice(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, true)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	leaf := builder.AttackClaim(second, false)
+	leaf.ContractIndex = 3
+	leaf.ParentContractIndex = 2
+	// A claim after the leaf in game order that needs to be countered
+	other := builder.AttackClaim(root, true)
+	other.ContractIndex = 4
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second, leaf, other}}
+
+	resp := &orderedResponder{}
+	agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, []string{"move", "step"}, resp.actions, "should counter the later claim before stepping on the leaf")
+}
+
+// orderedResponder records the order moves and steps are sent in.
+type orderedResponder struct {
+	stubResponder
+	actions []string
+}
+
+func (s *orderedResponder) Respond(ctx context.Context, response types.Claim) error {
+	s.actions = append(s.actions, "move")
+	return s.stubResponder.Respond(ctx, response)
+}
+
+func (s *orderedResponder) Step(ctx context.Context, stepData types.StepCallData) error {
+	s.actions = append(s.actions, "step")
+	return s.stubResponder.Step(ctx, stepData)
+}
+
+// timedResponder records the claims countered and advances the clock as each response is sent.
+type timedResponder struct {
+	*stubResponder
+	clock     *clock.DeterministicClock
+	delay     time.Duration
+	countered []int
+}
+
+func (s *timedResponder) Respond(ctx context.Context, response types.Claim) error {
+	s.countered = append(s.countered, response.ParentContractIndex)
+	s.clock.AdvanceTime(s.delay)
+	return s.stubResponder.Respond(ctx, response)
+}
+
+func TestRecordSteps(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, true)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	leaf := builder.AttackClaim(second, false)
+	leaf.ContractIndex = 3
+	leaf.ParentContractIndex = 2
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second, leaf}}
+
+	t.Run("RecordsStep", func(t *testing.T) {
+		resp := &stubResponder{}
+		steps := &stubStepRecorder{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Steps: steps})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.steps)
+		require.Len(t, steps.recorded, 1)
+		recorded := steps.recorded[0]
+		require.Equal(t, uint64(3), recorded.ClaimIndex)
+		require.True(t, recorded.IsAttack)
+		traceIndex := leaf.TraceIndex(maxDepth).Uint64()
+		require.Equal(t, builder.CorrectPreState(traceIndex), recorded.PreState)
+		require.Equal(t, builder.CorrectClaim(traceIndex), recorded.PostState)
+	})
+
+	t.Run("NoRecorder", func(t *testing.T) {
+		resp := &stubResponder{}
+		agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{})
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, resp.steps)
+	})
+}
+
+func TestRecordDisagreements(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, true)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	leaf := builder.AttackClaim(second, false)
+	leaf.ContractIndex = 3
+	leaf.ParentContractIndex = 2
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second, leaf}}
+
+	resp := &stubResponder{}
+	disagreements := &stubDisagreementRecorder{}
+	agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Disagreements: disagreements})
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, []int{1, 3}, disagreements.recorded, "should record the incorrect claims that are attacked")
+}
+
+func TestRecordHostileClaimsUnderAttack(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, true)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	loader := &stubClaimLoader{claims: []types.Claim{root, first, second}}
+
+	resp := &stubResponder{}
+	attacks := &stubAttackDetector{deferResolution: true}
+	agent := NewAgent(loader, maxDepth, builder.CorrectTraceProvider(), resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Attacks: attacks})
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, []int{1}, attacks.hostile, "should record the incorrect claims")
+	require.Zero(t, resp.callResolves, "should defer resolution")
+}
+
+type stubAttackDetector struct {
+	hostile         []int
+	deferResolution bool
+}
+
+func (s *stubAttackDetector) RecordHostileClaim(claim types.Claim) {
+	s.hostile = append(s.hostile, claim.ContractIndex)
+}
+
+func (s *stubAttackDetector) DeferResolution() bool {
+	return s.deferResolution
+}
+
+func TestPrecomputePendingMoves(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+	second := builder.AttackClaim(first, true)
+	second.ContractIndex = 2
+	second.ParentContractIndex = 1
+	leaf := builder.AttackClaim(second, false)
+
+	setup := func(claims []types.Claim, pending ...mempool.PendingMove) (*Agent, *stubResponder, *recordingTraceProvider) {
+		resp := &stubResponder{}
+		trace := &recordingTraceProvider{TraceProvider: builder.CorrectTraceProvider()}
+		lookahead := &stubLookahead{moves: pending}
+		agent := NewAgent(&stubClaimLoader{claims: claims}, maxDepth, trace, resp, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Lookahead: lookahead})
+		return agent, resp, trace
+	}
+
+	t.Run("PrecomputeMove", func(t *testing.T) {
+		agent, resp, trace := setup([]types.Claim{root}, mempool.PendingMove{ParentIndex: 0, Claim: first.Value, IsAttack: true})
+		require.NoError(t, agent.Act(context.Background()))
+		waitForPrecompute(agent)
+		require.Zero(t, resp.responses, "should not respond to pending moves")
+		require.Contains(t, trace.gets, first.Position.Attack().TraceIndex(maxDepth).Uint64())
+	})
+
+	t.Run("PrecomputeStep", func(t *testing.T) {
+		agent, resp, trace := setup([]types.Claim{root, first, second}, mempool.PendingMove{ParentIndex: 2, Claim: leaf.Value, IsAttack: true})
+		require.NoError(t, agent.Act(context.Background()))
+		waitForPrecompute(agent)
+		require.Zero(t, resp.steps, "should not step on pending moves")
+		require.Contains(t, trace.steps, leaf.TraceIndex(maxDepth).Uint64())
+	})
+
+	t.Run("IgnoreIncludedMoves", func(t *testing.T) {
+		agent, _, trace := setup([]types.Claim{root, first}, mempool.PendingMove{ParentIndex: 0, Claim: first.Value, IsAttack: true})
+		withoutLookahead := &recordingTraceProvider{TraceProvider: builder.CorrectTraceProvider()}
+		require.NoError(t, agent.Act(context.Background()))
+		waitForPrecompute(agent)
+		require.NoError(t, NewAgent(&stubClaimLoader{claims: []types.Claim{root, first}}, maxDepth, withoutLookahead, &stubResponder{}, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{}).Act(context.Background()))
+		require.Equal(t, withoutLookahead.gets, trace.gets)
+	})
+
+	t.Run("CancelOnNextAct", func(t *testing.T) {
+		trace := &blockingTraceProvider{TraceProvider: builder.CorrectTraceProvider(), blocked: make(chan struct{})}
+		lookahead := &blockingLookahead{moves: []mempool.PendingMove{{ParentIndex: 0, Claim: first.Value, IsAttack: true}}, trace: trace}
+		agent := NewAgent(&stubClaimLoader{claims: []types.Claim{root}}, maxDepth, trace, &stubResponder{}, nil, false, clock.SystemClock, metrics.NoopMetrics, log, AgentOptions{Lookahead: lookahead})
+		require.NoError(t, agent.Act(context.Background()))
+		select {
+		case <-trace.blocked:
+		case <-time.After(10 * time.Second):
+			t.Fatal("pending move not precomputed")
+		}
+		// Act returns without waiting for the precompute, and the next Act cancels it before responding to claims
+		require.NoError(t, agent.Act(context.Background()))
+		require.True(t, trace.cancelled.Load())
+		require.NoError(t, agent.Close())
+	})
+
+	t.Run("IgnoreUnknownParent", func(t *testing.T) {
+		agent, _, trace := setup([]types.Claim{root}, mempool.PendingMove{ParentIndex: 5, Claim: first.Value, IsAttack: true})
+		require.NoError(t, agent.Act(context.Background()))
+		waitForPrecompute(agent)
+		require.Empty(t, trace.gets)
+	})
+
+	t.Run("IgnoreDefendRoot", func(t *testing.T) {
+		agent, _, trace := setup([]types.Claim{root}, mempool.PendingMove{ParentIndex: 0, Claim: first.Value, IsAttack: false})
+		require.NoError(t, agent.Act(context.Background()))
+		waitForPrecompute(agent)
+		require.Empty(t, trace.gets)
+	})
+}
+
+// waitForPrecompute waits for the responses to pending moves being precomputed in the background to complete.
+func waitForPrecompute(agent *Agent) {
+	if agent.precomputeDone != nil {
+		<-agent.precomputeDone
+	}
+}
+
+type stubLookahead struct {
+	moves []mempool.PendingMove
+}
+
+func (s *stubLookahead) PendingMoves() []mempool.PendingMove {
+	return s.moves
+}
+
+// blockingLookahead makes the trace provider block until cancelled once pending moves are requested.
+type blockingLookahead struct {
+	moves []mempool.PendingMove
+	trace *blockingTraceProvider
+}
+
+func (s *blockingLookahead) PendingMoves() []mempool.PendingMove {
+	s.trace.block.Store(true)
+	return s.moves
+}
+
+type blockingTraceProvider struct {
+	types.TraceProvider
+	block     atomic.Bool
+	cancelled atomic.Bool
+	blocked   chan struct{}
+	once      sync.Once
+}
+
+func (b *blockingTraceProvider) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	if !b.block.Load() {
+		return b.TraceProvider.Get(ctx, i)
+	}
+	b.once.Do(func() { close(b.blocked) })
+	<-ctx.Done()
+	b.block.Store(false)
+	b.cancelled.Store(true)
+	return common.Hash{}, ctx.Err()
+}
+
+type recordingTraceProvider struct {
+	types.TraceProvider
+	gets  []uint64
+	steps []uint64
+}
+
+func (r *recordingTraceProvider) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	r.gets = append(r.gets, i)
+	return r.TraceProvider.Get(ctx, i)
+}
+
+func (r *recordingTraceProvider) GetStepData(ctx context.Context, i uint64) ([]byte, []byte, *types.PreimageOracleData, error) {
+	r.steps = append(r.steps, i)
+	return r.TraceProvider.GetStepData(ctx, i)
+}
+
+type stubStepRecorder struct {
+	recorded []corpus.Step
+}
+
+func (s *stubStepRecorder) RecordStep(step corpus.Step) {
+	s.recorded = append(s.recorded, step)
+}
+
+type stubDisagreementRecorder struct {
+	recorded []int
+}
+
+func (s *stubDisagreementRecorder) RecordDisagreement(_ context.Context, claim types.Claim) {
+	s.recorded = append(s.recorded, claim.ContractIndex)
+}
+
+type stubAgentMetrics struct {
+	duplicatesSkipped int
+	slicesExhausted   int
+}
+
+func (s *stubAgentMetrics) RecordDuplicateMoveSkipped() {
+	s.duplicatesSkipped++
+}
+
+func (s *stubAgentMetrics) RecordActTimeSliceExhausted() {
+	s.slicesExhausted++
+}
+
+type stubClaimLoader struct {
+	claims []types.Claim
+}
+
+func (s *stubClaimLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, nil
+}
+
+type stubResponder struct {
+	responses    int
+	steps        int
+	callResolves int
+	respondErr   error
+	// deadline is the clock deadline passed with the last move or step, if any
+	deadline *time.Time
+}
+
+func (s *stubResponder) CallResolve(_ context.Context) (types.GameStatus, error) {
+	s.callResolves++
+	return types.GameStatusInProgress, errors.New("not resolvable")
+}
+
+func (s *stubResponder) Resolve(_ context.Context) error {
+	return nil
+}
+
+func (s *stubResponder) Respond(ctx context.Context, _ types.Claim) error {
+	s.responses++
+	s.recordDeadline(ctx)
+	return s.respondErr
+}
+
+func (s *stubResponder) Step(ctx context.Context, _ types.StepCallData) error {
+	s.steps++
+	s.recordDeadline(ctx)
+	return nil
+}
+
+func (s *stubResponder) recordDeadline(ctx context.Context) {
+	s.deadline = nil
+	if deadline, ok := responder.ClockDeadline(ctx); ok {
+		s.deadline = &deadline
+	}
+}
+
+type stubSoftPause struct {
+	deferAll bool
+}
+
+func (s *stubSoftPause) DeferClaim(_ types.Claim) bool {
+	return s.deferAll
+}
@@ -0,0 +1,152 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// DefaultRepeatInterval is the minimum time between repeated alerts for the same condition.
+	DefaultRepeatInterval = time.Hour
+
+	// queueSize is the number of alerts that may be waiting to be sent before further alerts are dropped.
+	queueSize = 100
+	// maxAttempts is the number of times each alert is sent to a destination before giving up.
+	maxAttempts = 3
+	// requestTimeout is the maximum time to wait for a destination to accept a single alert.
+	requestTimeout = 30 * time.Second
+)
+
+// Kind identifies the condition an alert is raised for.
+type Kind string
+
+const (
+	KindPrestateMismatch Kind = "prestate_mismatch"
+	KindGameLost         Kind = "game_lost"
+	KindStuckTx          Kind = "stuck_tx"
+	KindLowBalance       Kind = "low_balance"
+	KindPanicMode        Kind = "panic_mode"
+)
+
+// Alert describes an operational emergency that requires attention from an operator.
+type Alert struct {
+	Kind Kind
+	// Key identifies the specific occurrence of the condition, such as the affected game.
+	// Alerts with the same kind and key are only sent once per repeat interval.
+	Key     string
+	Summary string
+	Details map[string]string
+}
+
+// id returns the identifier used to deduplicate the alert.
+func (a Alert) id() string {
+	return string(a.Kind) + ":" + a.Key
+}
+
+// Alerter raises alerts for critical conditions.
+// Alerts are sent asynchronously so raising an alert never blocks the caller.
+type Alerter interface {
+	Alert(alert Alert)
+}
+
+// NoopAlerter discards all alerts.
+var NoopAlerter Alerter = noopAlerter{}
+
+type noopAlerter struct{}
+
+func (noopAlerter) Alert(_ Alert) {}
+
+// Sender delivers alerts to an external destination.
+type Sender interface {
+	// Name identifies the destination in logs.
+	Name() string
+	Send(ctx context.Context, alert Alert) error
+}
+
+// Dispatcher sends alerts to every configured destination in the background.
+// Repeated alerts for the same condition are suppressed until the repeat interval has passed.
+type Dispatcher struct {
+	logger         log.Logger
+	clock          clock.Clock
+	senders        []Sender
+	repeatInterval time.Duration
+	strategy       retry.Strategy
+	queue          chan Alert
+
+	mu       sync.Mutex
+	lastSent map[string]time.Time
+}
+
+// NewDispatcher creates a new [Dispatcher] sending alerts to each of the senders.
+func NewDispatcher(logger log.Logger, cl clock.Clock, repeatInterval time.Duration, senders ...Sender) *Dispatcher {
+	return &Dispatcher{
+		logger:         logger.New("component", "alerts"),
+		clock:          cl,
+		senders:        senders,
+		repeatInterval: repeatInterval,
+		strategy:       retry.Exponential(),
+		queue:          make(chan Alert, queueSize),
+		lastSent:       make(map[string]time.Time),
+	}
+}
+
+// Alert queues the alert to be sent, unless an alert for the same condition was raised within the repeat interval.
+func (d *Dispatcher) Alert(alert Alert) {
+	if !d.shouldSend(alert) {
+		d.logger.Debug("Suppressing repeated alert", "kind", alert.Kind, "key", alert.Key)
+		return
+	}
+	select {
+	case d.queue <- alert:
+	default:
+		d.logger.Error("Alert queue full, dropping alert", "kind", alert.Kind, "key", alert.Key, "summary", alert.Summary)
+	}
+}
+
+func (d *Dispatcher) shouldSend(alert Alert) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.clock.Now()
+	if last, ok := d.lastSent[alert.id()]; ok && now.Sub(last) < d.repeatInterval {
+		return false
+	}
+	d.lastSent[alert.id()] = now
+	return true
+}
+
+// Start sends queued alerts in the background until ctx is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	go d.loop(ctx)
+}
+
+func (d *Dispatcher) loop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case alert := <-d.queue:
+			d.send(ctx, alert)
+		}
+	}
+}
+
+// send delivers the alert to each destination, retrying failures.
+func (d *Dispatcher) send(ctx context.Context, alert Alert) {
+	for _, sender := range d.senders {
+		_, err := retry.Do(ctx, maxAttempts, d.strategy, func() (struct{}, error) {
+			sendCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+			defer cancel()
+			return struct{}{}, sender.Send(sendCtx, alert)
+		})
+		if err != nil {
+			d.logger.Error("Failed to send alert", "destination", sender.Name(), "kind", alert.Kind, "key", alert.Key, "err", err)
+			continue
+		}
+		d.logger.Info("Sent alert", "destination", sender.Name(), "kind", alert.Kind, "key", alert.Key)
+	}
+}
@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcherSuppressesRepeatedAlerts(t *testing.T) {
+	d, cl, _ := setupDispatcherTest(t)
+	lost := Alert{Kind: KindGameLost, Key: "0x1234", Summary: "Game lost"}
+
+	d.Alert(lost)
+	require.Len(t, d.queue, 1)
+
+	d.Alert(lost)
+	require.Len(t, d.queue, 1, "should suppress repeated alert")
+
+	d.Alert(Alert{Kind: KindGameLost, Key: "0x5678", Summary: "Game lost"})
+	d.Alert(Alert{Kind: KindStuckTx, Key: "0x1234", Summary: "Tx stuck"})
+	require.Len(t, d.queue, 3, "should send alerts with a different kind or key")
+
+	cl.AdvanceTime(DefaultRepeatInterval - time.Second)
+	d.Alert(lost)
+	require.Len(t, d.queue, 3)
+
+	cl.AdvanceTime(time.Second)
+	d.Alert(lost)
+	require.Len(t, d.queue, 4, "should repeat alert after repeat interval")
+}
+
+func TestDispatcherDropsAlertsWhenQueueFull(t *testing.T) {
+	d, _, _ := setupDispatcherTest(t)
+	for i := 0; i < queueSize+1; i++ {
+		d.Alert(Alert{Kind: KindLowBalance, Key: string(rune('a' + i))})
+	}
+	require.Len(t, d.queue, queueSize)
+}
+
+func TestDispatcherSendsToAllDestinations(t *testing.T) {
+	d, _, senders := setupDispatcherTest(t)
+	senders[0].err = errors.New("boom")
+	alert := Alert{Kind: KindPrestateMismatch, Key: "cannon", Summary: "Prestate mismatch"}
+
+	d.send(context.Background(), alert)
+	require.Equal(t, maxAttempts, senders[0].attempts, "should retry failed sends")
+	require.Empty(t, senders[0].sent)
+	require.Equal(t, 1, senders[1].attempts)
+	require.Equal(t, []Alert{alert}, senders[1].sent, "should send to other destinations after a failure")
+}
+
+func TestDispatcherSendsInBackground(t *testing.T) {
+	d, _, senders := setupDispatcherTest(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	alert := Alert{Kind: KindStuckTx, Key: "0x1234"}
+	d.Alert(alert)
+	require.Eventually(t, func() bool {
+		return len(senders[1].sentAlerts()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestNoopAlerter(t *testing.T) {
+	require.NotPanics(t, func() {
+		NoopAlerter.Alert(Alert{Kind: KindGameLost})
+	})
+}
+
+func setupDispatcherTest(t *testing.T) (*Dispatcher, *clock.DeterministicClock, []*stubSender) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	senders := []*stubSender{{name: "first"}, {name: "second"}}
+	d := NewDispatcher(logger, cl, DefaultRepeatInterval, senders[0], senders[1])
+	d.strategy = retry.Fixed(0)
+	return d, cl, senders
+}
+
+type stubSender struct {
+	name string
+	err  error
+
+	mu       sync.Mutex
+	attempts int
+	sent     []Alert
+}
+
+func (s *stubSender) Name() string {
+	return s.name
+}
+
+func (s *stubSender) Send(_ context.Context, alert Alert) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, alert)
+	return nil
+}
+
+func (s *stubSender) sentAlerts() []Alert {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Alert(nil), s.sent...)
+}
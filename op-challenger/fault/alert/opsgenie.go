@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const (
+	// Maximum lengths of fields accepted by the Opsgenie alert API.
+	maxOpsgenieMessage = 130
+	maxOpsgenieAlias   = 512
+)
+
+// OpsgenieSender creates alerts through the Opsgenie alert API.
+// Alerts are created with an alias identifying the condition, so Opsgenie deduplicates repeated alerts.
+type OpsgenieSender struct {
+	client *http.Client
+	apiURL string
+	apiKey string
+}
+
+// NewOpsgenieSender creates a new [OpsgenieSender] using the API at apiURL, authenticating with apiKey.
+func NewOpsgenieSender(apiURL string, apiKey string) *OpsgenieSender {
+	return &OpsgenieSender{
+		client: &http.Client{Timeout: requestTimeout},
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		apiKey: apiKey,
+	}
+}
+
+func (o *OpsgenieSender) Name() string {
+	return "opsgenie"
+}
+
+func (o *OpsgenieSender) Send(ctx context.Context, alert Alert) error {
+	body := opsgenieAlert{
+		Message:     truncate("op-challenger: "+alert.Summary, maxOpsgenieMessage),
+		Alias:       truncate("op-challenger:"+alert.id(), maxOpsgenieAlias),
+		Description: alert.Summary,
+		Details:     alert.Details,
+		Tags:        []string{"op-challenger", string(alert.Kind)},
+		Source:      "op-challenger",
+		Priority:    "P1",
+	}
+	headers := map[string]string{"Authorization": "GenieKey " + o.apiKey}
+	return postJSON(ctx, o.client, o.apiURL+"/v2/alerts", body, headers)
+}
+
+type opsgenieAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description"`
+	Details     map[string]string `json:"details,omitempty"`
+	Tags        []string          `json:"tags"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
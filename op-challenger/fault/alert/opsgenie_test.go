@@ -0,0 +1,36 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpsgenieSender(t *testing.T) {
+	var received opsgenieAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v2/alerts", r.URL.Path)
+		require.Equal(t, "GenieKey key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewOpsgenieSender(server.URL+"/", "key")
+	require.NoError(t, sender.Send(context.Background(), testAlert))
+	require.Equal(t, "op-challenger: Game lost", received.Message)
+	require.Equal(t, "op-challenger:game_lost:0x1234", received.Alias)
+	require.Equal(t, testAlert.Details, received.Details)
+	require.Equal(t, []string{"op-challenger", "game_lost"}, received.Tags)
+	require.Equal(t, "P1", received.Priority)
+
+	long := testAlert
+	long.Summary = strings.Repeat("a", 200)
+	require.NoError(t, sender.Send(context.Background(), long))
+	require.Len(t, received.Message, maxOpsgenieMessage)
+}
@@ -0,0 +1,51 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// SlackSender posts alerts to a Slack incoming webhook.
+type SlackSender struct {
+	client  *http.Client
+	webhook string
+}
+
+// NewSlackSender creates a new [SlackSender] posting to the webhook URL.
+func NewSlackSender(webhook string) *SlackSender {
+	return &SlackSender{
+		client:  &http.Client{Timeout: requestTimeout},
+		webhook: webhook,
+	}
+}
+
+func (s *SlackSender) Name() string {
+	return "slack"
+}
+
+func (s *SlackSender) Send(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, s.client, s.webhook, slackMessage{Text: slackText(alert)}, nil)
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// slackText formats the alert as a Slack message, listing the details in a stable order.
+func slackText(alert Alert) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, ":rotating_light: *op-challenger: %v*\n", alert.Summary)
+	fmt.Fprintf(&text, "*kind:* `%v`", alert.Kind)
+	keys := make([]string, 0, len(alert.Details))
+	for key := range alert.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&text, "\n*%v:* `%v`", key, alert.Details[key])
+	}
+	return text.String()
+}
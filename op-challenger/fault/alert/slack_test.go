@@ -0,0 +1,51 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testAlert = Alert{
+	Kind:    KindGameLost,
+	Key:     "0x1234",
+	Summary: "Game lost",
+	Details: map[string]string{"status": "Challenger Won", "game": "0x1234"},
+}
+
+func TestSlackSender(t *testing.T) {
+	var received slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sender := NewSlackSender(server.URL + "/services/secret")
+	require.NoError(t, sender.Send(context.Background(), testAlert))
+	require.Equal(t, ":rotating_light: *op-challenger: Game lost*\n*kind:* `game_lost`\n*game:* `0x1234`\n*status:* `Challenger Won`", received.Text)
+}
+
+func TestSenderErrors(t *testing.T) {
+	t.Run("UnexpectedStatus", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		err := NewSlackSender(server.URL).Send(context.Background(), testAlert)
+		require.ErrorIs(t, err, errUnexpectedStatus)
+	})
+
+	t.Run("OmitEndpointFromError", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+		err := NewSlackSender(server.URL+"/services/secret").Send(context.Background(), testAlert)
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "secret")
+	})
+}
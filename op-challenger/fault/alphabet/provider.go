@@ -18,15 +18,17 @@ var (
 // AlphabetTraceProvider is a [TraceProvider] that provides claims for specific
 // indices in the given trace.
 type AlphabetTraceProvider struct {
-	state  []string
-	maxLen uint64
+	state []string
+	// maxLen is the number of indices in the trace. It is a [big.Int] as games deeper than 63 have more indices than
+	// fit in a uint64.
+	maxLen *big.Int
 }
 
 // NewTraceProvider returns a new [AlphabetProvider].
 func NewTraceProvider(state string, depth uint64) *AlphabetTraceProvider {
 	return &AlphabetTraceProvider{
 		state:  strings.Split(state, ""),
-		maxLen: uint64(1 << depth),
+		maxLen: new(big.Int).Lsh(big.NewInt(1), uint(depth)),
 	}
 }
 
@@ -41,7 +43,7 @@ func (ap *AlphabetTraceProvider) GetStepData(ctx context.Context, i uint64) ([]b
 	// We want the pre-state which is the value prior to the one requested
 	i--
 	// The index cannot be larger than the maximum index as computed by the depth.
-	if i >= ap.maxLen {
+	if !ap.inTrace(i) {
 		return nil, nil, nil, ErrIndexTooLarge
 	}
 	// We extend the deepest hash to the maximum depth if the trace is not expansive.
@@ -53,6 +55,9 @@ func (ap *AlphabetTraceProvider) GetStepData(ctx context.Context, i uint64) ([]b
 
 // Get returns the claim value at the given index in the trace.
 func (ap *AlphabetTraceProvider) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	if !ap.inTrace(i) {
+		return common.Hash{}, ErrIndexTooLarge
+	}
 	// Step data returns the pre-state, so add 1 to get the state for index i
 	claimBytes, _, _, err := ap.GetStepData(ctx, i+1)
 	if err != nil {
@@ -61,6 +66,11 @@ func (ap *AlphabetTraceProvider) Get(ctx context.Context, i uint64) (common.Hash
 	return crypto.Keccak256Hash(claimBytes), nil
 }
 
+// inTrace returns true if i is less than the number of indices in the trace.
+func (ap *AlphabetTraceProvider) inTrace(i uint64) bool {
+	return new(big.Int).SetUint64(i).Cmp(ap.maxLen) < 0
+}
+
 // AbsolutePreState returns the absolute pre-state for the alphabet trace.
 func (ap *AlphabetTraceProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
 	return common.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000060"), nil
@@ -2,6 +2,7 @@ package alphabet
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"testing"
 
@@ -102,3 +103,13 @@ func TestGet_Extends(t *testing.T) {
 	expected := alphabetClaim(2, "c")
 	require.Equal(t, expected, claim)
 }
+
+// TestGet_DeepTrace tests the Get function for traces with more indices than fit in a uint64.
+func TestGet_DeepTrace(t *testing.T) {
+	for _, depth := range []uint64{64, 100} {
+		ap := NewTraceProvider("abc", depth)
+		claim, err := ap.Get(context.Background(), math.MaxUint64-1)
+		require.NoError(t, err, "depth %v", depth)
+		require.Equal(t, alphabetClaim(2, "c"), claim)
+	}
+}
@@ -0,0 +1,22 @@
+package fault
+
+import (
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/profiler"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+)
+
+// AnomalyTrigger captures diagnostic profiles when an anomaly is detected.
+type AnomalyTrigger interface {
+	Trigger(reason string)
+}
+
+// profiledMetrics captures profiles when a cannon execution stalls, in addition to recording the stall.
+type profiledMetrics struct {
+	metrics.Metricer
+	anomalies AnomalyTrigger
+}
+
+func (m *profiledMetrics) RecordCannonStall() {
+	m.Metricer.RecordCannonStall()
+	m.anomalies.Trigger(profiler.ReasonCannonStall)
+}
@@ -0,0 +1,27 @@
+package fault
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/profiler"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiledMetricsTriggersOnCannonStall(t *testing.T) {
+	anomalies := &stubAnomalyTrigger{}
+	m := &profiledMetrics{Metricer: metrics.NoopMetrics, anomalies: anomalies}
+	m.RecordCannonExecutionTime(0)
+	require.Empty(t, anomalies.reasons)
+
+	m.RecordCannonStall()
+	require.Equal(t, []string{profiler.ReasonCannonStall}, anomalies.reasons)
+}
+
+type stubAnomalyTrigger struct {
+	reasons []string
+}
+
+func (s *stubAnomalyTrigger) Trigger(reason string) {
+	s.reasons = append(s.reasons, reason)
+}
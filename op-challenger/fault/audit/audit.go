@@ -0,0 +1,244 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// DirName is the name of the directory within the datadir the audit log is stored in.
+	DirName = "audit"
+	// FileName is the name of the audit log file within the audit directory.
+	FileName = "audit.jsonl"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionMove        = "move"
+	ActionMoveSkipped = "move-skipped"
+	ActionStep        = "step"
+	ActionResolve     = "resolve"
+	ActionApprove     = "approve"
+	ActionClaimCredit = "claim-credit"
+)
+
+// Transaction statuses recorded in the audit log.
+const (
+	StatusSuccess  = "success"
+	StatusReverted = "reverted"
+	StatusFailed   = "failed"
+)
+
+var (
+	ErrBrokenChain      = errors.New("audit log hash chain is broken")
+	ErrInvalidHash      = errors.New("audit log entry hash does not match its contents")
+	ErrInvalidSignature = errors.New("audit log entry signature does not match its signer")
+)
+
+// Entry is a single record in the audit log.
+// Each entry includes the hash of the previous entry so any modification, removal or reordering of entries can be
+// detected. Entries may also be signed with the challenger's key to prove they were recorded by the challenger.
+type Entry struct {
+	Seq     uint64            `json:"seq"`
+	Time    uint64            `json:"time"` // Unix timestamp the entry was recorded
+	Action  string            `json:"action"`
+	Game    common.Address    `json:"game"`
+	TxHash  *common.Hash      `json:"txHash,omitempty"` // Hash of the transaction sent, if any
+	Status  string            `json:"status,omitempty"` // Result of the transaction, if any
+	Details map[string]string `json:"details,omitempty"`
+
+	PrevHash  common.Hash     `json:"prevHash"`
+	Signer    *common.Address `json:"signer,omitempty"`
+	Hash      common.Hash     `json:"hash"`
+	Signature hexutil.Bytes   `json:"signature,omitempty"`
+}
+
+// computeHash returns the hash of the entry's contents, excluding the hash and signature.
+// Details are encoded with sorted keys so the hash is deterministic.
+func (e Entry) computeHash() (common.Hash, error) {
+	e.Hash = common.Hash{}
+	e.Signature = nil
+	data, err := json.Marshal(e)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(data), nil
+}
+
+// Recorder records actions in the audit log.
+type Recorder interface {
+	Record(entry Entry)
+}
+
+// Log is an append-only, hash-chained log of the actions taken by the challenger, stored as JSON lines.
+// It is safe for concurrent use.
+type Log struct {
+	logger log.Logger
+	clock  clock.Clock
+	path   string
+	key    *ecdsa.PrivateKey
+	signer *common.Address
+
+	mu       sync.Mutex
+	seq      uint64
+	lastHash common.Hash
+}
+
+// Open opens the audit log in dir, continuing the hash chain of any existing entries.
+// Entries are signed with key, which may be nil in which case entries are not signed.
+func Open(logger log.Logger, cl clock.Clock, dir string, key *ecdsa.PrivateKey) (*Log, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create audit dir: %w", err)
+	}
+	l := &Log{
+		logger: logger.New("component", "audit"),
+		clock:  cl,
+		path:   filepath.Join(dir, FileName),
+		key:    key,
+	}
+	if key != nil {
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+		l.signer = &signer
+	}
+	if err := l.loadLast(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// loadLast restores the sequence number and hash of the last entry in the existing log.
+// A final line without a newline was only partially written before the challenger stopped so is removed.
+func (l *Log) loadLast() error {
+	data, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read audit log: %w", err)
+	}
+	complete := bytes.LastIndexByte(data, '\n') + 1
+	if complete < len(data) {
+		l.logger.Warn("Removing partially written audit log entry", "bytes", len(data)-complete)
+		if err := os.Truncate(l.path, int64(complete)); err != nil {
+			return fmt.Errorf("failed to remove partial audit log entry: %w", err)
+		}
+		data = data[:complete]
+	}
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte{'\n'}), []byte{'\n'})
+	last := lines[len(lines)-1]
+	if len(last) == 0 {
+		return nil
+	}
+	var entry Entry
+	if err := json.Unmarshal(last, &entry); err != nil {
+		return fmt.Errorf("failed to parse last audit log entry: %w", err)
+	}
+	l.seq = entry.Seq + 1
+	l.lastHash = entry.Hash
+	return nil
+}
+
+// Record appends the action to the log, setting its sequence number, time and hash and signing it.
+// Failures are logged rather than returned so they never prevent the challenger from acting.
+func (l *Log) Record(entry Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.append(entry); err != nil {
+		l.logger.Error("Failed to record audit log entry", "action", entry.Action, "game", entry.Game, "err", err)
+	}
+}
+
+func (l *Log) append(entry Entry) error {
+	entry.Seq = l.seq
+	entry.Time = uint64(l.clock.Now().Unix())
+	entry.PrevHash = l.lastHash
+	entry.Signer = l.signer
+	hash, err := entry.computeHash()
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	if l.key != nil {
+		entry.Signature, err = crypto.Sign(hash[:], l.key)
+		if err != nil {
+			return fmt.Errorf("sign entry: %w", err)
+		}
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	l.seq++
+	l.lastHash = hash
+	return nil
+}
+
+// Read loads every entry from an audit log and verifies the hash chain and any signatures.
+// Returns the entries read before the first invalid entry along with the error.
+func Read(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	var prevHash common.Hash
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return entries, fmt.Errorf("failed to parse audit log entry %v: %w", len(entries), err)
+		}
+		if err := verify(entry, uint64(len(entries)), prevHash); err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+		prevHash = entry.Hash
+	}
+	return entries, scanner.Err()
+}
+
+func verify(entry Entry, seq uint64, prevHash common.Hash) error {
+	if entry.Seq != seq || entry.PrevHash != prevHash {
+		return fmt.Errorf("%w at entry %v", ErrBrokenChain, seq)
+	}
+	hash, err := entry.computeHash()
+	if err != nil {
+		return err
+	}
+	if hash != entry.Hash {
+		return fmt.Errorf("%w at entry %v", ErrInvalidHash, seq)
+	}
+	if entry.Signer == nil {
+		return nil
+	}
+	pub, err := crypto.SigToPub(hash[:], entry.Signature)
+	if err != nil || crypto.PubkeyToAddress(*pub) != *entry.Signer {
+		return fmt.Errorf("%w at entry %v", ErrInvalidSignature, seq)
+	}
+	return nil
+}
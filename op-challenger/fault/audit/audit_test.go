@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	gameA = common.Address{0xaa}
+	gameB = common.Address{0xbb}
+)
+
+func TestRecord(t *testing.T) {
+	dir := t.TempDir()
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	l := openLog(t, cl, dir)
+	txHash := common.Hash{0x01}
+	l.Record(Entry{Action: ActionMove, Game: gameA, TxHash: &txHash, Status: StatusSuccess, Details: map[string]string{"claim": "0x02"}})
+	cl.AdvanceTime(time.Minute)
+	l.Record(Entry{Action: ActionResolve, Game: gameB, Status: StatusReverted})
+
+	entries := readLog(t, dir)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(0), entries[0].Seq)
+	require.Equal(t, uint64(1000), entries[0].Time)
+	require.Equal(t, ActionMove, entries[0].Action)
+	require.Equal(t, gameA, entries[0].Game)
+	require.Equal(t, &txHash, entries[0].TxHash)
+	require.Equal(t, map[string]string{"claim": "0x02"}, entries[0].Details)
+	require.Equal(t, common.Hash{}, entries[0].PrevHash)
+	require.Nil(t, entries[0].Signer)
+	require.Empty(t, entries[0].Signature)
+
+	require.Equal(t, uint64(1), entries[1].Seq)
+	require.Equal(t, uint64(1060), entries[1].Time)
+	require.Equal(t, entries[0].Hash, entries[1].PrevHash)
+}
+
+func TestContinueExistingLog(t *testing.T) {
+	dir := t.TempDir()
+	l := openLog(t, clock.SystemClock, dir)
+	l.Record(Entry{Action: ActionMove, Game: gameA})
+
+	l = openLog(t, clock.SystemClock, dir)
+	l.Record(Entry{Action: ActionStep, Game: gameA})
+
+	entries := readLog(t, dir)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(1), entries[1].Seq)
+	require.Equal(t, entries[0].Hash, entries[1].PrevHash)
+}
+
+func TestRemovePartialEntry(t *testing.T) {
+	dir := t.TempDir()
+	l := openLog(t, clock.SystemClock, dir)
+	l.Record(Entry{Action: ActionMove, Game: gameA})
+	f, err := os.OpenFile(filepath.Join(dir, FileName), os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":1,"act`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l = openLog(t, clock.SystemClock, dir)
+	l.Record(Entry{Action: ActionStep, Game: gameA})
+
+	entries := readLog(t, dir)
+	require.Len(t, entries, 2)
+	require.Equal(t, ActionStep, entries[1].Action)
+}
+
+func TestOpenRejectsCorruptLog(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, FileName), []byte("not json\n"), 0644))
+	_, err := Open(testlog.Logger(t, log.LvlInfo), clock.SystemClock, dir, nil)
+	require.ErrorContains(t, err, "failed to parse last audit log entry")
+}
+
+func TestSignEntries(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	l, err := Open(testlog.Logger(t, log.LvlInfo), clock.SystemClock, dir, key)
+	require.NoError(t, err)
+	l.Record(Entry{Action: ActionMove, Game: gameA})
+
+	entries := readLog(t, dir)
+	require.Len(t, entries, 1)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	require.Equal(t, &signer, entries[0].Signer)
+	require.NotEmpty(t, entries[0].Signature)
+}
+
+func TestRead(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	l, err := Open(testlog.Logger(t, log.LvlInfo), clock.SystemClock, dir, key)
+	require.NoError(t, err)
+	l.Record(Entry{Action: ActionMove, Game: gameA})
+	l.Record(Entry{Action: ActionStep, Game: gameA})
+	l.Record(Entry{Action: ActionResolve, Game: gameA})
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte{'\n'}), []byte{'\n'})
+
+	modify := func(t *testing.T, index int, change func(entry *Entry)) []byte {
+		var entry Entry
+		require.NoError(t, json.Unmarshal(lines[index], &entry))
+		change(&entry)
+		line, err := json.Marshal(entry)
+		require.NoError(t, err)
+		modified := append([][]byte{}, lines...)
+		modified[index] = line
+		return bytes.Join(modified, []byte{'\n'})
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		entries, err := Read(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+	})
+
+	t.Run("ModifiedEntry", func(t *testing.T) {
+		entries, err := Read(bytes.NewReader(modify(t, 1, func(entry *Entry) {
+			entry.Game = gameB
+		})))
+		require.ErrorIs(t, err, ErrInvalidHash)
+		require.Len(t, entries, 1, "should return entries before the invalid entry")
+	})
+
+	t.Run("RemovedEntry", func(t *testing.T) {
+		modified := bytes.Join([][]byte{lines[0], lines[2]}, []byte{'\n'})
+		_, err := Read(bytes.NewReader(modified))
+		require.ErrorIs(t, err, ErrBrokenChain)
+	})
+
+	t.Run("RehashedEntry", func(t *testing.T) {
+		_, err := Read(bytes.NewReader(modify(t, 2, func(entry *Entry) {
+			entry.Action = ActionMoveSkipped
+			hash, err := entry.computeHash()
+			require.NoError(t, err)
+			entry.Hash = hash
+		})))
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("ResignedByOtherKey", func(t *testing.T) {
+		other, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		_, err = Read(bytes.NewReader(modify(t, 0, func(entry *Entry) {
+			entry.Signature, err = crypto.Sign(entry.Hash[:], other)
+			require.NoError(t, err)
+		})))
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func openLog(t *testing.T, cl clock.Clock, dir string) *Log {
+	l, err := Open(testlog.Logger(t, log.LvlInfo), cl, dir, nil)
+	require.NoError(t, err)
+	return l
+}
+
+func readLog(t *testing.T, dir string) []Entry {
+	f, err := os.Open(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	defer f.Close()
+	entries, err := Read(f)
+	require.NoError(t, err)
+	return entries
+}
@@ -0,0 +1,152 @@
+package fault
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type BalanceMetricer interface {
+	RecordBondTokenBalance(token common.Address, balance *big.Int, decimals uint8)
+}
+
+// TokenBalanceReader loads the balance of an account in an ERC-20 token and the number of decimals the token uses.
+type TokenBalanceReader interface {
+	TokenBalanceAt(ctx context.Context, token common.Address, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TokenDecimals(ctx context.Context, token common.Address) (uint8, error)
+}
+
+// erc20BalanceReader is a [TokenBalanceReader] that calls the token contract's balanceOf method.
+type erc20BalanceReader struct {
+	caller bind.ContractCaller
+}
+
+func (r erc20BalanceReader) TokenBalanceAt(ctx context.Context, token common.Address, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	contract, err := bindings.NewERC20Caller(token, r.caller)
+	if err != nil {
+		return nil, err
+	}
+	return contract.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, account)
+}
+
+func (r erc20BalanceReader) TokenDecimals(ctx context.Context, token common.Address) (uint8, error) {
+	contract, err := bindings.NewERC20Caller(token, r.caller)
+	if err != nil {
+		return 0, err
+	}
+	return contract.Decimals(&bind.CallOpts{Context: ctx})
+}
+
+// balanceGuardian raises an alert when the balance of the account used to send transactions falls below the minimum
+// required to keep playing games. It also reports the account's balance in each token that bonds are denominated in.
+type balanceGuardian struct {
+	logger  log.Logger
+	metrics BalanceMetricer
+	client  BalanceReader
+	tokens  TokenBalanceReader
+	from    common.Address
+	min     *big.Int
+	alerter alert.Alerter
+
+	// low is set while the balance is below the minimum
+	low bool
+
+	tokensLock sync.Mutex
+	bondTokens []common.Address
+
+	// decimals caches the decimals of each bond token. Only accessed by Check.
+	decimals map[common.Address]uint8
+}
+
+// newBalanceGuardian creates a new balanceGuardian. A nil or zero minimum disables balance alerts.
+func newBalanceGuardian(logger log.Logger, m BalanceMetricer, client BalanceReader, tokens TokenBalanceReader, from common.Address, min *big.Int, alerter alert.Alerter) *balanceGuardian {
+	return &balanceGuardian{
+		logger:   logger,
+		metrics:  m,
+		client:   client,
+		tokens:   tokens,
+		from:     from,
+		min:      min,
+		alerter:  alerter,
+		decimals: make(map[common.Address]uint8),
+	}
+}
+
+// TrackToken adds a token that bonds are denominated in, so the account's balance in it is reported.
+// It is safe to call concurrently with Check.
+func (b *balanceGuardian) TrackToken(token common.Address) {
+	b.tokensLock.Lock()
+	defer b.tokensLock.Unlock()
+	for _, existing := range b.bondTokens {
+		if existing == token {
+			return
+		}
+	}
+	b.bondTokens = append(b.bondTokens, token)
+}
+
+// Check loads the current balance and raises an alert if it is below the minimum.
+// The alert is repeated while the balance remains low, subject to the alerter's deduplication.
+func (b *balanceGuardian) Check(ctx context.Context, l1Block uint64) {
+	blockNumber := new(big.Int).SetUint64(l1Block)
+	b.recordTokenBalances(ctx, blockNumber)
+	if b.min == nil || b.min.Sign() == 0 {
+		return
+	}
+	balance, err := b.client.BalanceAt(ctx, b.from, blockNumber)
+	if err != nil {
+		b.logger.Warn("Failed to load balance", "from", b.from, "err", err)
+		return
+	}
+	if balance.Cmp(b.min) >= 0 {
+		if b.low {
+			b.logger.Info("Balance restored above minimum", "from", b.from, "balance", balance)
+			b.low = false
+		}
+		return
+	}
+	if !b.low {
+		b.logger.Error("Balance below minimum", "from", b.from, "balance", balance, "min", b.min)
+		b.low = true
+	}
+	b.alerter.Alert(alert.Alert{
+		Kind:    alert.KindLowBalance,
+		Key:     b.from.Hex(),
+		Summary: "Challenger balance below minimum",
+		Details: map[string]string{
+			"from":    b.from.Hex(),
+			"balance": balance.String(),
+			"min":     b.min.String(),
+		},
+	})
+}
+
+func (b *balanceGuardian) recordTokenBalances(ctx context.Context, blockNumber *big.Int) {
+	b.tokensLock.Lock()
+	tokens := append([]common.Address(nil), b.bondTokens...)
+	b.tokensLock.Unlock()
+	for _, token := range tokens {
+		decimals, ok := b.decimals[token]
+		if !ok {
+			var err error
+			decimals, err = b.tokens.TokenDecimals(ctx, token)
+			if err != nil {
+				b.logger.Warn("Failed to load token decimals", "token", token, "err", err)
+				continue
+			}
+			b.decimals[token] = decimals
+		}
+		balance, err := b.tokens.TokenBalanceAt(ctx, token, b.from, blockNumber)
+		if err != nil {
+			b.logger.Warn("Failed to load token balance", "from", b.from, "token", token, "err", err)
+			continue
+		}
+		b.metrics.RecordBondTokenBalance(token, balance, decimals)
+	}
+}
@@ -0,0 +1,160 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceGuardian(t *testing.T) {
+	from := common.Address{0xaa}
+
+	setup := func(t *testing.T, min *big.Int) (*balanceGuardian, *stubBalanceReader, *stubAlerter) {
+		logger := testlog.Logger(t, log.LvlCrit)
+		client := &stubBalanceReader{balance: big.NewInt(100)}
+		alerter := &stubAlerter{}
+		return newBalanceGuardian(logger, &stubBalanceMetrics{}, client, &stubTokenBalanceReader{}, from, min, alerter), client, alerter
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		for _, min := range []*big.Int{nil, big.NewInt(0)} {
+			guardian, client, alerter := setup(t, min)
+			client.balance = big.NewInt(0)
+			guardian.Check(context.Background(), 5)
+			require.Empty(t, alerter.alerts)
+			require.Equal(t, common.Address{}, client.account, "should not load balance")
+		}
+	})
+
+	t.Run("AboveMinimum", func(t *testing.T) {
+		guardian, client, alerter := setup(t, big.NewInt(100))
+		guardian.Check(context.Background(), 5)
+		require.Equal(t, from, client.account)
+		require.Empty(t, alerter.alerts)
+	})
+
+	t.Run("BelowMinimum", func(t *testing.T) {
+		guardian, client, alerter := setup(t, big.NewInt(100))
+		client.balance = big.NewInt(99)
+		guardian.Check(context.Background(), 5)
+		require.Len(t, alerter.alerts, 1)
+		require.Equal(t, alert.KindLowBalance, alerter.alerts[0].Kind)
+		require.Equal(t, from.Hex(), alerter.alerts[0].Key)
+		require.Equal(t, "99", alerter.alerts[0].Details["balance"])
+		require.Equal(t, "100", alerter.alerts[0].Details["min"])
+
+		// Alerts are repeated while the balance is low and deduplicated by the alerter
+		guardian.Check(context.Background(), 6)
+		require.Len(t, alerter.alerts, 2)
+
+		client.balance = big.NewInt(200)
+		guardian.Check(context.Background(), 7)
+		require.Len(t, alerter.alerts, 2)
+		require.False(t, guardian.low)
+	})
+
+	t.Run("IgnoreErrors", func(t *testing.T) {
+		guardian, client, alerter := setup(t, big.NewInt(100))
+		client.err = errors.New("boom")
+		guardian.Check(context.Background(), 5)
+		require.Empty(t, alerter.alerts)
+	})
+}
+
+func TestBalanceGuardianRecordsTokenBalances(t *testing.T) {
+	from := common.Address{0xaa}
+	token1 := common.Address{0x01}
+	token2 := common.Address{0x02}
+	logger := testlog.Logger(t, log.LvlCrit)
+	m := &stubBalanceMetrics{}
+	tokens := &stubTokenBalanceReader{
+		balances: map[common.Address]*big.Int{
+			token1: big.NewInt(10),
+			token2: big.NewInt(20),
+		},
+		decimals: map[common.Address]uint8{
+			token1: 18,
+			token2: 6,
+		},
+	}
+	guardian := newBalanceGuardian(logger, m, &stubBalanceReader{}, tokens, from, nil, alert.NoopAlerter)
+
+	guardian.Check(context.Background(), 5)
+	require.Empty(t, m.tokenBalances, "should not record balances before tokens are tracked")
+
+	guardian.TrackToken(token1)
+	guardian.TrackToken(token2)
+	guardian.TrackToken(token1)
+	guardian.Check(context.Background(), 6)
+	require.Equal(t, map[common.Address]*big.Int{token1: big.NewInt(10), token2: big.NewInt(20)}, m.tokenBalances)
+	require.Equal(t, from, tokens.account)
+	require.Equal(t, big.NewInt(6), tokens.blockNumber)
+	require.Equal(t, map[common.Address]uint8{token1: 18, token2: 6}, m.tokenDecimals)
+
+	guardian.Check(context.Background(), 7)
+	require.Equal(t, 2, tokens.decimalsCalls, "should cache token decimals")
+}
+
+func TestBalanceGuardianSkipsTokenWithUnknownDecimals(t *testing.T) {
+	token := common.Address{0x01}
+	logger := testlog.Logger(t, log.LvlCrit)
+	m := &stubBalanceMetrics{}
+	tokens := &stubTokenBalanceReader{balances: map[common.Address]*big.Int{token: big.NewInt(10)}}
+	guardian := newBalanceGuardian(logger, m, &stubBalanceReader{}, tokens, common.Address{0xaa}, nil, alert.NoopAlerter)
+	guardian.TrackToken(token)
+
+	guardian.Check(context.Background(), 5)
+	require.Empty(t, m.tokenBalances)
+
+	tokens.decimals = map[common.Address]uint8{token: 6}
+	guardian.Check(context.Background(), 6)
+	require.Equal(t, map[common.Address]*big.Int{token: big.NewInt(10)}, m.tokenBalances)
+}
+
+type stubBalanceMetrics struct {
+	tokenBalances map[common.Address]*big.Int
+	tokenDecimals map[common.Address]uint8
+}
+
+func (s *stubBalanceMetrics) RecordBondTokenBalance(token common.Address, balance *big.Int, decimals uint8) {
+	if s.tokenBalances == nil {
+		s.tokenBalances = make(map[common.Address]*big.Int)
+		s.tokenDecimals = make(map[common.Address]uint8)
+	}
+	s.tokenBalances[token] = balance
+	s.tokenDecimals[token] = decimals
+}
+
+type stubTokenBalanceReader struct {
+	balances      map[common.Address]*big.Int
+	decimals      map[common.Address]uint8
+	decimalsCalls int
+	account       common.Address
+	blockNumber   *big.Int
+}
+
+func (s *stubTokenBalanceReader) TokenBalanceAt(_ context.Context, token common.Address, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	s.account = account
+	s.blockNumber = blockNumber
+	balance, ok := s.balances[token]
+	if !ok {
+		return nil, errors.New("unknown token")
+	}
+	return balance, nil
+}
+
+func (s *stubTokenBalanceReader) TokenDecimals(_ context.Context, token common.Address) (uint8, error) {
+	s.decimalsCalls++
+	decimals, ok := s.decimals[token]
+	if !ok {
+		return 0, errors.New("unknown token")
+	}
+	return decimals, nil
+}
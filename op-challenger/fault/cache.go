@@ -0,0 +1,138 @@
+package fault
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// claimCacheTTL is the maximum age of cached claims. Older claims are discarded and reloaded.
+	claimCacheTTL = 5 * time.Minute
+	// maxWarmConcurrency is the maximum number of games loaded concurrently when warming the cache.
+	maxWarmConcurrency = 16
+)
+
+type GameStateLoader interface {
+	ClaimLoader
+	GetGameStatus(ctx context.Context) (types.GameStatus, error)
+}
+
+type gameStateLoaderCreator func(game common.Address) (GameStateLoader, error)
+
+type cachedClaims struct {
+	claims   []types.Claim
+	loadedAt time.Time
+}
+
+// claimCache holds the claims of games that were pre-fetched on startup, so the first update of each game
+// can act immediately instead of waiting for its claims to load.
+// Cached claims are only used once, subsequent loads always fetch the latest claims.
+type claimCache struct {
+	logger       log.Logger
+	clock        clock.Clock
+	createLoader gameStateLoaderCreator
+
+	lock    sync.Mutex
+	entries map[common.Address]cachedClaims
+}
+
+func newClaimCache(logger log.Logger, cl clock.Clock, createLoader gameStateLoaderCreator) *claimCache {
+	return &claimCache{
+		logger:       logger,
+		clock:        cl,
+		createLoader: createLoader,
+		entries:      make(map[common.Address]cachedClaims),
+	}
+}
+
+// Warm concurrently loads and caches the claims of the unresolved games, blocking until all games are loaded.
+// Games that fail to load are skipped and will load their claims when first progressed.
+func (c *claimCache) Warm(ctx context.Context, games []common.Address) {
+	start := c.clock.Now()
+	var wg sync.WaitGroup
+	var cached atomic.Int32
+	sem := make(chan struct{}, maxWarmConcurrency)
+	for _, game := range games {
+		game := game
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+			if c.warmGame(ctx, game) {
+				cached.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	c.logger.Info("Warmed claim cache", "games", len(games), "cached", cached.Load(), "duration", c.clock.Now().Sub(start))
+}
+
+func (c *claimCache) warmGame(ctx context.Context, game common.Address) bool {
+	loader, err := c.createLoader(game)
+	if err != nil {
+		c.logger.Warn("Failed to create loader to warm claim cache", "game", game, "err", err)
+		return false
+	}
+	status, err := loader.GetGameStatus(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to load game status to warm claim cache", "game", game, "err", err)
+		return false
+	}
+	if status != types.GameStatusInProgress {
+		return false
+	}
+	claims, err := loader.FetchClaims(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to load claims to warm claim cache", "game", game, "err", err)
+		return false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[game] = cachedClaims{claims: claims, loadedAt: c.clock.Now()}
+	return true
+}
+
+// take removes and returns the cached claims for the game if they are present and not older than claimCacheTTL.
+func (c *claimCache) take(game common.Address) ([]types.Claim, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[game]
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, game)
+	if c.clock.Now().Sub(entry.loadedAt) > claimCacheTTL {
+		return nil, false
+	}
+	return entry.claims, true
+}
+
+// ClaimLoader wraps loader so that the first load of the game's claims uses the cached claims, if available.
+func (c *claimCache) ClaimLoader(game common.Address, loader ClaimLoader) ClaimLoader {
+	return &cachedClaimLoader{cache: c, game: game, loader: loader}
+}
+
+type cachedClaimLoader struct {
+	cache  *claimCache
+	game   common.Address
+	loader ClaimLoader
+}
+
+func (l *cachedClaimLoader) FetchClaims(ctx context.Context) ([]types.Claim, error) {
+	if claims, ok := l.cache.take(l.game); ok {
+		return claims, nil
+	}
+	return l.loader.FetchClaims(ctx)
+}
@@ -0,0 +1,126 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimCache_Warm(t *testing.T) {
+	inProgress := common.Address{0xaa}
+	resolved := common.Address{0xbb}
+	failing := common.Address{0xcc}
+	cache, loaders, _ := setupClaimCacheTest(t)
+	loaders[inProgress] = &stubGameStateLoader{status: types.GameStatusInProgress, claims: []types.Claim{{ContractIndex: 1}}}
+	loaders[resolved] = &stubGameStateLoader{status: types.GameStatusChallengerWon, claims: []types.Claim{{ContractIndex: 2}}}
+	loaders[failing] = &stubGameStateLoader{status: types.GameStatusInProgress, err: errors.New("boom")}
+
+	cache.Warm(context.Background(), []common.Address{inProgress, resolved, failing})
+
+	claims, ok := cache.take(inProgress)
+	require.True(t, ok)
+	require.Equal(t, loaders[inProgress].claims, claims)
+
+	_, ok = cache.take(inProgress)
+	require.False(t, ok, "cached claims should only be used once")
+	_, ok = cache.take(resolved)
+	require.False(t, ok, "should not cache resolved games")
+	_, ok = cache.take(failing)
+	require.False(t, ok, "should not cache games that failed to load")
+}
+
+func TestClaimCache_WarmConcurrently(t *testing.T) {
+	cache, loaders, _ := setupClaimCacheTest(t)
+	games := []common.Address{{0xaa}, {0xbb}, {0xcc}}
+	var started sync.WaitGroup
+	started.Add(len(games))
+	for _, game := range games {
+		// Each load waits until all games have started loading so the games must load concurrently
+		loaders[game] = &stubGameStateLoader{status: types.GameStatusInProgress, started: &started}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cache.Warm(context.Background(), games)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("games were not loaded concurrently")
+	}
+	for _, game := range games {
+		_, ok := cache.take(game)
+		require.True(t, ok)
+	}
+}
+
+func TestClaimCache_IgnoreExpiredClaims(t *testing.T) {
+	game := common.Address{0xaa}
+	cache, loaders, cl := setupClaimCacheTest(t)
+	loaders[game] = &stubGameStateLoader{status: types.GameStatusInProgress}
+	cache.Warm(context.Background(), []common.Address{game})
+
+	cl.AdvanceTime(claimCacheTTL + time.Second)
+	_, ok := cache.take(game)
+	require.False(t, ok)
+}
+
+func TestClaimCache_ClaimLoader(t *testing.T) {
+	game := common.Address{0xaa}
+	cache, loaders, _ := setupClaimCacheTest(t)
+	loaders[game] = &stubGameStateLoader{status: types.GameStatusInProgress, claims: []types.Claim{{ContractIndex: 1}}}
+	cache.Warm(context.Background(), []common.Address{game})
+
+	fresh := &stubClaimLoader{claims: []types.Claim{{ContractIndex: 2}}}
+	loader := cache.ClaimLoader(game, fresh)
+
+	claims, err := loader.FetchClaims(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, loaders[game].claims, claims, "should use cached claims for first load")
+
+	claims, err = loader.FetchClaims(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, fresh.claims, claims, "should load fresh claims after first load")
+}
+
+func setupClaimCacheTest(t *testing.T) (*claimCache, map[common.Address]*stubGameStateLoader, *clock.DeterministicClock) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	loaders := make(map[common.Address]*stubGameStateLoader)
+	cache := newClaimCache(testlog.Logger(t, log.LvlInfo), cl, func(game common.Address) (GameStateLoader, error) {
+		loader, ok := loaders[game]
+		if !ok {
+			return nil, errors.New("unknown game")
+		}
+		return loader, nil
+	})
+	return cache, loaders, cl
+}
+
+type stubGameStateLoader struct {
+	status  types.GameStatus
+	claims  []types.Claim
+	err     error
+	started *sync.WaitGroup
+}
+
+func (s *stubGameStateLoader) GetGameStatus(_ context.Context) (types.GameStatus, error) {
+	if s.started != nil {
+		s.started.Done()
+		s.started.Wait()
+	}
+	return s.status, nil
+}
+
+func (s *stubGameStateLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, s.err
+}
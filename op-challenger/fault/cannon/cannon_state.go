@@ -1,11 +1,13 @@
 package cannon
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func parseState(path string) (*mipsevm.State, error) {
@@ -21,3 +23,53 @@ func parseState(path string) (*mipsevm.State, error) {
 	}
 	return &state, nil
 }
+
+// witnessSize is the length of a state witness produced by [mipsevm.State.EncodeWitness].
+const witnessSize = 32 + 32 + 4*6 + 1 + 1 + 8 + 32*4
+
+// witnessState is the VM state encoded in a state witness.
+// The memory itself isn't included in the witness, only its merkle root.
+type witnessState struct {
+	MemRoot        common.Hash `json:"memRoot"`
+	PreimageKey    common.Hash `json:"preimageKey"`
+	PreimageOffset uint32      `json:"preimageOffset"`
+	PC             uint32      `json:"pc"`
+	NextPC         uint32      `json:"nextPC"`
+	LO             uint32      `json:"lo"`
+	HI             uint32      `json:"hi"`
+	Heap           uint32      `json:"heap"`
+	ExitCode       uint8       `json:"exit"`
+	Exited         bool        `json:"exited"`
+	Step           uint64      `json:"step"`
+	Registers      [32]uint32  `json:"registers"`
+}
+
+// decodeWitness decodes a state witness produced by [mipsevm.State.EncodeWitness].
+func decodeWitness(witness []byte) (*witnessState, error) {
+	if len(witness) != witnessSize {
+		return nil, fmt.Errorf("invalid state witness length %v, expected %v", len(witness), witnessSize)
+	}
+	var state witnessState
+	copy(state.MemRoot[:], witness[0:32])
+	copy(state.PreimageKey[:], witness[32:64])
+	offset := 64
+	readUint32 := func() uint32 {
+		v := binary.BigEndian.Uint32(witness[offset:])
+		offset += 4
+		return v
+	}
+	state.PreimageOffset = readUint32()
+	state.PC = readUint32()
+	state.NextPC = readUint32()
+	state.LO = readUint32()
+	state.HI = readUint32()
+	state.Heap = readUint32()
+	state.ExitCode = witness[offset]
+	state.Exited = witness[offset+1] != 0
+	state.Step = binary.BigEndian.Uint64(witness[offset+2:])
+	offset += 10
+	for i := range state.Registers {
+		state.Registers[i] = readUint32()
+	}
+	return &state, nil
+}
@@ -0,0 +1,51 @@
+package cannon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeWitness(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		state := &mipsevm.State{
+			Memory:         mipsevm.NewMemory(),
+			PreimageKey:    common.Hash{0xaa},
+			PreimageOffset: 12,
+			PC:             0x1000,
+			NextPC:         0x1004,
+			LO:             1,
+			HI:             2,
+			Heap:           0x2000,
+			ExitCode:       3,
+			Exited:         true,
+			Step:           987654321,
+		}
+		for i := range state.Registers {
+			state.Registers[i] = uint32(i * 100)
+		}
+		decoded, err := decodeWitness(state.EncodeWitness())
+		require.NoError(t, err)
+		require.Equal(t, &witnessState{
+			MemRoot:        state.Memory.MerkleRoot(),
+			PreimageKey:    state.PreimageKey,
+			PreimageOffset: state.PreimageOffset,
+			PC:             state.PC,
+			NextPC:         state.NextPC,
+			LO:             state.LO,
+			HI:             state.HI,
+			Heap:           state.Heap,
+			ExitCode:       state.ExitCode,
+			Exited:         state.Exited,
+			Step:           state.Step,
+			Registers:      state.Registers,
+		}, decoded)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		_, err := decodeWitness(make([]byte, witnessSize-1))
+		require.ErrorContains(t, err, "invalid state witness length")
+	})
+}
@@ -6,14 +6,15 @@ import (
 	"fmt"
 	"math"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
-	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -28,8 +29,16 @@ var snapshotNameRegexp = regexp.MustCompile(`^[0-9]+\.json$`)
 type snapshotSelect func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error)
 type cmdExecutor func(ctx context.Context, l log.Logger, binary string, args ...string) error
 
+type SubprocessMetricer interface {
+	RecordCannonFailure(game common.Address, reason string)
+	RecordCannonExecutionTime(t time.Duration)
+	RecordCannonStall()
+}
+
 type Executor struct {
 	logger           log.Logger
+	metrics          SubprocessMetricer
+	game             common.Address
 	l1               string
 	l2               string
 	inputs           LocalGameInputs
@@ -40,29 +49,62 @@ type Executor struct {
 	l2Genesis        string
 	absolutePreState string
 	snapshotFreq     uint
+	maxRestarts      uint
+	stallThreshold   time.Duration
 	selectSnapshot   snapshotSelect
 	cmdExecutor      cmdExecutor
+	clock            clock.Clock
 }
 
-func NewExecutor(logger log.Logger, cfg *config.Config, inputs LocalGameInputs) *Executor {
+func NewExecutor(logger log.Logger, m SubprocessMetricer, cl clock.Clock, cfg *config.Config, game common.Address, inputs LocalGameInputs) *Executor {
+	runner := &subprocessRunner{
+		limits: ResourceLimits{
+			MaxMemory:  uint64(cfg.Cannon.MaxMemory) * 1024 * 1024,
+			MaxCPUTime: cfg.Cannon.MaxCPUTime,
+		},
+		timeout: cfg.Cannon.Timeout,
+	}
 	return &Executor{
 		logger:           logger,
+		metrics:          m,
+		game:             game,
 		l1:               cfg.L1EthRpc,
-		l2:               cfg.CannonL2,
+		l2:               cfg.Cannon.L2,
 		inputs:           inputs,
-		cannon:           cfg.CannonBin,
-		server:           cfg.CannonServer,
-		network:          cfg.CannonNetwork,
-		rollupConfig:     cfg.CannonRollupConfigPath,
-		l2Genesis:        cfg.CannonL2GenesisPath,
-		absolutePreState: cfg.CannonAbsolutePreState,
-		snapshotFreq:     cfg.CannonSnapshotFreq,
+		cannon:           cfg.Cannon.Bin,
+		server:           cfg.Cannon.Server,
+		network:          cfg.Cannon.Network,
+		rollupConfig:     cfg.Cannon.RollupConfigPath,
+		l2Genesis:        cfg.Cannon.L2GenesisPath,
+		absolutePreState: cfg.Cannon.AbsolutePreState,
+		snapshotFreq:     cfg.Cannon.SnapshotFreq,
+		maxRestarts:      cfg.Cannon.MaxRestarts,
+		stallThreshold:   cfg.Cannon.StallThreshold,
 		selectSnapshot:   findStartingSnapshot,
-		cmdExecutor:      runCmd,
+		cmdExecutor:      runner.run,
+		clock:            cl,
 	}
 }
 
+// GenerateProof executes cannon to generate a proof at the specified trace index.
+// If cannon fails after starting, it is restarted from the latest snapshot up to maxRestarts times.
 func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) error {
+	for attempt := uint(0); ; attempt++ {
+		err := e.generateProof(ctx, dir, i)
+		var subErr *SubprocessError
+		if !errors.As(err, &subErr) {
+			return err
+		}
+		e.metrics.RecordCannonFailure(e.game, subErr.Reason)
+		if attempt >= e.maxRestarts {
+			e.logger.Error("Cannon failed, giving up", "game", e.game, "proof", i, "reason", subErr.Reason, "restarts", attempt, "err", err)
+			return fmt.Errorf("cannon failed after %v restarts: %w", attempt, err)
+		}
+		e.logger.Warn("Cannon failed, restarting", "game", e.game, "proof", i, "reason", subErr.Reason, "restart", attempt+1, "max", e.maxRestarts, "err", err)
+	}
+}
+
+func (e *Executor) generateProof(ctx context.Context, dir string, i uint64) error {
 	snapshotDir := filepath.Join(dir, snapsDir)
 	start, err := e.selectSnapshot(e.logger, snapshotDir, e.absolutePreState, i)
 	if err != nil {
@@ -116,19 +158,19 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 		return fmt.Errorf("could not create proofs directory %v: %w", proofDir, err)
 	}
 	e.logger.Info("Generating trace", "proof", i, "cmd", e.cannon, "args", strings.Join(args, ", "))
-	return e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...)
-}
-
-func runCmd(ctx context.Context, l log.Logger, binary string, args ...string) error {
-	cmd := exec.CommandContext(ctx, binary, args...)
-	stdOut := oplog.NewWriter(l, log.LvlInfo)
-	defer stdOut.Close()
-	// Keep stdErr at info level because cannon uses stderr for progress messages
-	stdErr := oplog.NewWriter(l, log.LvlInfo)
-	defer stdErr.Close()
-	cmd.Stdout = stdOut
-	cmd.Stderr = stdErr
-	return cmd.Run()
+	startTime := e.clock.Now()
+	if e.stallThreshold != 0 {
+		stalled := e.clock.AfterFunc(e.stallThreshold, func() {
+			e.logger.Warn("Cannon execution stalled", "proof", i, "threshold", e.stallThreshold)
+			e.metrics.RecordCannonStall()
+		})
+		defer stalled.Stop()
+	}
+	if err := e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...); err != nil {
+		return err
+	}
+	e.metrics.RecordCannonExecutionTime(e.clock.Now().Sub(startTime))
+	return nil
 }
 
 // findStartingSnapshot finds the closest snapshot before the specified traceIndex in snapDir.
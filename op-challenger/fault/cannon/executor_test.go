@@ -2,6 +2,7 @@ package cannon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
@@ -23,12 +25,12 @@ func TestGenerateProof(t *testing.T) {
 	input := "starting.json"
 	tempDir := t.TempDir()
 	dir := filepath.Join(tempDir, "gameDir")
-	cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", config.TraceTypeCannon, true, tempDir)
-	cfg.CannonAbsolutePreState = "pre.json"
-	cfg.CannonBin = "./bin/cannon"
-	cfg.CannonServer = "./bin/op-program"
-	cfg.CannonL2 = "http://localhost:9999"
-	cfg.CannonSnapshotFreq = 500
+	cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", true, tempDir, config.TraceTypeCannon)
+	cfg.Cannon.AbsolutePreState = "pre.json"
+	cfg.Cannon.Bin = "./bin/cannon"
+	cfg.Cannon.Server = "./bin/op-program"
+	cfg.Cannon.L2 = "http://localhost:9999"
+	cfg.Cannon.SnapshotFreq = 500
 
 	inputs := LocalGameInputs{
 		L1Head:        common.Hash{0x11},
@@ -38,7 +40,7 @@ func TestGenerateProof(t *testing.T) {
 		L2BlockNumber: big.NewInt(3333),
 	}
 	captureExec := func(t *testing.T, cfg config.Config, proofAt uint64) (string, string, map[string]string) {
-		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &cfg, inputs)
+		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &stubSubprocessMetrics{}, clock.SystemClock, &cfg, common.Address{0xaa}, inputs)
 		executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
 			return input, nil
 		}
@@ -65,14 +67,14 @@ func TestGenerateProof(t *testing.T) {
 	}
 
 	t.Run("Network", func(t *testing.T) {
-		cfg.CannonNetwork = "mainnet"
-		cfg.CannonRollupConfigPath = ""
-		cfg.CannonL2GenesisPath = ""
+		cfg.Cannon.Network = "mainnet"
+		cfg.Cannon.RollupConfigPath = ""
+		cfg.Cannon.L2GenesisPath = ""
 		binary, subcommand, args := captureExec(t, cfg, 150_000_000)
 		require.DirExists(t, filepath.Join(dir, preimagesDir))
 		require.DirExists(t, filepath.Join(dir, proofsDir))
 		require.DirExists(t, filepath.Join(dir, snapsDir))
-		require.Equal(t, cfg.CannonBin, binary)
+		require.Equal(t, cfg.Cannon.Bin, binary)
 		require.Equal(t, "run", subcommand)
 		require.Equal(t, input, args["--input"])
 		require.Contains(t, args, "--meta")
@@ -84,13 +86,13 @@ func TestGenerateProof(t *testing.T) {
 		// Slight quirk of how we pair off args
 		// The server binary winds up as the key and the first arg --server as the value which has no value
 		// Then everything else pairs off correctly again
-		require.Equal(t, "--server", args[cfg.CannonServer])
+		require.Equal(t, "--server", args[cfg.Cannon.Server])
 		require.Equal(t, cfg.L1EthRpc, args["--l1"])
-		require.Equal(t, cfg.CannonL2, args["--l2"])
+		require.Equal(t, cfg.Cannon.L2, args["--l2"])
 		require.Equal(t, filepath.Join(dir, preimagesDir), args["--datadir"])
 		require.Equal(t, filepath.Join(dir, proofsDir, "%d.json"), args["--proof-fmt"])
 		require.Equal(t, filepath.Join(dir, snapsDir, "%d.json"), args["--snapshot-fmt"])
-		require.Equal(t, cfg.CannonNetwork, args["--network"])
+		require.Equal(t, cfg.Cannon.Network, args["--network"])
 		require.NotContains(t, args, "--rollup.config")
 		require.NotContains(t, args, "--l2.genesis")
 
@@ -103,19 +105,19 @@ func TestGenerateProof(t *testing.T) {
 	})
 
 	t.Run("RollupAndGenesis", func(t *testing.T) {
-		cfg.CannonNetwork = ""
-		cfg.CannonRollupConfigPath = "rollup.json"
-		cfg.CannonL2GenesisPath = "genesis.json"
+		cfg.Cannon.Network = ""
+		cfg.Cannon.RollupConfigPath = "rollup.json"
+		cfg.Cannon.L2GenesisPath = "genesis.json"
 		_, _, args := captureExec(t, cfg, 150_000_000)
 		require.NotContains(t, args, "--network")
-		require.Equal(t, cfg.CannonRollupConfigPath, args["--rollup.config"])
-		require.Equal(t, cfg.CannonL2GenesisPath, args["--l2.genesis"])
+		require.Equal(t, cfg.Cannon.RollupConfigPath, args["--rollup.config"])
+		require.Equal(t, cfg.Cannon.L2GenesisPath, args["--l2.genesis"])
 	})
 
 	t.Run("NoStopAtWhenProofIsMaxUInt", func(t *testing.T) {
-		cfg.CannonNetwork = "mainnet"
-		cfg.CannonRollupConfigPath = "rollup.json"
-		cfg.CannonL2GenesisPath = "genesis.json"
+		cfg.Cannon.Network = "mainnet"
+		cfg.Cannon.RollupConfigPath = "rollup.json"
+		cfg.Cannon.L2GenesisPath = "genesis.json"
 		_, _, args := captureExec(t, cfg, math.MaxUint64)
 		// stop-at would need to be one more than the proof step which would overflow back to 0
 		// so expect that it will be omitted. We'll ultimately want cannon to execute until the program exits.
@@ -123,18 +125,94 @@ func TestGenerateProof(t *testing.T) {
 	})
 }
 
-func TestRunCmdLogsOutput(t *testing.T) {
-	bin := "/bin/echo"
-	if _, err := os.Stat(bin); err != nil {
-		t.Skip(bin, " not available", err)
+func TestGenerateProofRestartsFailedRuns(t *testing.T) {
+	game := common.Address{0xaa}
+	setup := func(t *testing.T, maxRestarts uint, results ...error) (*Executor, *stubSubprocessMetrics, *int) {
+		cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", true, t.TempDir(), config.TraceTypeCannon)
+		cfg.Cannon.MaxRestarts = maxRestarts
+		m := &stubSubprocessMetrics{}
+		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, clock.SystemClock, &cfg, game, LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		runs := 0
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, binary string, args ...string) error {
+			err := results[runs]
+			runs++
+			return err
+		}
+		return executor, m, &runs
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	logger := testlog.Logger(t, log.LvlInfo)
-	logs := testlog.Capture(logger)
-	err := runCmd(ctx, logger, bin, "Hello World")
-	require.NoError(t, err)
-	require.NotNil(t, logs.FindLog(log.LvlInfo, "Hello World"))
+
+	t.Run("RecoverAfterRestart", func(t *testing.T) {
+		executor, m, runs := setup(t, 2, &SubprocessError{Reason: FailureKilled}, nil)
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 10))
+		require.Equal(t, 2, *runs)
+		require.Equal(t, []string{FailureKilled}, m.failures[game])
+		require.Len(t, m.executionTimes, 1, "should only record time of successful executions")
+	})
+
+	t.Run("RecordExecutionTime", func(t *testing.T) {
+		executor, m, _ := setup(t, 0, nil)
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		executor.clock = cl
+		run := executor.cmdExecutor
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, binary string, args ...string) error {
+			cl.AdvanceTime(3 * time.Minute)
+			return run(ctx, l, binary, args...)
+		}
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 10))
+		require.Equal(t, []time.Duration{3 * time.Minute}, m.executionTimes)
+	})
+
+	t.Run("GiveUpAfterMaxRestarts", func(t *testing.T) {
+		executor, m, runs := setup(t, 1, &SubprocessError{Reason: FailureExit}, &SubprocessError{Reason: FailureTimeout}, nil)
+		err := executor.GenerateProof(context.Background(), t.TempDir(), 10)
+		var subErr *SubprocessError
+		require.ErrorAs(t, err, &subErr)
+		require.Equal(t, FailureTimeout, subErr.Reason)
+		require.Equal(t, 2, *runs)
+		require.Equal(t, []string{FailureExit, FailureTimeout}, m.failures[game])
+	})
+
+	t.Run("DoNotRestartOtherErrors", func(t *testing.T) {
+		startErr := errors.New("binary not found")
+		executor, m, runs := setup(t, 2, startErr)
+		require.ErrorIs(t, executor.GenerateProof(context.Background(), t.TempDir(), 10), startErr)
+		require.Equal(t, 1, *runs)
+		require.Empty(t, m.failures)
+	})
+}
+
+func TestGenerateProofReportsStalls(t *testing.T) {
+	setup := func(t *testing.T, stallThreshold time.Duration) (*Executor, *stubSubprocessMetrics, *clock.DeterministicClock) {
+		cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", true, t.TempDir(), config.TraceTypeCannon)
+		cfg.Cannon.StallThreshold = stallThreshold
+		m := &stubSubprocessMetrics{}
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, cl, &cfg, common.Address{0xaa}, LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, binary string, args ...string) error {
+			cl.AdvanceTime(10 * time.Minute)
+			return nil
+		}
+		return executor, m, cl
+	}
+
+	t.Run("Stalled", func(t *testing.T) {
+		executor, m, _ := setup(t, 10*time.Minute)
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 10))
+		require.Equal(t, 1, m.stalls)
+	})
+
+	t.Run("CompletedBeforeThreshold", func(t *testing.T) {
+		executor, m, cl := setup(t, 11*time.Minute)
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 10))
+		cl.AdvanceTime(time.Hour)
+		require.Zero(t, m.stalls)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		executor, m, _ := setup(t, 0)
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 10))
+		require.Zero(t, m.stalls)
+	})
 }
 
 func TestFindStartingSnapshot(t *testing.T) {
@@ -208,3 +286,24 @@ func TestFindStartingSnapshot(t *testing.T) {
 		require.Equal(t, filepath.Join(dir, "100.json"), snapshot)
 	})
 }
+
+type stubSubprocessMetrics struct {
+	failures       map[common.Address][]string
+	executionTimes []time.Duration
+	stalls         int
+}
+
+func (s *stubSubprocessMetrics) RecordCannonExecutionTime(t time.Duration) {
+	s.executionTimes = append(s.executionTimes, t)
+}
+
+func (s *stubSubprocessMetrics) RecordCannonStall() {
+	s.stalls++
+}
+
+func (s *stubSubprocessMetrics) RecordCannonFailure(game common.Address, reason string) {
+	if s.failures == nil {
+		s.failures = make(map[common.Address][]string)
+	}
+	s.failures[game] = append(s.failures[game], reason)
+}
@@ -0,0 +1,25 @@
+package cannon
+
+import (
+	"context"
+	"fmt"
+)
+
+// CannonPrestateProvider provides the absolute pre-state from a cannon state file
+// without requiring the inputs needed to generate a full trace.
+type CannonPrestateProvider struct {
+	prestate string
+}
+
+// NewPrestateProvider creates a [CannonPrestateProvider] that loads the absolute pre-state from the specified file.
+func NewPrestateProvider(prestate string) *CannonPrestateProvider {
+	return &CannonPrestateProvider{prestate: prestate}
+}
+
+func (p *CannonPrestateProvider) AbsolutePreState(_ context.Context) ([]byte, error) {
+	state, err := parseState(p.prestate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load absolute pre-state: %w", err)
+	}
+	return state.EncodeWitness(), nil
+}
@@ -0,0 +1,31 @@
+package cannon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrestateProvider(t *testing.T) {
+	t.Run("StateUnavailable", func(t *testing.T) {
+		provider := NewPrestateProvider("/dir/does/not/exist/state.json")
+		_, err := provider.AbsolutePreState(context.Background())
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("MatchesTraceProvider", func(t *testing.T) {
+		dataDir := t.TempDir()
+		setupPreState(t, dataDir, "state.json")
+		provider := NewPrestateProvider(filepath.Join(dataDir, "state.json"))
+		preState, err := provider.AbsolutePreState(context.Background())
+		require.NoError(t, err)
+
+		traceProvider, _ := setupWithTestData(t, dataDir, "state.json")
+		expected, err := traceProvider.AbsolutePreState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected, preState)
+	})
+}
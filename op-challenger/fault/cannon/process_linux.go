@@ -0,0 +1,37 @@
+package cannon
+
+import (
+	"fmt"
+	"math"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// setProcessGroup starts cmd in its own process group and kills the whole group when cmd is cancelled,
+// so that child processes such as op-program are stopped along with cannon.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+// limitCommand returns the command to run binary with args under the resource limits.
+// The limits are set by a shell that then replaces itself with binary, so they apply before binary starts executing
+// and are inherited by its child processes. If the limits can't be set, the command fails without running binary.
+func limitCommand(binary string, args []string, limits ResourceLimits) (string, []string, error) {
+	var ulimits []string
+	if limits.MaxMemory != 0 {
+		// ulimit -v is in kilobytes
+		ulimits = append(ulimits, fmt.Sprintf("ulimit -v %d", (limits.MaxMemory+1023)/1024))
+	}
+	if limits.MaxCPUTime != 0 {
+		ulimits = append(ulimits, fmt.Sprintf("ulimit -t %d", uint64(math.Ceil(limits.MaxCPUTime.Seconds()))))
+	}
+	if len(ulimits) == 0 {
+		return binary, args, nil
+	}
+	script := strings.Join(append(ulimits, `exec "$0" "$@"`), " && ")
+	return "/bin/sh", append([]string{"-c", script, binary}, args...), nil
+}
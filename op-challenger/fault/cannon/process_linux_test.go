@@ -0,0 +1,48 @@
+package cannon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitCommand(t *testing.T) {
+	t.Run("LimitsSetBeforeExec", func(t *testing.T) {
+		limits := ResourceLimits{MaxMemory: 512 * 1024 * 1024, MaxCPUTime: 1500 * time.Millisecond}
+		name, args, err := limitCommand("/bin/sh", []string{"-c", "ulimit -v; ulimit -t; echo $0 $1", "arg0", "arg1"}, limits)
+		require.NoError(t, err)
+		out, err := exec.Command(name, args...).Output()
+		if errors.Is(err, exec.ErrNotFound) {
+			t.Skip("sh not available", err)
+		}
+		require.NoError(t, err)
+		require.Equal(t, "524288\n2\narg0 arg1\n", string(out), "should round CPU time up to whole seconds and pass args unchanged")
+	})
+
+	t.Run("NoLimits", func(t *testing.T) {
+		name, args, err := limitCommand("cannon", []string{"run", "--input", "state.json"}, ResourceLimits{})
+		require.NoError(t, err)
+		require.Equal(t, "cannon", name)
+		require.Equal(t, []string{"run", "--input", "state.json"}, args)
+	})
+}
+
+func TestSetProcessGroupKillsChildren(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// The child sleep process inherits stdout so Wait blocks until it exits
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", "sleep 10; echo done")
+	cmd.Stdout = &bytes.Buffer{}
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		t.Skip("sh not available", err)
+	}
+	start := time.Now()
+	cancel()
+	require.Error(t, cmd.Wait())
+	require.Less(t, time.Since(start), 5*time.Second, "should kill child processes")
+}
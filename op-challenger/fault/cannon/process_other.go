@@ -0,0 +1,16 @@
+//go:build !linux
+
+package cannon
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var errLimitsUnsupported = errors.New("resource limits are only supported on linux")
+
+func setProcessGroup(_ *exec.Cmd) {}
+
+func limitCommand(binary string, args []string, _ ResourceLimits) (string, []string, error) {
+	return binary, args, errLimitsUnsupported
+}
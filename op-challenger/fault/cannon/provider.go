@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -50,10 +51,11 @@ type CannonTraceProvider struct {
 	lastProof *proofData
 }
 
-func NewTraceProvider(ctx context.Context, logger log.Logger, cfg *config.Config, l1Client bind.ContractCaller, dir string, gameAddr common.Address) (*CannonTraceProvider, error) {
-	l2Client, err := ethclient.DialContext(ctx, cfg.CannonL2)
+// NewTraceProvider creates a [CannonTraceProvider] for the game, loading the local inputs from l1Client and the L2 node.
+func NewTraceProvider(ctx context.Context, logger log.Logger, m SubprocessMetricer, cl clock.Clock, cfg *config.Config, l1Client bind.ContractCaller, dir string, gameAddr common.Address) (*CannonTraceProvider, error) {
+	l2Client, err := ethclient.DialContext(ctx, cfg.Cannon.L2)
 	if err != nil {
-		return nil, fmt.Errorf("dial l2 client %v: %w", cfg.CannonL2, err)
+		return nil, fmt.Errorf("dial l2 client %v: %w", cfg.Cannon.L2, err)
 	}
 	defer l2Client.Close() // Not needed after fetching the inputs
 	gameCaller, err := bindings.NewFaultDisputeGameCaller(gameAddr, l1Client)
@@ -64,15 +66,15 @@ func NewTraceProvider(ctx context.Context, logger log.Logger, cfg *config.Config
 	if err != nil {
 		return nil, fmt.Errorf("fetch local game inputs: %w", err)
 	}
-	return NewTraceProviderFromInputs(logger, cfg, localInputs, dir), nil
+	return NewTraceProviderFromInputs(logger, m, cl, cfg, gameAddr, localInputs, dir), nil
 }
 
-func NewTraceProviderFromInputs(logger log.Logger, cfg *config.Config, localInputs LocalGameInputs, dir string) *CannonTraceProvider {
+func NewTraceProviderFromInputs(logger log.Logger, m SubprocessMetricer, cl clock.Clock, cfg *config.Config, gameAddr common.Address, localInputs LocalGameInputs, dir string) *CannonTraceProvider {
 	return &CannonTraceProvider{
 		logger:    logger,
 		dir:       dir,
-		prestate:  cfg.CannonAbsolutePreState,
-		generator: NewExecutor(logger, cfg, localInputs),
+		prestate:  cfg.Cannon.AbsolutePreState,
+		generator: NewExecutor(logger, m, cl, cfg, gameAddr, localInputs),
 	}
 }
 
@@ -109,12 +111,48 @@ func (p *CannonTraceProvider) GetStepData(ctx context.Context, i uint64) ([]byte
 	return value, data, oracleData, nil
 }
 
-func (p *CannonTraceProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
-	state, err := parseState(p.prestate)
+// traceDiagnostics describes the proof used to determine the claim value at a trace index.
+type traceDiagnostics struct {
+	// ProofPath is the file the proof was loaded from. Empty if the index is beyond the end of the trace.
+	ProofPath string `json:"proofPath,omitempty"`
+	// Extended is true if the index is beyond the end of the trace, so the final state is used.
+	Extended bool `json:"extended"`
+	// PreState is the VM state before the step at the trace index is executed.
+	PreState    *witnessState `json:"preState,omitempty"`
+	OracleKey   hexutil.Bytes `json:"oracleKey,omitempty"`
+	OracleValue hexutil.Bytes `json:"oracleValue,omitempty"`
+}
+
+// Diagnose describes the proof used to determine the claim value at trace index i, for disagreement diagnostics.
+func (p *CannonTraceProvider) Diagnose(ctx context.Context, i uint64) (any, error) {
+	proof, err := p.loadProof(ctx, i)
 	if err != nil {
-		return nil, fmt.Errorf("cannot load absolute pre-state: %w", err)
+		return nil, err
 	}
-	return state.EncodeWitness(), nil
+	diag := traceDiagnostics{
+		Extended:    proof == p.lastProof && i > p.lastStep,
+		OracleKey:   proof.OracleKey,
+		OracleValue: proof.OracleValue,
+	}
+	if !diag.Extended {
+		diag.ProofPath = p.proofPath(i)
+	}
+	if len(proof.StateData) > 0 {
+		state, err := decodeWitness(proof.StateData)
+		if err != nil {
+			return nil, fmt.Errorf("decode pre-state: %w", err)
+		}
+		diag.PreState = state
+	}
+	return diag, nil
+}
+
+func (p *CannonTraceProvider) proofPath(i uint64) string {
+	return filepath.Join(p.dir, proofsDir, fmt.Sprintf("%d.json", i))
+}
+
+func (p *CannonTraceProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
+	return NewPrestateProvider(p.prestate).AbsolutePreState(ctx)
 }
 
 // loadProof will attempt to load or generate the proof data at the specified index
@@ -122,9 +160,11 @@ func (p *CannonTraceProvider) AbsolutePreState(ctx context.Context) ([]byte, err
 func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofData, error) {
 	if p.lastProof != nil && i > p.lastStep {
 		// If the requested index is after the last step in the actual trace, extend the final no-op step
+		p.logger.Debug("Using final proof for index beyond end of trace", "index", i, "last_step", p.lastStep)
 		return p.lastProof, nil
 	}
-	path := filepath.Join(p.dir, proofsDir, fmt.Sprintf("%d.json", i))
+	p.logger.Debug("Loading proof", "index", i)
+	path := p.proofPath(i)
 	file, err := os.Open(path)
 	if errors.Is(err, os.ErrNotExist) {
 		if err := p.generator.GenerateProof(ctx, p.dir, i); err != nil {
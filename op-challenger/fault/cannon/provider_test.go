@@ -147,6 +147,69 @@ func TestGetStepData(t *testing.T) {
 	})
 }
 
+func TestDiagnose(t *testing.T) {
+	dataDir, prestate := setupTestData(t)
+	preState := &mipsevm.State{
+		Memory:      &mipsevm.Memory{},
+		PreimageKey: common.Hash{0xab},
+		PC:          4,
+		NextPC:      8,
+		Step:        3,
+	}
+	preState.Registers[2] = 5
+
+	t.Run("GeneratedProof", func(t *testing.T) {
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		generator.finalState = &mipsevm.State{
+			Memory: &mipsevm.Memory{},
+			Step:   10,
+			Exited: true,
+		}
+		generator.proof = &proofData{
+			ClaimValue:  common.Hash{0xaa}.Bytes(),
+			StateData:   preState.EncodeWitness(),
+			ProofData:   []byte{0xcc},
+			OracleKey:   common.Hash{0xdd}.Bytes(),
+			OracleValue: []byte{0xee},
+		}
+		result, err := provider.Diagnose(context.Background(), 3)
+		require.NoError(t, err)
+		diag := result.(traceDiagnostics)
+		require.Equal(t, filepath.Join(dataDir, proofsDir, "3.json"), diag.ProofPath)
+		require.False(t, diag.Extended)
+		require.Equal(t, preState.PreimageKey, diag.PreState.PreimageKey)
+		require.Equal(t, preState.PC, diag.PreState.PC)
+		require.Equal(t, preState.Step, diag.PreState.Step)
+		require.Equal(t, preState.Registers, diag.PreState.Registers)
+		require.EqualValues(t, generator.proof.OracleKey, diag.OracleKey)
+		require.EqualValues(t, generator.proof.OracleValue, diag.OracleValue)
+	})
+
+	t.Run("ProofAfterEndOfTrace", func(t *testing.T) {
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		generator.finalState = &mipsevm.State{
+			Memory:   &mipsevm.Memory{},
+			Step:     10,
+			Exited:   true,
+			ExitCode: 1,
+		}
+		result, err := provider.Diagnose(context.Background(), 7000)
+		require.NoError(t, err)
+		diag := result.(traceDiagnostics)
+		require.True(t, diag.Extended)
+		require.Empty(t, diag.ProofPath)
+		require.True(t, diag.PreState.Exited)
+		require.Equal(t, uint8(1), diag.PreState.ExitCode)
+		require.Equal(t, uint64(10), diag.PreState.Step)
+	})
+
+	t.Run("InvalidStateData", func(t *testing.T) {
+		provider, _ := setupWithTestData(t, dataDir, prestate)
+		_, err := provider.Diagnose(context.Background(), 0)
+		require.ErrorContains(t, err, "invalid state witness length")
+	})
+}
+
 func TestAbsolutePreState(t *testing.T) {
 	dataDir := t.TempDir()
 
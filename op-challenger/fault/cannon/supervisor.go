@@ -0,0 +1,160 @@
+package cannon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// FailureExit indicates the subprocess exited with a non-zero exit code.
+	FailureExit = "exit"
+	// FailureKilled indicates the subprocess was terminated by a signal, typically because it exceeded a resource limit.
+	FailureKilled = "killed"
+	// FailureTimeout indicates the subprocess was stopped because it exceeded the configured timeout.
+	FailureTimeout = "timeout"
+
+	// stderrTailLines is the number of trailing stderr lines included in a SubprocessError.
+	stderrTailLines = 20
+	// waitDelay is the time to wait for output to be closed after the subprocess is killed.
+	waitDelay = 5 * time.Second
+)
+
+// ResourceLimits restricts the resources available to a subprocess. Zero values are unlimited.
+type ResourceLimits struct {
+	MaxMemory  uint64        // Maximum virtual memory in bytes
+	MaxCPUTime time.Duration // Maximum CPU time
+}
+
+func (l ResourceLimits) enabled() bool {
+	return l.MaxMemory != 0 || l.MaxCPUTime != 0
+}
+
+// SubprocessError reports a subprocess that did not complete successfully.
+type SubprocessError struct {
+	Reason string
+	Stderr []string
+	Err    error
+}
+
+func (e *SubprocessError) Error() string {
+	if len(e.Stderr) == 0 {
+		return fmt.Sprintf("subprocess failed (%v): %v", e.Reason, e.Err)
+	}
+	return fmt.Sprintf("subprocess failed (%v): %v, stderr:\n%v", e.Reason, e.Err, strings.Join(e.Stderr, "\n"))
+}
+
+func (e *SubprocessError) Unwrap() error {
+	return e.Err
+}
+
+// subprocessRunner runs a binary with resource limits and a timeout applied, logging its output.
+type subprocessRunner struct {
+	limits  ResourceLimits
+	timeout time.Duration
+}
+
+// run executes the binary and waits for it to complete.
+// Returns a *SubprocessError if the binary fails after it has started.
+func (r *subprocessRunner) run(ctx context.Context, l log.Logger, binary string, args ...string) error {
+	runCtx := ctx
+	if r.timeout != 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	name, cmdArgs := binary, args
+	if r.limits.enabled() {
+		var err error
+		if name, cmdArgs, err = limitCommand(binary, args, r.limits); err != nil {
+			l.Warn("Unable to apply resource limits to subprocess", "err", err)
+		}
+	}
+	cmd := exec.CommandContext(runCtx, name, cmdArgs...)
+	setProcessGroup(cmd)
+	cmd.WaitDelay = waitDelay
+	stdOut := oplog.NewWriter(l, log.LvlInfo)
+	defer stdOut.Close()
+	// Keep stdErr at info level because cannon uses stderr for progress messages
+	stdErr := oplog.NewWriter(l.New("stream", "stderr"), log.LvlInfo)
+	defer stdErr.Close()
+	stdErrTail := newLineTail(stderrTailLines)
+	cmd.Stdout = stdOut
+	cmd.Stderr = io.MultiWriter(stdErr, stdErrTail)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start %v: %w", binary, err)
+	}
+	err := cmd.Wait()
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		// Cancelled by the caller rather than failing
+		return ctx.Err()
+	}
+	subErr := &SubprocessError{Reason: FailureExit, Stderr: stdErrTail.Lines(), Err: err}
+	var exitErr *exec.ExitError
+	if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		subErr.Reason = FailureTimeout
+	} else if errors.As(err, &exitErr) && exitErr.ExitCode() == -1 {
+		subErr.Reason = FailureKilled
+	}
+	return subErr
+}
+
+// lineTail is an io.Writer that retains the last complete lines written to it.
+type lineTail struct {
+	mu      sync.Mutex
+	max     int
+	lines   []string
+	partial []byte
+}
+
+func newLineTail(max int) *lineTail {
+	return &lineTail{max: max}
+}
+
+func (t *lineTail) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.partial = append(t.partial, p...)
+	for {
+		idx := bytes.IndexByte(t.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		t.add(string(t.partial[:idx]))
+		t.partial = t.partial[idx+1:]
+	}
+	return len(p), nil
+}
+
+func (t *lineTail) add(line string) {
+	t.lines = append(t.lines, line)
+	if len(t.lines) > t.max {
+		t.lines = t.lines[len(t.lines)-t.max:]
+	}
+}
+
+// Lines returns the retained lines, including any trailing partial line.
+func (t *lineTail) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	lines := append([]string(nil), t.lines...)
+	if len(t.partial) > 0 {
+		lines = append(lines, string(t.partial))
+	}
+	if len(lines) > t.max {
+		lines = lines[len(lines)-t.max:]
+	}
+	return lines
+}
@@ -0,0 +1,82 @@
+package cannon
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLogsOutput(t *testing.T) {
+	bin := "/bin/echo"
+	if _, err := os.Stat(bin); err != nil {
+		t.Skip(bin, " not available", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	runner := &subprocessRunner{}
+	err := runner.run(ctx, logger, bin, "Hello World")
+	require.NoError(t, err)
+	require.NotNil(t, logs.FindLog(log.LvlInfo, "Hello World"))
+}
+
+func TestRunFailures(t *testing.T) {
+	sh := "/bin/sh"
+	if _, err := os.Stat(sh); err != nil {
+		t.Skip(sh, " not available", err)
+	}
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	t.Run("Exit", func(t *testing.T) {
+		runner := &subprocessRunner{}
+		err := runner.run(context.Background(), logger, sh, "-c", "echo first >&2; echo second >&2; exit 3")
+		var subErr *SubprocessError
+		require.ErrorAs(t, err, &subErr)
+		require.Equal(t, FailureExit, subErr.Reason)
+		require.Equal(t, []string{"first", "second"}, subErr.Stderr)
+	})
+
+	t.Run("Killed", func(t *testing.T) {
+		runner := &subprocessRunner{}
+		err := runner.run(context.Background(), logger, sh, "-c", "kill -9 $$")
+		var subErr *SubprocessError
+		require.ErrorAs(t, err, &subErr)
+		require.Equal(t, FailureKilled, subErr.Reason)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		runner := &subprocessRunner{timeout: 50 * time.Millisecond}
+		err := runner.run(context.Background(), logger, sh, "-c", "sleep 10")
+		var subErr *SubprocessError
+		require.ErrorAs(t, err, &subErr)
+		require.Equal(t, FailureTimeout, subErr.Reason)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		runner := &subprocessRunner{}
+		err := runner.run(ctx, logger, sh, "-c", "sleep 10")
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestLineTail(t *testing.T) {
+	tail := newLineTail(3)
+	for i := 0; i < 5; i++ {
+		_, err := tail.Write([]byte("line " + strconv.Itoa(i) + "\n"))
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"line 2", "line 3", "line 4"}, tail.Lines())
+
+	_, err := tail.Write([]byte("partial"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 3", "line 4", "partial"}, tail.Lines())
+}
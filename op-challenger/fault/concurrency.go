@@ -0,0 +1,198 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// defaultMemoryPerGame is the memory assumed to be used by each game progressed concurrently when the memory
+	// available to cannon is not limited.
+	defaultMemoryPerGame = 2 << 30
+	// diskPerGame is the free disk space required for each game progressed concurrently.
+	diskPerGame = 1 << 30
+	// tuneInterval is the time between adjustments of the concurrency.
+	tuneInterval = time.Minute
+	// baselineWeight is the weight of the latest average cannon execution time in the moving average used as the
+	// baseline to detect slowdowns. Lower weights make the baseline less sensitive to noise.
+	baselineWeight = 0.2
+)
+
+var (
+	errResourcesUnsupported = errors.New("system resource checks are only supported on linux")
+	errMemAvailableMissing  = errors.New("MemAvailable not found in /proc/meminfo")
+)
+
+// SystemResources reports the resources available to the challenger.
+type SystemResources interface {
+	NumCPU() int
+	AvailableMemory() (uint64, error)
+	FreeDisk(dir string) (uint64, error)
+}
+
+type systemResources struct{}
+
+func (systemResources) NumCPU() int {
+	return runtime.NumCPU()
+}
+
+// autoConcurrency returns the number of games that can be progressed concurrently with the available CPU cores,
+// memory and disk space in dir. Resources that can't be checked are ignored. Returns at least 1.
+func autoConcurrency(logger log.Logger, resources SystemResources, dir string, memoryPerGame uint64) uint {
+	limit := uint(resources.NumCPU())
+	if mem, err := resources.AvailableMemory(); err != nil {
+		logger.Warn("Unable to check available memory, ignoring it when setting concurrency", "err", err)
+	} else if memLimit := uint(mem / memoryPerGame); memLimit < limit {
+		limit = memLimit
+	}
+	if disk, err := resources.FreeDisk(dir); err != nil {
+		logger.Warn("Unable to check free disk space, ignoring it when setting concurrency", "err", err)
+	} else if diskLimit := uint(disk / diskPerGame); diskLimit < limit {
+		limit = diskLimit
+	}
+	if limit < 1 {
+		return 1
+	}
+	return limit
+}
+
+// ConcurrencyLimiter controls the number of games progressed concurrently.
+type ConcurrencyLimiter interface {
+	MaxConcurrency() uint
+	SetMaxConcurrency(maxConcurrency uint)
+}
+
+type ConcurrencyMetricer interface {
+	RecordMaxConcurrency(concurrency uint)
+}
+
+// concurrencyTuner adjusts the number of games progressed concurrently, between 1 and maxConcurrency.
+// Concurrency is reduced when available memory is too low to run another cannon execution or when cannon executions
+// slow down, indicating they are contending for resources. Otherwise it is increased back towards maxConcurrency.
+type concurrencyTuner struct {
+	logger         log.Logger
+	clock          clock.Clock
+	metrics        ConcurrencyMetricer
+	resources      SystemResources
+	limiter        ConcurrencyLimiter
+	maxConcurrency uint
+	memoryPerGame  uint64
+
+	lock      sync.Mutex
+	durations []time.Duration
+	// baseline is the exponentially weighted moving average of the average cannon execution time of each check.
+	baseline time.Duration
+}
+
+func newConcurrencyTuner(logger log.Logger, cl clock.Clock, m ConcurrencyMetricer, resources SystemResources, limiter ConcurrencyLimiter, maxConcurrency uint, memoryPerGame uint64) *concurrencyTuner {
+	return &concurrencyTuner{
+		logger:         logger.New("component", "concurrency"),
+		clock:          cl,
+		metrics:        m,
+		resources:      resources,
+		limiter:        limiter,
+		maxConcurrency: maxConcurrency,
+		memoryPerGame:  memoryPerGame,
+	}
+}
+
+// RecordCannonExecution records the time taken by a successful cannon execution.
+func (t *concurrencyTuner) RecordCannonExecution(d time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.durations = append(t.durations, d)
+}
+
+// Start adjusts the concurrency in the background until ctx is done.
+func (t *concurrencyTuner) Start(ctx context.Context) {
+	go func() {
+		ticker := t.clock.NewTicker(tuneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Ch():
+				t.tune()
+			}
+		}
+	}()
+}
+
+func (t *concurrencyTuner) tune() {
+	current := t.limiter.MaxConcurrency()
+	next := current
+	var reason string
+	if t.memoryPressure() {
+		next--
+		reason = "low available memory"
+	} else if t.slowedDown() {
+		next--
+		reason = "cannon executions slowed down"
+	} else if current < t.maxConcurrency {
+		next++
+		reason = "resources available"
+	}
+	if next < 1 {
+		next = 1
+	}
+	if next == current {
+		return
+	}
+	t.logger.Info("Adjusting concurrency", "from", current, "to", next, "reason", reason)
+	t.limiter.SetMaxConcurrency(next)
+	t.metrics.RecordMaxConcurrency(next)
+}
+
+// memoryPressure returns true if there is not enough available memory for another cannon execution.
+func (t *concurrencyTuner) memoryPressure() bool {
+	mem, err := t.resources.AvailableMemory()
+	if err != nil {
+		t.logger.Debug("Unable to check available memory", "err", err)
+		return false
+	}
+	return mem < t.memoryPerGame
+}
+
+// slowedDown returns true if the average cannon execution time since the last check is more than 50% longer than
+// the baseline. The baseline is a moving average of previous checks rather than the fastest average seen, so a single
+// unusually fast check doesn't cause normal execution times to be treated as a slowdown, and the baseline follows
+// gradual changes in execution times.
+func (t *concurrencyTuner) slowedDown() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(t.durations) == 0 {
+		return false
+	}
+	var total time.Duration
+	for _, d := range t.durations {
+		total += d
+	}
+	avg := total / time.Duration(len(t.durations))
+	t.durations = nil
+	if t.baseline == 0 {
+		t.baseline = avg
+		return false
+	}
+	slow := avg > t.baseline*3/2
+	t.baseline += time.Duration(baselineWeight * float64(avg-t.baseline))
+	return slow
+}
+
+// tunedMetrics reports cannon execution times to the concurrency tuner in addition to recording them.
+type tunedMetrics struct {
+	metrics.Metricer
+	tuner *concurrencyTuner
+}
+
+func (m *tunedMetrics) RecordCannonExecutionTime(t time.Duration) {
+	m.Metricer.RecordCannonExecutionTime(t)
+	m.tuner.RecordCannonExecution(t)
+}
@@ -0,0 +1,188 @@
+package fault
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+const testMemoryPerGame = 2 << 30
+
+func TestAutoConcurrency(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	tests := []struct {
+		name      string
+		resources *stubResources
+		expected  uint
+	}{
+		{
+			name:      "LimitedByCPU",
+			resources: &stubResources{cpus: 4, memory: 100 * testMemoryPerGame, disk: 100 * diskPerGame},
+			expected:  4,
+		},
+		{
+			name:      "LimitedByMemory",
+			resources: &stubResources{cpus: 8, memory: 3*testMemoryPerGame + 1, disk: 100 * diskPerGame},
+			expected:  3,
+		},
+		{
+			name:      "LimitedByDisk",
+			resources: &stubResources{cpus: 8, memory: 100 * testMemoryPerGame, disk: 2 * diskPerGame},
+			expected:  2,
+		},
+		{
+			name:      "AtLeastOne",
+			resources: &stubResources{cpus: 8, memory: 1, disk: 1},
+			expected:  1,
+		},
+		{
+			name:      "IgnoreUnavailableResources",
+			resources: &stubResources{cpus: 6, err: errResourcesUnsupported},
+			expected:  6,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, autoConcurrency(logger, test.resources, t.TempDir(), testMemoryPerGame))
+		})
+	}
+}
+
+func TestConcurrencyTuner(t *testing.T) {
+	setup := func(t *testing.T, current uint, maxConcurrency uint) (*concurrencyTuner, *stubResources, *stubLimiter, *stubConcurrencyMetrics) {
+		resources := &stubResources{cpus: 8, memory: 10 * testMemoryPerGame}
+		limiter := &stubLimiter{maxConcurrency: current}
+		m := &stubConcurrencyMetrics{}
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		tuner := newConcurrencyTuner(testlog.Logger(t, log.LvlInfo), cl, m, resources, limiter, maxConcurrency, testMemoryPerGame)
+		return tuner, resources, limiter, m
+	}
+
+	t.Run("IncreaseWhenResourcesAvailable", func(t *testing.T) {
+		tuner, _, limiter, m := setup(t, 2, 4)
+		tuner.tune()
+		require.Equal(t, uint(3), limiter.maxConcurrency)
+		require.Equal(t, uint(3), m.maxConcurrency)
+	})
+
+	t.Run("DoNotExceedMax", func(t *testing.T) {
+		tuner, _, limiter, m := setup(t, 4, 4)
+		tuner.tune()
+		require.Equal(t, uint(4), limiter.maxConcurrency)
+		require.Zero(t, m.maxConcurrency, "should not record unchanged concurrency")
+	})
+
+	t.Run("DecreaseUnderMemoryPressure", func(t *testing.T) {
+		tuner, resources, limiter, _ := setup(t, 3, 4)
+		resources.memory = testMemoryPerGame - 1
+		tuner.tune()
+		require.Equal(t, uint(2), limiter.maxConcurrency)
+	})
+
+	t.Run("DoNotDecreaseBelowOne", func(t *testing.T) {
+		tuner, resources, limiter, _ := setup(t, 1, 4)
+		resources.memory = 0
+		tuner.tune()
+		require.Equal(t, uint(1), limiter.maxConcurrency)
+	})
+
+	t.Run("IgnoreUnavailableMemory", func(t *testing.T) {
+		tuner, resources, limiter, _ := setup(t, 2, 4)
+		resources.err = errors.New("boom")
+		tuner.tune()
+		require.Equal(t, uint(3), limiter.maxConcurrency)
+	})
+
+	t.Run("DecreaseWhenCannonSlowsDown", func(t *testing.T) {
+		tuner, _, limiter, _ := setup(t, 2, 4)
+		tuner.RecordCannonExecution(10 * time.Minute)
+		tuner.RecordCannonExecution(12 * time.Minute)
+		tuner.tune()
+		require.Equal(t, uint(3), limiter.maxConcurrency, "first executions set the baseline")
+
+		tuner.RecordCannonExecution(14 * time.Minute)
+		tuner.tune()
+		require.Equal(t, uint(4), limiter.maxConcurrency, "should tolerate small slowdowns")
+
+		tuner.RecordCannonExecution(20 * time.Minute)
+		tuner.tune()
+		require.Equal(t, uint(3), limiter.maxConcurrency)
+
+		// Executions that aren't slow allow concurrency to increase again
+		tuner.RecordCannonExecution(11 * time.Minute)
+		tuner.tune()
+		require.Equal(t, uint(4), limiter.maxConcurrency)
+	})
+
+	t.Run("IgnoreNoisyExecutionTimes", func(t *testing.T) {
+		tuner, _, limiter, _ := setup(t, 4, 4)
+		// An unusually fast check must not become the baseline that normal execution times are compared against
+		for _, d := range []time.Duration{10, 14, 7, 13, 8, 14, 7, 13} {
+			tuner.RecordCannonExecution(d * time.Minute)
+			tuner.tune()
+			require.Equal(t, uint(4), limiter.maxConcurrency)
+		}
+	})
+
+	t.Run("FollowGradualChanges", func(t *testing.T) {
+		tuner, _, limiter, _ := setup(t, 4, 4)
+		for d := time.Duration(10); d <= 20; d++ {
+			tuner.RecordCannonExecution(d * time.Minute)
+			tuner.tune()
+			require.Equal(t, uint(4), limiter.maxConcurrency)
+		}
+	})
+
+	t.Run("TunedMetrics", func(t *testing.T) {
+		tuner, _, _, _ := setup(t, 2, 4)
+		m := &tunedMetrics{Metricer: metrics.NoopMetrics, tuner: tuner}
+		m.RecordCannonExecutionTime(time.Minute)
+		require.Equal(t, []time.Duration{time.Minute}, tuner.durations)
+	})
+}
+
+type stubResources struct {
+	cpus   int
+	memory uint64
+	disk   uint64
+	err    error
+}
+
+func (s *stubResources) NumCPU() int {
+	return s.cpus
+}
+
+func (s *stubResources) AvailableMemory() (uint64, error) {
+	return s.memory, s.err
+}
+
+func (s *stubResources) FreeDisk(_ string) (uint64, error) {
+	return s.disk, s.err
+}
+
+type stubLimiter struct {
+	maxConcurrency uint
+}
+
+func (s *stubLimiter) MaxConcurrency() uint {
+	return s.maxConcurrency
+}
+
+func (s *stubLimiter) SetMaxConcurrency(maxConcurrency uint) {
+	s.maxConcurrency = maxConcurrency
+}
+
+type stubConcurrencyMetrics struct {
+	maxConcurrency uint
+}
+
+func (s *stubConcurrencyMetrics) RecordMaxConcurrency(concurrency uint) {
+	s.maxConcurrency = concurrency
+}
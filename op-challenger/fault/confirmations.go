@@ -0,0 +1,79 @@
+package fault
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// confirmedHead tracks the latest L1 block with at least the minimum number of confirmations.
+// It is updated by the game monitor and read by the [confirmedCaller] used by game players so is safe for
+// concurrent use.
+type confirmedHead struct {
+	depth uint64
+	// block is the confirmed block number plus one, so that zero means no block has been confirmed yet.
+	block atomic.Uint64
+}
+
+func newConfirmedHead(depth uint64) *confirmedHead {
+	return &confirmedHead{depth: depth}
+}
+
+// Update records the current L1 head and returns the latest block that has the minimum confirmations.
+// If the chain is not yet long enough to have a confirmed block, the genesis block is used so that the monitor and
+// game players both read state from it.
+func (c *confirmedHead) Update(head uint64) uint64 {
+	var confirmed uint64
+	if head > c.depth {
+		confirmed = head - c.depth
+	}
+	c.block.Store(confirmed + 1)
+	return confirmed
+}
+
+// BlockNumber returns the latest confirmed block, or nil to use the latest block if no confirmation depth is set
+// or the head hasn't been updated yet.
+func (c *confirmedHead) BlockNumber() *big.Int {
+	if c.depth == 0 {
+		return nil
+	}
+	block := c.block.Load()
+	if block == 0 {
+		return nil
+	}
+	return new(big.Int).SetUint64(block - 1)
+}
+
+// confirmedCaller is a [bind.ContractCaller] that sends calls for the latest block to the latest confirmed block
+// instead, so the challenger only acts on games and claims that are unlikely to be removed by a reorg.
+// Calls for a specific block are passed through unchanged.
+type confirmedCaller struct {
+	caller bind.ContractCaller
+	head   *confirmedHead
+}
+
+func newConfirmedCaller(caller bind.ContractCaller, head *confirmedHead) *confirmedCaller {
+	return &confirmedCaller{
+		caller: caller,
+		head:   head,
+	}
+}
+
+func (c *confirmedCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.caller.CodeAt(ctx, contract, c.blockNumber(blockNumber))
+}
+
+func (c *confirmedCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.caller.CallContract(ctx, call, c.blockNumber(blockNumber))
+}
+
+func (c *confirmedCaller) blockNumber(requested *big.Int) *big.Int {
+	if requested != nil {
+		return requested
+	}
+	return c.head.BlockNumber()
+}
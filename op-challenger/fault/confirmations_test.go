@@ -0,0 +1,67 @@
+package fault
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmedHead(t *testing.T) {
+	t.Run("NoDepth", func(t *testing.T) {
+		head := newConfirmedHead(0)
+		require.Equal(t, uint64(100), head.Update(100))
+		require.Nil(t, head.BlockNumber(), "should use latest block")
+	})
+
+	t.Run("Depth", func(t *testing.T) {
+		head := newConfirmedHead(10)
+		require.Nil(t, head.BlockNumber(), "should use latest block before first update")
+		require.Equal(t, uint64(90), head.Update(100))
+		require.Equal(t, big.NewInt(90), head.BlockNumber())
+	})
+
+	t.Run("ChainShorterThanDepth", func(t *testing.T) {
+		head := newConfirmedHead(10)
+		require.Zero(t, head.Update(5))
+		require.Equal(t, big.NewInt(0), head.BlockNumber(), "should read from the same block as the monitor")
+		require.Zero(t, head.Update(10))
+		require.Equal(t, big.NewInt(0), head.BlockNumber())
+	})
+}
+
+func TestConfirmedCaller(t *testing.T) {
+	head := newConfirmedHead(10)
+	head.Update(100)
+	stub := &stubBlockCaller{}
+	caller := newConfirmedCaller(stub, head)
+
+	_, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(90), stub.blockNumber, "should call at confirmed block")
+
+	_, err = caller.CodeAt(context.Background(), common.Address{0xaa}, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(90), stub.blockNumber, "should load code at confirmed block")
+
+	_, err = caller.CallContract(context.Background(), ethereum.CallMsg{}, big.NewInt(95))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(95), stub.blockNumber, "should not change requested block")
+}
+
+type stubBlockCaller struct {
+	blockNumber *big.Int
+}
+
+func (s *stubBlockCaller) CodeAt(_ context.Context, _ common.Address, blockNumber *big.Int) ([]byte, error) {
+	s.blockNumber = blockNumber
+	return nil, nil
+}
+
+func (s *stubBlockCaller) CallContract(_ context.Context, _ ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	s.blockNumber = blockNumber
+	return nil, nil
+}
@@ -0,0 +1,236 @@
+package corpus
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Version is the version of the binary format written by [Encode].
+	Version = 1
+
+	// FileExt is the extension of step files written to the corpus directory.
+	FileExt = ".step"
+
+	// maxFieldLen limits the size of variable length fields when decoding to avoid allocating excessive memory for
+	// corrupt files.
+	maxFieldLen = 1 << 26
+)
+
+var (
+	magic = [4]byte{'O', 'P', 'S', 'C'}
+
+	ErrInvalidMagic       = errors.New("not a step corpus file")
+	ErrUnsupportedVersion = errors.New("unsupported step corpus version")
+	ErrFieldTooLarge      = errors.New("field too large")
+)
+
+// Step is a single step transition computed by the challenger.
+// Executing the step from PreState with Proof, after loading OracleData into the preimage oracle, is expected to
+// result in a state with the claim value PostState.
+type Step struct {
+	Game       common.Address
+	ClaimIndex uint64
+	IsAttack   bool
+	PreState   []byte
+	Proof      []byte
+	OracleData *types.PreimageOracleData // May be nil if the step doesn't require preimage data
+	PostState  common.Hash
+}
+
+// Encode writes the step to w in the corpus binary format.
+// All integers are big endian. Variable length fields are prefixed with their length as a uint32.
+//
+//	magic "OPSC" | version uint8 | game [20]byte | claimIndex uint64 | isAttack uint8 |
+//	preState bytes | proof bytes | hasOracleData uint8 |
+//	[isLocal uint8 | oracleKey bytes | oracleData bytes | oracleOffset uint32] | postState [32]byte
+func Encode(w io.Writer, step Step) error {
+	var buf bytes.Buffer
+	buf.Write(magic[:])
+	buf.WriteByte(Version)
+	buf.Write(step.Game[:])
+	_ = binary.Write(&buf, binary.BigEndian, step.ClaimIndex)
+	writeBool(&buf, step.IsAttack)
+	writeBytes(&buf, step.PreState)
+	writeBytes(&buf, step.Proof)
+	writeBool(&buf, step.OracleData != nil)
+	if step.OracleData != nil {
+		writeBool(&buf, step.OracleData.IsLocal)
+		writeBytes(&buf, step.OracleData.OracleKey)
+		writeBytes(&buf, step.OracleData.OracleData)
+		_ = binary.Write(&buf, binary.BigEndian, step.OracleData.OracleOffset)
+	}
+	buf.Write(step.PostState[:])
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Decode reads a step in the corpus binary format from r.
+func Decode(r io.Reader) (Step, error) {
+	in := bufio.NewReader(r)
+	var header [5]byte
+	if _, err := io.ReadFull(in, header[:]); err != nil {
+		return Step{}, fmt.Errorf("read header: %w", err)
+	}
+	if !bytes.Equal(header[:4], magic[:]) {
+		return Step{}, ErrInvalidMagic
+	}
+	if header[4] != Version {
+		return Step{}, fmt.Errorf("%w: %v", ErrUnsupportedVersion, header[4])
+	}
+	var step Step
+	if _, err := io.ReadFull(in, step.Game[:]); err != nil {
+		return Step{}, fmt.Errorf("read game: %w", err)
+	}
+	if err := binary.Read(in, binary.BigEndian, &step.ClaimIndex); err != nil {
+		return Step{}, fmt.Errorf("read claim index: %w", err)
+	}
+	var err error
+	if step.IsAttack, err = readBool(in); err != nil {
+		return Step{}, fmt.Errorf("read is attack: %w", err)
+	}
+	if step.PreState, err = readBytes(in); err != nil {
+		return Step{}, fmt.Errorf("read pre-state: %w", err)
+	}
+	if step.Proof, err = readBytes(in); err != nil {
+		return Step{}, fmt.Errorf("read proof: %w", err)
+	}
+	hasOracleData, err := readBool(in)
+	if err != nil {
+		return Step{}, fmt.Errorf("read has oracle data: %w", err)
+	}
+	if hasOracleData {
+		data := &types.PreimageOracleData{}
+		if data.IsLocal, err = readBool(in); err != nil {
+			return Step{}, fmt.Errorf("read oracle is local: %w", err)
+		}
+		if data.OracleKey, err = readBytes(in); err != nil {
+			return Step{}, fmt.Errorf("read oracle key: %w", err)
+		}
+		if data.OracleData, err = readBytes(in); err != nil {
+			return Step{}, fmt.Errorf("read oracle data: %w", err)
+		}
+		if err := binary.Read(in, binary.BigEndian, &data.OracleOffset); err != nil {
+			return Step{}, fmt.Errorf("read oracle offset: %w", err)
+		}
+		step.OracleData = data
+	}
+	if _, err := io.ReadFull(in, step.PostState[:]); err != nil {
+		return Step{}, fmt.Errorf("read post-state: %w", err)
+	}
+	return step, nil
+}
+
+func writeBool(buf *bytes.Buffer, b bool) {
+	if b {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+}
+
+func writeBytes(buf *bytes.Buffer, data []byte) {
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(data)))
+	buf.Write(data)
+}
+
+func readBool(r io.ByteReader) (bool, error) {
+	b, err := r.ReadByte()
+	return b != 0, err
+}
+
+func readBytes(r io.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if length > maxFieldLen {
+		return nil, fmt.Errorf("%w: %v bytes", ErrFieldTooLarge, length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Recorder writes steps to a corpus directory, one file per step.
+// Files are named after the hash of their content so a step that is computed repeatedly is only recorded once.
+type Recorder struct {
+	logger log.Logger
+	dir    string
+}
+
+// NewRecorder creates a new [Recorder] that writes steps to dir, creating it if needed.
+func NewRecorder(logger log.Logger, dir string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create step corpus dir: %w", err)
+	}
+	return &Recorder{
+		logger: logger.New("component", "corpus"),
+		dir:    dir,
+	}, nil
+}
+
+// RecordStep writes the step to the corpus directory.
+// Failures are logged rather than returned so recording never interferes with playing games.
+func (r *Recorder) RecordStep(step Step) {
+	path, err := r.write(step)
+	if err != nil {
+		r.logger.Error("Failed to record step", "game", step.Game, "claimIndex", step.ClaimIndex, "err", err)
+		return
+	}
+	r.logger.Debug("Recorded step", "game", step.Game, "claimIndex", step.ClaimIndex, "file", path)
+}
+
+// ForGame returns a [GameRecorder] that records steps for the game.
+func (r *Recorder) ForGame(game common.Address) *GameRecorder {
+	return &GameRecorder{recorder: r, game: game}
+}
+
+// GameRecorder records steps for a single game.
+type GameRecorder struct {
+	recorder *Recorder
+	game     common.Address
+}
+
+// RecordStep writes the step to the corpus directory as a step in the recorder's game.
+func (g *GameRecorder) RecordStep(step Step) {
+	step.Game = g.game
+	g.recorder.RecordStep(step)
+}
+
+func (r *Recorder) write(step Step) (string, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, step); err != nil {
+		return "", fmt.Errorf("failed to encode step: %w", err)
+	}
+	path := filepath.Join(r.dir, crypto.Keccak256Hash(buf.Bytes()).Hex()+FileExt)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	tmp, err := os.CreateTemp(r.dir, "tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create step file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("failed to write step file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to close step file: %w", err)
+	}
+	return path, os.Rename(tmp.Name(), path)
+}
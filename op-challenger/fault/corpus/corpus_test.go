@@ -0,0 +1,138 @@
+package corpus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+	}{
+		{
+			name: "WithoutOracleData",
+			step: Step{
+				Game:       common.Address{0xaa},
+				ClaimIndex: 7,
+				IsAttack:   true,
+				PreState:   []byte{1, 2, 3},
+				Proof:      []byte{4, 5},
+				PostState:  common.Hash{0xbb},
+			},
+		},
+		{
+			name: "WithOracleData",
+			step: Step{
+				Game:       common.Address{0xcc},
+				ClaimIndex: 1 << 40,
+				PreState:   []byte{1},
+				Proof:      []byte{},
+				OracleData: &types.PreimageOracleData{
+					IsLocal:      true,
+					OracleKey:    []byte{6, 7},
+					OracleData:   []byte{8, 9, 10},
+					OracleOffset: 32,
+				},
+				PostState: common.Hash{0xdd},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Encode(&buf, test.step))
+			actual, err := Decode(&buf)
+			require.NoError(t, err)
+			require.Equal(t, test.step.Game, actual.Game)
+			require.Equal(t, test.step.ClaimIndex, actual.ClaimIndex)
+			require.Equal(t, test.step.IsAttack, actual.IsAttack)
+			require.Equal(t, test.step.PreState, actual.PreState)
+			require.Equal(t, len(test.step.Proof), len(actual.Proof))
+			require.Equal(t, test.step.OracleData, actual.OracleData)
+			require.Equal(t, test.step.PostState, actual.PostState)
+		})
+	}
+}
+
+func TestEncodingIsStable(t *testing.T) {
+	step := Step{
+		Game:       common.Address{0xaa},
+		ClaimIndex: 2,
+		IsAttack:   true,
+		PreState:   []byte{0x01},
+		Proof:      []byte{0x02},
+		OracleData: &types.PreimageOracleData{OracleKey: []byte{0x03}, OracleData: []byte{0x04}, OracleOffset: 5},
+		PostState:  common.Hash{0xbb},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, step))
+	expected := "4f50534301" + // magic and version
+		"aa00000000000000000000000000000000000000" + // game
+		"0000000000000002" + // claim index
+		"01" + // is attack
+		"0000000101" + // pre-state
+		"0000000102" + // proof
+		"01" + "00" + "0000000103" + "0000000104" + "00000005" + // oracle data
+		"bb00000000000000000000000000000000000000000000000000000000000000" // post-state
+	require.Equal(t, expected, hex.EncodeToString(buf.Bytes()))
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Run("InvalidMagic", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader([]byte("ABCD\x01")))
+		require.ErrorIs(t, err, ErrInvalidMagic)
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader([]byte("OPSC\x02")))
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("FieldTooLarge", func(t *testing.T) {
+		data := append([]byte("OPSC\x01"), make([]byte, 20+8+1)...)
+		data = append(data, 0xff, 0xff, 0xff, 0xff)
+		_, err := Decode(bytes.NewReader(data))
+		require.ErrorIs(t, err, ErrFieldTooLarge)
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "corpus")
+	recorder, err := NewRecorder(testlog.Logger(t, log.LvlInfo), dir)
+	require.NoError(t, err)
+
+	step1 := Step{Game: common.Address{0xaa}, ClaimIndex: 1, PreState: []byte{1}, Proof: []byte{2}, PostState: common.Hash{0x01}}
+	step2 := Step{Game: common.Address{0xaa}, ClaimIndex: 2, PreState: []byte{3}, Proof: []byte{4}, PostState: common.Hash{0x02}}
+	recorder.RecordStep(step1)
+	recorder.ForGame(step2.Game).RecordStep(Step{ClaimIndex: 2, PreState: []byte{3}, Proof: []byte{4}, PostState: common.Hash{0x02}})
+	// Recording the same step again should not create a duplicate
+	recorder.RecordStep(step1)
+	recorder.RecordStep(step2)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	var claimIndices []uint64
+	for _, entry := range entries {
+		require.Equal(t, FileExt, filepath.Ext(entry.Name()))
+		f, err := os.Open(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+		step, err := Decode(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+		require.Equal(t, common.Address{0xaa}, step.Game)
+		claimIndices = append(claimIndices, step.ClaimIndex)
+	}
+	require.ElementsMatch(t, []uint64{1, 2}, claimIndices)
+}
@@ -0,0 +1,295 @@
+package costs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/reporter"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	gamesDir   = "games"
+	dailyDir   = "daily"
+	fileExt    = ".json"
+	dateFormat = "2006-01-02"
+
+	// refreshInterval is the time between updates of the daily metrics, so they reset when the day changes.
+	refreshInterval = time.Hour
+)
+
+// Report is the cost of participating in a single resolved game.
+// Amounts are in wei and encoded as JSON numbers so negative values can be represented.
+type Report struct {
+	Game           common.Address `json:"game"`
+	Won            bool           `json:"won"`
+	GasCost        *big.Int       `json:"gasCost"`        // Total fees in wei paid for transactions sent to the game
+	BondsLocked    *big.Int       `json:"bondsLocked"`    // Total value in wei bonded in the game
+	BondsRecovered *big.Int       `json:"bondsRecovered"` // Value in wei of bonds expected to be returned
+	NetProfit      *big.Int       `json:"netProfit"`      // Bonds recovered minus bonds locked and gas cost. Negative for a loss
+	CreatedAt      uint64         `json:"createdAt"`      // Unix timestamp the game was created
+	ResolvedAt     uint64         `json:"resolvedAt"`     // Unix timestamp the challenger observed the game was complete
+	Duration       uint64         `json:"duration"`       // Seconds between the game being created and resolved
+}
+
+// NewReport calculates the cost report for a completed game from its outcome.
+// Bonds are assumed to be recovered in full when the game is won and forfeited when it is lost.
+func NewReport(outcome reporter.Outcome) Report {
+	gasCost := bigOrZero(outcome.GasCost)
+	bonded := bigOrZero(outcome.Bonded)
+	recovered := new(big.Int)
+	if outcome.Won {
+		recovered.Set(bonded)
+	}
+	profit := new(big.Int).Sub(recovered, bonded)
+	profit.Sub(profit, gasCost)
+	var duration uint64
+	if outcome.ResolvedAt > outcome.CreatedAt {
+		duration = outcome.ResolvedAt - outcome.CreatedAt
+	}
+	return Report{
+		Game:           outcome.Game,
+		Won:            outcome.Won,
+		GasCost:        gasCost,
+		BondsLocked:    bonded,
+		BondsRecovered: recovered,
+		NetProfit:      profit,
+		CreatedAt:      outcome.CreatedAt,
+		ResolvedAt:     outcome.ResolvedAt,
+		Duration:       duration,
+	}
+}
+
+func bigOrZero(v *hexutil.Big) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(v.ToInt())
+}
+
+// Totals are the combined costs of all games resolved on a single UTC day.
+type Totals struct {
+	Date           string   `json:"date"`
+	Games          uint64   `json:"games"`
+	Won            uint64   `json:"won"`
+	GasCost        *big.Int `json:"gasCost"`
+	BondsLocked    *big.Int `json:"bondsLocked"`
+	BondsRecovered *big.Int `json:"bondsRecovered"`
+	NetProfit      *big.Int `json:"netProfit"`
+	Duration       uint64   `json:"duration"`
+}
+
+func newTotals(date string) *Totals {
+	return &Totals{
+		Date:           date,
+		GasCost:        new(big.Int),
+		BondsLocked:    new(big.Int),
+		BondsRecovered: new(big.Int),
+		NetProfit:      new(big.Int),
+	}
+}
+
+func (t *Totals) add(report Report) {
+	t.Games++
+	if report.Won {
+		t.Won++
+	}
+	t.GasCost.Add(t.GasCost, report.GasCost)
+	t.BondsLocked.Add(t.BondsLocked, report.BondsLocked)
+	t.BondsRecovered.Add(t.BondsRecovered, report.BondsRecovered)
+	t.NetProfit.Add(t.NetProfit, report.NetProfit)
+	t.Duration += report.Duration
+}
+
+type Metricer interface {
+	RecordDailyCosts(games uint64, gasCost *big.Int, bondsLocked *big.Int, bondsRecovered *big.Int, netProfit *big.Int, gameTime time.Duration)
+}
+
+// Ledger stores a cost report for each resolved game and aggregates them into daily totals.
+// Reports and totals are archived as JSON files in the ledger's directory, and the totals for the current day are
+// exposed via metrics.
+type Ledger struct {
+	logger  log.Logger
+	clock   clock.Clock
+	metrics Metricer
+	dir     string
+
+	lock  sync.Mutex
+	daily map[string]*Totals
+}
+
+// NewLedger creates a [Ledger] archiving reports in dir, loading the totals of any previously archived reports.
+func NewLedger(logger log.Logger, cl clock.Clock, m Metricer, dir string) (*Ledger, error) {
+	if err := os.MkdirAll(filepath.Join(dir, gamesDir), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cost report dir: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, dailyDir), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create daily cost dir: %w", err)
+	}
+	l := &Ledger{
+		logger:  logger.New("component", "costs"),
+		clock:   cl,
+		metrics: m,
+		dir:     dir,
+		daily:   make(map[string]*Totals),
+	}
+	if err := l.load(); err != nil {
+		return nil, err
+	}
+	l.recordMetrics()
+	return l, nil
+}
+
+// load aggregates the archived game reports into daily totals.
+func (l *Ledger) load() error {
+	entries, err := os.ReadDir(filepath.Join(l.dir, gamesDir))
+	if err != nil {
+		return fmt.Errorf("failed to list cost reports: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fileExt) {
+			continue
+		}
+		var report Report
+		if err := readJSON(filepath.Join(l.dir, gamesDir, entry.Name()), &report); err != nil {
+			l.logger.Warn("Ignoring invalid cost report", "file", entry.Name(), "err", err)
+			continue
+		}
+		l.totalsFor(report).add(report)
+	}
+	return nil
+}
+
+// Start keeps the daily metrics up to date in the background until ctx is done.
+func (l *Ledger) Start(ctx context.Context) {
+	go func() {
+		ticker := l.clock.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Ch():
+				l.recordMetrics()
+			}
+		}
+	}()
+}
+
+// ReportOutcome records the cost report for a completed game.
+// Games that already have a report are ignored so they are only counted once, including after a restart.
+// Failures are logged rather than returned so reporting never interferes with playing games.
+func (l *Ledger) ReportOutcome(outcome reporter.Outcome) {
+	report := NewReport(outcome)
+	if err := l.Record(report); err != nil {
+		l.logger.Error("Failed to record cost report", "game", report.Game, "err", err)
+	}
+}
+
+// Record archives the report and adds it to the totals for the day the game was resolved.
+func (l *Ledger) Record(report Report) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	path := filepath.Join(l.dir, gamesDir, report.Game.Hex()+fileExt)
+	if _, err := os.Stat(path); err == nil {
+		l.logger.Debug("Cost report already recorded", "game", report.Game)
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to check for existing cost report: %w", err)
+	}
+	if err := writeJSON(path, report); err != nil {
+		return fmt.Errorf("failed to write cost report: %w", err)
+	}
+	totals := l.totalsFor(report)
+	totals.add(report)
+	if err := writeJSON(filepath.Join(l.dir, dailyDir, totals.Date+fileExt), totals); err != nil {
+		return fmt.Errorf("failed to write daily costs: %w", err)
+	}
+	l.logger.Info("Recorded game costs", "game", report.Game, "won", report.Won, "gasCost", report.GasCost,
+		"bondsLocked", report.BondsLocked, "bondsRecovered", report.BondsRecovered, "netProfit", report.NetProfit,
+		"duration", time.Duration(report.Duration)*time.Second)
+	l.recordMetricsLocked()
+	return nil
+}
+
+// DailyTotals returns the totals for each day a game was resolved on, oldest first.
+func (l *Ledger) DailyTotals() []Totals {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	totals := make([]Totals, 0, len(l.daily))
+	for _, t := range l.daily {
+		totals = append(totals, *t)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Date < totals[j].Date
+	})
+	return totals
+}
+
+// totalsFor returns the totals for the day the report's game was resolved. The lock must be held.
+func (l *Ledger) totalsFor(report Report) *Totals {
+	date := time.Unix(int64(report.ResolvedAt), 0).UTC().Format(dateFormat)
+	totals, ok := l.daily[date]
+	if !ok {
+		totals = newTotals(date)
+		l.daily[date] = totals
+	}
+	return totals
+}
+
+func (l *Ledger) recordMetrics() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.recordMetricsLocked()
+}
+
+// recordMetricsLocked records the totals for the current day. The lock must be held.
+func (l *Ledger) recordMetricsLocked() {
+	totals, ok := l.daily[l.clock.Now().UTC().Format(dateFormat)]
+	if !ok {
+		totals = newTotals("")
+	}
+	l.metrics.RecordDailyCosts(totals.Games, totals.GasCost, totals.BondsLocked, totals.BondsRecovered,
+		totals.NetProfit, time.Duration(totals.Duration)*time.Second)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeJSON atomically writes v to path as JSON.
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,168 @@
+package costs
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/reporter"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// day1 and day2 are midday on consecutive UTC days
+var (
+	day1 = time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	day2 = day1.Add(24 * time.Hour)
+)
+
+func TestNewReport(t *testing.T) {
+	t.Run("Won", func(t *testing.T) {
+		report := NewReport(outcome(common.Address{0xaa}, true, day1))
+		require.Equal(t, Report{
+			Game:           common.Address{0xaa},
+			Won:            true,
+			GasCost:        big.NewInt(100),
+			BondsLocked:    big.NewInt(1000),
+			BondsRecovered: big.NewInt(1000),
+			NetProfit:      big.NewInt(-100),
+			CreatedAt:      uint64(day1.Unix()) - 3600,
+			ResolvedAt:     uint64(day1.Unix()),
+			Duration:       3600,
+		}, report)
+	})
+
+	t.Run("Lost", func(t *testing.T) {
+		report := NewReport(outcome(common.Address{0xaa}, false, day1))
+		require.Equal(t, big.NewInt(0), report.BondsRecovered)
+		require.Equal(t, big.NewInt(-1100), report.NetProfit)
+	})
+
+	t.Run("NoActivity", func(t *testing.T) {
+		report := NewReport(reporter.Outcome{Game: common.Address{0xaa}, Won: true, ResolvedAt: 10})
+		require.Equal(t, big.NewInt(0), report.GasCost)
+		require.Equal(t, big.NewInt(0), report.BondsLocked)
+		require.Equal(t, big.NewInt(0), report.NetProfit)
+		require.Equal(t, uint64(10), report.Duration)
+	})
+}
+
+func TestLedger(t *testing.T) {
+	setup := func(t *testing.T, dir string) (*Ledger, *clock.DeterministicClock, *stubMetrics) {
+		cl := clock.NewDeterministicClock(day2)
+		m := &stubMetrics{}
+		ledger, err := NewLedger(testlog.Logger(t, log.LvlInfo), cl, m, dir)
+		require.NoError(t, err)
+		return ledger, cl, m
+	}
+
+	t.Run("AggregateDailyTotals", func(t *testing.T) {
+		ledger, _, m := setup(t, t.TempDir())
+		ledger.ReportOutcome(outcome(common.Address{0x01}, true, day1))
+		ledger.ReportOutcome(outcome(common.Address{0x02}, true, day2))
+		ledger.ReportOutcome(outcome(common.Address{0x03}, false, day2))
+
+		totals := ledger.DailyTotals()
+		require.Len(t, totals, 2)
+		require.Equal(t, "2023-08-01", totals[0].Date)
+		require.Equal(t, uint64(1), totals[0].Games)
+		require.Equal(t, Totals{
+			Date:           "2023-08-02",
+			Games:          2,
+			Won:            1,
+			GasCost:        big.NewInt(200),
+			BondsLocked:    big.NewInt(2000),
+			BondsRecovered: big.NewInt(1000),
+			NetProfit:      big.NewInt(-1200),
+			Duration:       7200,
+		}, totals[1])
+
+		// Metrics report the totals for the current day
+		require.Equal(t, uint64(2), m.games)
+		require.Equal(t, big.NewInt(-1200), m.netProfit)
+		require.Equal(t, 2*time.Hour, m.gameTime)
+	})
+
+	t.Run("IgnoreDuplicateReports", func(t *testing.T) {
+		ledger, _, m := setup(t, t.TempDir())
+		ledger.ReportOutcome(outcome(common.Address{0x01}, true, day2))
+		ledger.ReportOutcome(outcome(common.Address{0x01}, true, day2))
+		require.Equal(t, uint64(1), ledger.DailyTotals()[0].Games)
+		require.Equal(t, uint64(1), m.games)
+	})
+
+	t.Run("ArchiveReports", func(t *testing.T) {
+		dir := t.TempDir()
+		ledger, _, _ := setup(t, dir)
+		ledger.ReportOutcome(outcome(common.Address{0x01}, false, day2))
+
+		var report Report
+		data, err := os.ReadFile(filepath.Join(dir, gamesDir, common.Address{0x01}.Hex()+fileExt))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &report))
+		require.Equal(t, NewReport(outcome(common.Address{0x01}, false, day2)), report)
+
+		var totals Totals
+		data, err = os.ReadFile(filepath.Join(dir, dailyDir, "2023-08-02"+fileExt))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &totals))
+		require.Equal(t, ledger.DailyTotals()[0], totals)
+	})
+
+	t.Run("LoadArchivedReports", func(t *testing.T) {
+		dir := t.TempDir()
+		ledger, _, _ := setup(t, dir)
+		ledger.ReportOutcome(outcome(common.Address{0x01}, true, day1))
+		ledger.ReportOutcome(outcome(common.Address{0x02}, true, day2))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, gamesDir, "invalid.json"), []byte("{"), 0644))
+
+		reloaded, _, m := setup(t, dir)
+		require.Equal(t, ledger.DailyTotals(), reloaded.DailyTotals())
+		require.Equal(t, uint64(1), m.games)
+
+		// Games already recorded before the restart are not counted again
+		reloaded.ReportOutcome(outcome(common.Address{0x02}, true, day2))
+		require.Equal(t, ledger.DailyTotals(), reloaded.DailyTotals())
+	})
+
+	t.Run("ResetMetricsOnNewDay", func(t *testing.T) {
+		ledger, cl, m := setup(t, t.TempDir())
+		ledger.ReportOutcome(outcome(common.Address{0x01}, true, day2))
+		require.Equal(t, uint64(1), m.games)
+
+		cl.AdvanceTime(24 * time.Hour)
+		ledger.recordMetrics()
+		require.Zero(t, m.games)
+		require.Equal(t, big.NewInt(0), m.netProfit)
+	})
+}
+
+func outcome(game common.Address, won bool, resolvedAt time.Time) reporter.Outcome {
+	return reporter.Outcome{
+		Game:       game,
+		Won:        won,
+		GasCost:    (*hexutil.Big)(big.NewInt(100)),
+		Bonded:     (*hexutil.Big)(big.NewInt(1000)),
+		CreatedAt:  uint64(resolvedAt.Add(-time.Hour).Unix()),
+		ResolvedAt: uint64(resolvedAt.Unix()),
+	}
+}
+
+type stubMetrics struct {
+	games     uint64
+	netProfit *big.Int
+	gameTime  time.Duration
+}
+
+func (s *stubMetrics) RecordDailyCosts(games uint64, _ *big.Int, _ *big.Int, _ *big.Int, netProfit *big.Int, gameTime time.Duration) {
+	s.games = games
+	s.netProfit = netProfit
+	s.gameTime = gameTime
+}
@@ -0,0 +1,186 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Dir is the directory within a game's data directory that diagnostic bundles are stored in.
+	Dir = "diagnostics"
+
+	filePrefix = "claim-"
+	fileExt    = ".json"
+)
+
+// TraceDiagnoser is implemented by trace providers that can describe how the value at a trace index was computed.
+// The result is included in diagnostic bundles and must be JSON serializable.
+type TraceDiagnoser interface {
+	Diagnose(ctx context.Context, i uint64) (any, error)
+}
+
+// Bundle describes a claim the challenger disagrees with, to help determine whether the disagreement is a real fault
+// or a problem with the challenger's own trace.
+type Bundle struct {
+	Game       common.Address `json:"game"`
+	ClaimIndex uint64         `json:"claimIndex"`
+	Position   *hexutil.Big   `json:"position"` // Generalized index of the claim's position
+	Depth      uint64         `json:"depth"`
+	TraceIndex uint64         `json:"traceIndex"`
+	// TheirValue is the value of the claim in the game.
+	TheirValue common.Hash `json:"theirValue"`
+	// OurValue is the value expected by the challenger's trace provider at the claim's trace index.
+	OurValue common.Hash `json:"ourValue"`
+	// Trace is the trace provider's description of how OurValue was computed. Omitted if the trace provider
+	// doesn't support diagnostics.
+	Trace json.RawMessage `json:"trace,omitempty"`
+	// TraceError is the error from the trace provider if it failed to describe how OurValue was computed.
+	TraceError string `json:"traceError,omitempty"`
+	RecordedAt uint64 `json:"recordedAt"` // Unix timestamp of when the bundle was recorded
+}
+
+// Recorder records a diagnostic bundle for each claim in a game the challenger disagrees with.
+// Bundles are written to the [Dir] directory within the game's data directory, so are removed with the rest of the
+// game data once the game is resolved.
+type Recorder struct {
+	logger    log.Logger
+	clock     clock.Clock
+	game      common.Address
+	dir       string
+	trace     types.TraceProvider
+	gameDepth int
+
+	// recorded is the contract index of claims a bundle has already been recorded for.
+	recorded map[int]bool
+}
+
+// NewRecorder creates a [Recorder] for the game, storing bundles in the game's data directory gameDir.
+// Bundles are timestamped with the current time reported by cl.
+func NewRecorder(logger log.Logger, cl clock.Clock, game common.Address, gameDir string, trace types.TraceProvider, gameDepth int) *Recorder {
+	return &Recorder{
+		logger:    logger,
+		clock:     cl,
+		game:      game,
+		dir:       filepath.Join(gameDir, Dir),
+		trace:     trace,
+		gameDepth: gameDepth,
+		recorded:  make(map[int]bool),
+	}
+}
+
+// RecordDisagreement records a diagnostic bundle for the claim, unless one has already been recorded.
+// Failures are logged rather than returned so they don't prevent the challenger responding to the claim.
+func (r *Recorder) RecordDisagreement(ctx context.Context, claim types.Claim) {
+	if r.recorded[claim.ContractIndex] {
+		return
+	}
+	path := filepath.Join(r.dir, fmt.Sprintf("%v%d%v", filePrefix, claim.ContractIndex, fileExt))
+	if _, err := os.Stat(path); err == nil {
+		// Recorded before the challenger restarted
+		r.recorded[claim.ContractIndex] = true
+		return
+	}
+	bundle, err := r.bundle(ctx, claim)
+	if err != nil {
+		r.logger.Warn("Failed to create disagreement diagnostics", "claim", claim.ContractIndex, "err", err)
+		return
+	}
+	if err := write(path, bundle); err != nil {
+		r.logger.Warn("Failed to write disagreement diagnostics", "claim", claim.ContractIndex, "err", err)
+		return
+	}
+	r.recorded[claim.ContractIndex] = true
+	r.logger.Info("Recorded disagreement diagnostics", "claim", claim.ContractIndex, "trace_index", bundle.TraceIndex,
+		"their_value", bundle.TheirValue, "our_value", bundle.OurValue)
+}
+
+func (r *Recorder) bundle(ctx context.Context, claim types.Claim) (Bundle, error) {
+	traceIndex := claim.TraceIndex(r.gameDepth)
+	index := uint64(math.MaxUint64)
+	if traceIndex.IsUint64() {
+		index = traceIndex.Uint64()
+	}
+	ourValue, err := r.trace.Get(ctx, index)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("get value at trace index %v: %w", index, err)
+	}
+	bundle := Bundle{
+		Game:       r.game,
+		ClaimIndex: uint64(claim.ContractIndex),
+		Position:   (*hexutil.Big)(claim.ToGIndex()),
+		Depth:      uint64(claim.Depth()),
+		TraceIndex: index,
+		TheirValue: claim.Value,
+		OurValue:   ourValue,
+		RecordedAt: uint64(r.clock.Now().Unix()),
+	}
+	if diagnoser, ok := r.trace.(TraceDiagnoser); ok {
+		if detail, err := diagnoser.Diagnose(ctx, index); err != nil {
+			bundle.TraceError = err.Error()
+		} else if bundle.Trace, err = json.Marshal(detail); err != nil {
+			bundle.TraceError = fmt.Sprintf("encode trace diagnostics: %v", err)
+		}
+	}
+	return bundle, nil
+}
+
+func write(path string, bundle Bundle) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create diagnostics dir: %w", err)
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so partially written bundles are never loaded
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load returns the diagnostic bundles recorded in the game's data directory gameDir, ordered by claim index.
+// Returns an empty slice if no bundles have been recorded.
+func Load(gameDir string) ([]Bundle, error) {
+	dir := filepath.Join(gameDir, Dir)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Bundle{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("list diagnostics: %w", err)
+	}
+	bundles := make([]Bundle, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("read %v: %w", name, err)
+		}
+		var bundle Bundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, fmt.Errorf("invalid diagnostics %v: %w", name, err)
+		}
+		bundles = append(bundles, bundle)
+	}
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].ClaimIndex < bundles[j].ClaimIndex
+	})
+	return bundles, nil
+}
@@ -0,0 +1,184 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+const gameDepth = 4
+
+var gameAddr = common.Address{0xaa}
+
+func TestRecordDisagreement(t *testing.T) {
+	ctx := context.Background()
+	claim := types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{0xbb},
+			Position: positions.NewPosition(2, big.NewInt(1)),
+		},
+		ContractIndex: 3,
+	}
+
+	t.Run("WritesBundle", func(t *testing.T) {
+		dir := t.TempDir()
+		trace := alphabet.NewTraceProvider("abcdefghijklmnop", gameDepth)
+		recorder, cl := newRecorder(t, dir, trace)
+		recorder.RecordDisagreement(ctx, claim)
+
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		expected, err := trace.Get(ctx, 7)
+		require.NoError(t, err)
+		require.Equal(t, []Bundle{{
+			Game:       gameAddr,
+			ClaimIndex: 3,
+			Position:   (*hexutil.Big)(big.NewInt(5)),
+			Depth:      2,
+			TraceIndex: 7,
+			TheirValue: common.Hash{0xbb},
+			OurValue:   expected,
+			RecordedAt: uint64(cl.Now().Unix()),
+		}}, bundles)
+	})
+
+	t.Run("IncludesTraceDiagnostics", func(t *testing.T) {
+		dir := t.TempDir()
+		trace := &stubDiagnoser{TraceProvider: alphabet.NewTraceProvider("abcdefghijklmnop", gameDepth), detail: map[string]uint64{"step": 7}}
+		recorder, _ := newRecorder(t, dir, trace)
+		recorder.RecordDisagreement(ctx, claim)
+
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		require.JSONEq(t, `{"step":7}`, string(bundles[0].Trace))
+		require.Empty(t, bundles[0].TraceError)
+		require.Equal(t, []uint64{7}, trace.requested)
+	})
+
+	t.Run("TraceDiagnosticsError", func(t *testing.T) {
+		dir := t.TempDir()
+		trace := &stubDiagnoser{TraceProvider: alphabet.NewTraceProvider("abcdefghijklmnop", gameDepth), err: errors.New("boom")}
+		recorder, _ := newRecorder(t, dir, trace)
+		recorder.RecordDisagreement(ctx, claim)
+
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		require.Empty(t, bundles[0].Trace)
+		require.Equal(t, "boom", bundles[0].TraceError)
+	})
+
+	t.Run("RecordsOncePerClaim", func(t *testing.T) {
+		dir := t.TempDir()
+		trace := &stubDiagnoser{TraceProvider: alphabet.NewTraceProvider("abcdefghijklmnop", gameDepth)}
+		recorder, _ := newRecorder(t, dir, trace)
+		recorder.RecordDisagreement(ctx, claim)
+		recorder.RecordDisagreement(ctx, claim)
+		require.Len(t, trace.requested, 1)
+
+		// Bundles recorded before a restart are not recorded again
+		restarted, _ := newRecorder(t, dir, trace)
+		restarted.RecordDisagreement(ctx, claim)
+		require.Len(t, trace.requested, 1)
+	})
+
+	t.Run("TraceError", func(t *testing.T) {
+		dir := t.TempDir()
+		recorder, _ := newRecorder(t, dir, &errorTrace{})
+		recorder.RecordDisagreement(ctx, claim)
+
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		require.Empty(t, bundles)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("NoBundles", func(t *testing.T) {
+		bundles, err := Load(t.TempDir())
+		require.NoError(t, err)
+		require.NotNil(t, bundles)
+		require.Empty(t, bundles)
+	})
+
+	t.Run("OrderedByClaimIndex", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, idx := range []uint64{10, 2, 5} {
+			writeBundle(t, dir, "claim-"+big.NewInt(int64(idx)).String()+".json", Bundle{ClaimIndex: idx})
+		}
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, bundles, 3)
+		require.Equal(t, uint64(2), bundles[0].ClaimIndex)
+		require.Equal(t, uint64(5), bundles[1].ClaimIndex)
+		require.Equal(t, uint64(10), bundles[2].ClaimIndex)
+	})
+
+	t.Run("IgnoreOtherFiles", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundle(t, dir, "claim-1.json", Bundle{ClaimIndex: 1})
+		writeBundle(t, dir, "claim-2.json.tmp", Bundle{ClaimIndex: 2})
+		writeBundle(t, dir, "other.json", Bundle{ClaimIndex: 3})
+		bundles, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		require.Equal(t, uint64(1), bundles[0].ClaimIndex)
+	})
+
+	t.Run("InvalidBundle", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, Dir), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, Dir, "claim-1.json"), []byte("{"), 0644))
+		_, err := Load(dir)
+		require.ErrorContains(t, err, "invalid diagnostics")
+	})
+}
+
+func newRecorder(t *testing.T, dir string, trace types.TraceProvider) (*Recorder, *clock.DeterministicClock) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	recorder := NewRecorder(testlog.Logger(t, log.LvlInfo), cl, gameAddr, dir, trace, gameDepth)
+	return recorder, cl
+}
+
+func writeBundle(t *testing.T, dir string, name string, bundle Bundle) {
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, Dir), 0755))
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, Dir, name), data, 0644))
+}
+
+type stubDiagnoser struct {
+	types.TraceProvider
+	detail    any
+	err       error
+	requested []uint64
+}
+
+func (s *stubDiagnoser) Diagnose(_ context.Context, i uint64) (any, error) {
+	s.requested = append(s.requested, i)
+	return s.detail, s.err
+}
+
+type errorTrace struct {
+	types.TraceProvider
+}
+
+func (e *errorTrace) Get(_ context.Context, _ uint64) (common.Hash, error) {
+	return common.Hash{}, errors.New("no trace")
+}
@@ -1,32 +1,357 @@
 package fault
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"golang.org/x/exp/slices"
 )
 
-const gameDirPrefix = "game-"
+const (
+	gameDirPrefix = "game-"
+	gameLogsDir   = "logs"
+	outcomesDir   = "outcomes"
+	costsDir      = "costs"
+	profilesDir   = "profiles"
+	gameSizesFile = "game-sizes.json"
+
+	// maxGameSizes is the number of recent game data sizes of each trace type used to estimate the space required by
+	// new games.
+	maxGameSizes = 10
+
+	// diskUsageRefreshInterval is how often the space used by the data directories of games with reserved space is
+	// updated.
+	diskUsageRefreshInterval = time.Minute
+)
+
+var ErrInsufficientDisk = errors.New("insufficient disk space")
 
 // diskManager coordinates the storage of game data on disk.
+// Before a game is played, space is reserved for its data so games that can't complete are not started. The space
+// required is estimated from the largest data directory of recently resolved games of the same trace type, or a default
+// for the trace type until games have been resolved. The trace type of a game isn't known until its player is created,
+// so the largest estimate of the supported trace types is reserved until then.
+// The space already used by each game with reserved space is refreshed in the background so reserving space doesn't
+// need to walk every game's data directory.
 type diskManager struct {
-	datadir string
+	logger    log.Logger
+	clock     clock.Clock
+	datadir   string
+	resources SystemResources
+
+	lock       sync.Mutex
+	traceTypes []config.TraceType
+	// gameSizes are the sizes in bytes of the data directories of recently removed games by trace type, oldest first.
+	gameSizes    map[config.TraceType][]uint64
+	reservations map[common.Address]*reservation
+}
+
+type reservation struct {
+	// size is the space in bytes reserved for the game's data.
+	size uint64
+	// used is the space in bytes used by the game's data directory when last checked.
+	used uint64
+	// traceType is the trace type of the game once its player has been created.
+	traceType config.TraceType
+}
+
+// remaining returns the part of the reserved space that the game's data directory isn't already using.
+func (r *reservation) remaining() uint64 {
+	if r.used >= r.size {
+		return 0
+	}
+	return r.size - r.used
 }
 
-func newDiskManager(dir string) *diskManager {
-	return &diskManager{datadir: dir}
+// newDiskManager creates a diskManager storing data in dir.
+// resources may be nil, in which case no space is reserved for games.
+func newDiskManager(logger log.Logger, cl clock.Clock, dir string, resources SystemResources, traceTypes []config.TraceType) *diskManager {
+	d := &diskManager{
+		logger:       logger.New("component", "disk"),
+		clock:        cl,
+		datadir:      dir,
+		resources:    resources,
+		traceTypes:   traceTypes,
+		gameSizes:    make(map[config.TraceType][]uint64),
+		reservations: make(map[common.Address]*reservation),
+	}
+	if err := d.loadGameSizes(); err != nil {
+		d.logger.Warn("Failed to load previous game data sizes", "err", err)
+	}
+	return d
+}
+
+// defaultReservation returns the space to reserve for games of the trace type before any have been resolved.
+func defaultReservation(traceType config.TraceType) uint64 {
+	if traceType == config.TraceTypeCannon {
+		return diskPerGame
+	}
+	return 0
 }
 
 func (d *diskManager) DirForGame(addr common.Address) string {
 	return filepath.Join(d.datadir, gameDirPrefix+addr.Hex())
 }
 
+// LogFileForGame returns the path of the log file for the game.
+// Log files are stored separately to the game data so log rotation doesn't affect it, but are removed with the game's
+// data by [RemoveAllExcept].
+func (d *diskManager) LogFileForGame(addr common.Address) string {
+	return filepath.Join(d.datadir, gameLogsDir, gameDirPrefix+addr.Hex()+".log")
+}
+
+// OutcomesDir returns the directory outcome reports are queued in until they are sent.
+func (d *diskManager) OutcomesDir() string {
+	return filepath.Join(d.datadir, outcomesDir)
+}
+
+// CostsDir returns the directory the cost reports of resolved games are archived in.
+func (d *diskManager) CostsDir() string {
+	return filepath.Join(d.datadir, costsDir)
+}
+
+// AuditDir returns the directory the audit log of actions taken by the challenger is stored in.
+func (d *diskManager) AuditDir() string {
+	return filepath.Join(d.datadir, audit.DirName)
+}
+
+// ProfilesDir returns the directory pprof profiles captured after anomalies are stored in.
+func (d *diskManager) ProfilesDir() string {
+	return filepath.Join(d.datadir, profilesDir)
+}
+
+// Reserve reserves the space expected to be required by the game's data.
+// Returns ErrInsufficientDisk if the free disk space isn't enough for the game in addition to the space still
+// reserved for other games. Space already used by the game's data directory counts towards its reservation, so games
+// resumed after a restart only require the remaining space. Reserving space for a game more than once has no effect.
+// Only the game's own data directory is checked. The space used by other games is the last value from [Start].
+func (d *diskManager) Reserve(addr common.Address) error {
+	if d.resources == nil {
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, ok := d.reservations[addr]; ok {
+		return nil
+	}
+	estimate := d.estimateGameSize()
+	if estimate == 0 {
+		d.reservations[addr] = &reservation{}
+		return nil
+	}
+	if err := os.MkdirAll(d.datadir, 0755); err != nil {
+		return fmt.Errorf("failed to create datadir: %w", err)
+	}
+	res := &reservation{size: estimate}
+	if used, err := dirSize(d.DirForGame(addr)); err != nil {
+		d.logger.Warn("Unable to check game data size", "game", addr, "err", err)
+	} else {
+		res.used = used
+	}
+	free, err := d.resources.FreeDisk(d.datadir)
+	if err != nil {
+		d.logger.Warn("Unable to check free disk space, not reserving space for game", "game", addr, "err", err)
+		d.reservations[addr] = res
+		return nil
+	}
+	var reserved uint64
+	for _, other := range d.reservations {
+		reserved += other.remaining()
+	}
+	required := res.remaining()
+	if free < reserved || free-reserved < required {
+		return fmt.Errorf("%w for game %v: %v bytes required, %v bytes free with %v bytes reserved for other games",
+			ErrInsufficientDisk, addr, required, free, reserved)
+	}
+	d.logger.Debug("Reserved disk space for game", "game", addr, "size", estimate, "required", required)
+	d.reservations[addr] = res
+	return nil
+}
+
+// SetTraceType records the trace type of a game once its player has been created. The space reserved for the game is
+// reduced to the estimate for its trace type, and the size of its data is used to estimate the space required by
+// future games of the same trace type once it is removed.
+func (d *diskManager) SetTraceType(addr common.Address, traceType config.TraceType) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	res, ok := d.reservations[addr]
+	if !ok {
+		return
+	}
+	res.traceType = traceType
+	if estimate := d.estimateForTraceType(traceType); estimate < res.size {
+		res.size = estimate
+	}
+}
+
+// Start periodically refreshes the space used by the data directories of games with reserved space until ctx is done.
+func (d *diskManager) Start(ctx context.Context) {
+	if d.resources == nil {
+		return
+	}
+	go func() {
+		ticker := d.clock.NewTicker(diskUsageRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Ch():
+				d.refreshUsage()
+			}
+		}
+	}()
+}
+
+// refreshUsage updates the space used by each game with reserved space.
+// The lock isn't held while walking the data directories so reserving space for new games isn't delayed.
+func (d *diskManager) refreshUsage() {
+	d.lock.Lock()
+	games := make([]common.Address, 0, len(d.reservations))
+	for addr := range d.reservations {
+		games = append(games, addr)
+	}
+	d.lock.Unlock()
+	for _, addr := range games {
+		used, err := dirSize(d.DirForGame(addr))
+		if err != nil {
+			d.logger.Warn("Unable to check game data size", "game", addr, "err", err)
+			continue
+		}
+		d.lock.Lock()
+		if res, ok := d.reservations[addr]; ok {
+			res.used = used
+		}
+		d.lock.Unlock()
+	}
+}
+
+// estimateGameSize returns the space expected to be required by the data of a game with an unknown trace type.
+func (d *diskManager) estimateGameSize() uint64 {
+	var largest uint64
+	for _, traceType := range d.traceTypes {
+		if estimate := d.estimateForTraceType(traceType); estimate > largest {
+			largest = estimate
+		}
+	}
+	return largest
+}
+
+// estimateForTraceType returns the space expected to be required by the data of a game of the trace type.
+func (d *diskManager) estimateForTraceType(traceType config.TraceType) uint64 {
+	sizes := d.gameSizes[traceType]
+	if len(sizes) == 0 {
+		return defaultReservation(traceType)
+	}
+	var largest uint64
+	for _, size := range sizes {
+		if size > largest {
+			largest = size
+		}
+	}
+	return largest
+}
+
+// recordGameSize records the size of a removed game's data directory to estimate the space required by new games of
+// the same trace type.
+func (d *diskManager) recordGameSize(traceType config.TraceType, size uint64) {
+	sizes := append(d.gameSizes[traceType], size)
+	if len(sizes) > maxGameSizes {
+		sizes = sizes[len(sizes)-maxGameSizes:]
+	}
+	d.gameSizes[traceType] = sizes
+	if err := d.saveGameSizes(); err != nil {
+		d.logger.Warn("Failed to save game data sizes", "err", err)
+	}
+}
+
+func (d *diskManager) loadGameSizes() error {
+	data, err := os.ReadFile(filepath.Join(d.datadir, gameSizesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &d.gameSizes)
+}
+
+func (d *diskManager) saveGameSizes() error {
+	data, err := json.Marshal(d.gameSizes)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(d.datadir, gameSizesFile), data, 0644)
+}
+
+// removeLogsExcept removes the log files, including rotated backups, of all games other than those in keep.
+func (d *diskManager) removeLogsExcept(keep []common.Address) error {
+	logsDir := filepath.Join(d.datadir, gameLogsDir)
+	entries, err := os.ReadDir(logsDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to list log directory: %w", err)
+	}
+	var errs []error
+	for _, entry := range entries {
+		name := entry.Name()
+		// Rotated backups add a timestamp after the address, so only the start of the name is checked.
+		addrEnd := len(gameDirPrefix) + 2*common.AddressLength + 2
+		if entry.IsDir() || !strings.HasPrefix(name, gameDirPrefix) || !strings.HasSuffix(name, ".log") || len(name) < addrEnd {
+			continue
+		}
+		addrHex := name[len(gameDirPrefix):addrEnd]
+		if !common.IsHexAddress(addrHex) || slices.Contains(keep, common.HexToAddress(addrHex)) {
+			continue
+		}
+		errs = append(errs, os.Remove(filepath.Join(logsDir, name)))
+	}
+	return errors.Join(errs...)
+}
+
+// dirSize returns the total size in bytes of the files in dir. Returns 0 if dir does not exist.
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	return size, err
+}
+
+// RemoveAllExcept removes the data and log files of all games other than those in keep and releases the space reserved
+// for them. The sizes of removed directories of games that space was reserved for are recorded by trace type to
+// estimate the space required by new games.
 func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
 	entries, err := os.ReadDir(d.datadir)
 	if err != nil {
 		return fmt.Errorf("failed to list directory: %w", err)
@@ -49,7 +374,21 @@ func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
 			// Preserve data for games we should keep.
 			continue
 		}
-		errs = append(errs, os.RemoveAll(filepath.Join(d.datadir, entry.Name())))
+		dir := filepath.Join(d.datadir, entry.Name())
+		if res, ok := d.reservations[addr]; ok && res.traceType != "" {
+			if size, err := dirSize(dir); err != nil {
+				d.logger.Warn("Unable to check game data size", "game", addr, "err", err)
+			} else {
+				d.recordGameSize(res.traceType, size)
+			}
+		}
+		errs = append(errs, os.RemoveAll(dir))
+	}
+	errs = append(errs, d.removeLogsExcept(keep))
+	for addr := range d.reservations {
+		if !slices.Contains(keep, addr) {
+			delete(d.reservations, addr)
+		}
 	}
 	return errors.Join(errs...)
 }
@@ -5,23 +5,54 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
 
 func TestDiskManager_DirForGame(t *testing.T) {
 	baseDir := t.TempDir()
 	addr := common.Address{0x53}
-	disk := newDiskManager(baseDir)
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
 	result := disk.DirForGame(addr)
 	require.Equal(t, filepath.Join(baseDir, gameDirPrefix+addr.Hex()), result)
 }
 
+func TestDiskManager_LogFileForGame(t *testing.T) {
+	baseDir := t.TempDir()
+	addr := common.Address{0x53}
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
+	result := disk.LogFileForGame(addr)
+	require.Equal(t, filepath.Join(baseDir, gameLogsDir, gameDirPrefix+addr.Hex()+".log"), result)
+}
+
+func TestDiskManager_OutcomesDir(t *testing.T) {
+	baseDir := t.TempDir()
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
+	require.Equal(t, filepath.Join(baseDir, outcomesDir), disk.OutcomesDir())
+}
+
+func TestDiskManager_CostsDir(t *testing.T) {
+	baseDir := t.TempDir()
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
+	require.Equal(t, filepath.Join(baseDir, costsDir), disk.CostsDir())
+}
+
+func TestDiskManager_AuditDir(t *testing.T) {
+	baseDir := t.TempDir()
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
+	require.Equal(t, filepath.Join(baseDir, audit.DirName), disk.AuditDir())
+}
+
 func TestDiskManager_RemoveAllExcept(t *testing.T) {
 	baseDir := t.TempDir()
 	keep := common.Address{0x53}
 	delete := common.Address{0xaa}
-	disk := newDiskManager(baseDir)
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
 	keepDir := disk.DirForGame(keep)
 	deleteDir := disk.DirForGame(delete)
 
@@ -45,6 +76,16 @@ func TestDiskManager_RemoveAllExcept(t *testing.T) {
 
 	keepFiles := populateDir(keepDir)
 	populateDir(deleteDir)
+	logFile := disk.LogFileForGame(delete)
+	logsDir := filepath.Dir(logFile)
+	require.NoError(t, os.MkdirAll(logsDir, 0777))
+	require.NoError(t, os.WriteFile(logFile, []byte("log"), 0644))
+	rotatedLogFile := filepath.Join(logsDir, gameDirPrefix+delete.Hex()+"-2023-09-01T10-00-00.000.log")
+	require.NoError(t, os.WriteFile(rotatedLogFile, []byte("log"), 0644))
+	keepLogFile := disk.LogFileForGame(keep)
+	require.NoError(t, os.WriteFile(keepLogFile, []byte("log"), 0644))
+	unexpectedLogFile := filepath.Join(logsDir, "other.log")
+	require.NoError(t, os.WriteFile(unexpectedLogFile, []byte("log"), 0644))
 
 	require.NoError(t, disk.RemoveAllExcept([]common.Address{keep}))
 	require.NoDirExists(t, deleteDir, "should have deleted directory")
@@ -54,4 +95,144 @@ func TestDiskManager_RemoveAllExcept(t *testing.T) {
 	require.FileExists(t, unexpectedFile, "should not delete unexpected file")
 	require.DirExists(t, unexpectedDir, "should not delete unexpected dir")
 	require.DirExists(t, invalidHexDir, "should not delete dir with invalid address")
+	require.NoFileExists(t, logFile, "should delete logs for deleted game")
+	require.NoFileExists(t, rotatedLogFile, "should delete rotated logs for deleted game")
+	require.FileExists(t, keepLogFile, "should keep logs for active game")
+	require.FileExists(t, unexpectedLogFile, "should not delete unexpected log file")
+}
+
+func TestDiskManager_RemoveAllExceptWithoutLogs(t *testing.T) {
+	baseDir := t.TempDir()
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, baseDir, nil, []config.TraceType{config.TraceTypeAlphabet})
+	require.NoError(t, os.MkdirAll(disk.DirForGame(common.Address{0xaa}), 0777))
+	require.NoError(t, disk.RemoveAllExcept(nil))
+	require.NoDirExists(t, disk.DirForGame(common.Address{0xaa}))
+}
+
+func TestDiskManager_Reserve(t *testing.T) {
+	game1 := common.Address{0xaa}
+	game2 := common.Address{0xbb}
+	setup := func(t *testing.T, dir string, free uint64) (*diskManager, *stubResources) {
+		resources := &stubResources{disk: free}
+		return newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, dir, resources, []config.TraceType{config.TraceTypeCannon}), resources
+	}
+	writeGameData := func(t *testing.T, disk *diskManager, addr common.Address, size int) {
+		dir := disk.DirForGame(addr)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "proofs"), 0777))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "proofs", "data"), make([]byte, size), 0644))
+	}
+
+	t.Run("DefaultForTraceType", func(t *testing.T) {
+		disk, _ := setup(t, t.TempDir(), diskPerGame)
+		require.NoError(t, disk.Reserve(game1))
+		require.ErrorIs(t, disk.Reserve(game2), ErrInsufficientDisk)
+	})
+
+	t.Run("AlphabetRequiresNoSpace", func(t *testing.T) {
+		resources := &stubResources{}
+		disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, t.TempDir(), resources, []config.TraceType{config.TraceTypeAlphabet})
+		require.NoError(t, disk.Reserve(game1))
+		require.NoError(t, disk.Reserve(game2))
+	})
+
+	t.Run("ReserveAgain", func(t *testing.T) {
+		disk, _ := setup(t, t.TempDir(), diskPerGame)
+		require.NoError(t, disk.Reserve(game1))
+		require.NoError(t, disk.Reserve(game1))
+	})
+
+	t.Run("ExcludeSpaceAlreadyUsed", func(t *testing.T) {
+		disk, resources := setup(t, t.TempDir(), diskPerGame)
+		disk.gameSizes[config.TraceTypeCannon] = []uint64{1000}
+		require.NoError(t, disk.Reserve(game1))
+		// Game 1 has written 600 bytes of its 1000 byte reservation, so only 400 bytes remain reserved for it.
+		writeGameData(t, disk, game1, 600)
+		resources.disk = 1400
+		require.ErrorIs(t, disk.Reserve(game2), ErrInsufficientDisk, "should use the last refreshed usage of other games")
+		disk.refreshUsage()
+		require.NoError(t, disk.Reserve(game2))
+	})
+
+	t.Run("ResumedGameRequiresRemainingSpace", func(t *testing.T) {
+		disk, resources := setup(t, t.TempDir(), 0)
+		disk.gameSizes[config.TraceTypeCannon] = []uint64{1000}
+		writeGameData(t, disk, game1, 800)
+		resources.disk = 199
+		require.ErrorIs(t, disk.Reserve(game1), ErrInsufficientDisk)
+		resources.disk = 200
+		require.NoError(t, disk.Reserve(game1))
+	})
+
+	t.Run("ReleaseOnRemove", func(t *testing.T) {
+		disk, _ := setup(t, t.TempDir(), diskPerGame)
+		require.NoError(t, disk.Reserve(game1))
+		require.NoError(t, disk.RemoveAllExcept(nil))
+		require.NoError(t, disk.Reserve(game2))
+	})
+
+	t.Run("EstimateFromRemovedGames", func(t *testing.T) {
+		dir := t.TempDir()
+		disk, resources := setup(t, dir, diskPerGame)
+		require.NoError(t, disk.Reserve(game1))
+		disk.SetTraceType(game1, config.TraceTypeCannon)
+		writeGameData(t, disk, game1, 500)
+		require.NoError(t, disk.RemoveAllExcept(nil))
+		require.Equal(t, uint64(500), disk.estimateGameSize())
+
+		resources.disk = 499
+		require.ErrorIs(t, disk.Reserve(game2), ErrInsufficientDisk)
+		resources.disk = 500
+		require.NoError(t, disk.Reserve(game2))
+
+		// Sizes are retained across restarts
+		reloaded, _ := setup(t, dir, 0)
+		require.Equal(t, uint64(500), reloaded.estimateGameSize())
+	})
+
+	t.Run("LimitRecordedSizes", func(t *testing.T) {
+		disk, _ := setup(t, t.TempDir(), 0)
+		disk.recordGameSize(config.TraceTypeCannon, 5000)
+		for i := 0; i < maxGameSizes; i++ {
+			disk.recordGameSize(config.TraceTypeCannon, 100)
+		}
+		require.Len(t, disk.gameSizes[config.TraceTypeCannon], maxGameSizes)
+		require.Equal(t, uint64(100), disk.estimateGameSize())
+	})
+
+	t.Run("EstimateByTraceType", func(t *testing.T) {
+		resources := &stubResources{disk: 1000}
+		disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, t.TempDir(), resources, []config.TraceType{config.TraceTypeCannon, config.TraceTypeAlphabet})
+		disk.recordGameSize(config.TraceTypeCannon, 1000)
+		disk.recordGameSize(config.TraceTypeAlphabet, 10)
+		require.Equal(t, uint64(1000), disk.estimateGameSize(), "should use the largest estimate until the trace type is known")
+
+		require.NoError(t, disk.Reserve(game1))
+		require.ErrorIs(t, disk.Reserve(game2), ErrInsufficientDisk)
+
+		// Once game 1 is known to be an alphabet game only the space for the alphabet trace type is reserved
+		disk.SetTraceType(game1, config.TraceTypeAlphabet)
+		resources.disk = 1009
+		require.ErrorIs(t, disk.Reserve(game2), ErrInsufficientDisk)
+		resources.disk = 1010
+		require.NoError(t, disk.Reserve(game2))
+	})
+
+	t.Run("IgnoreSizeOfGamesWithUnknownTraceType", func(t *testing.T) {
+		disk, _ := setup(t, t.TempDir(), diskPerGame)
+		require.NoError(t, disk.Reserve(game1))
+		writeGameData(t, disk, game1, 500)
+		require.NoError(t, disk.RemoveAllExcept(nil))
+		require.Empty(t, disk.gameSizes)
+	})
+
+	t.Run("IgnoreUnsupportedFreeDiskCheck", func(t *testing.T) {
+		disk, resources := setup(t, t.TempDir(), 0)
+		resources.err = errResourcesUnsupported
+		require.NoError(t, disk.Reserve(game1))
+	})
+
+	t.Run("NoResources", func(t *testing.T) {
+		disk := newDiskManager(testlog.Logger(t, log.LvlInfo), clock.SystemClock, t.TempDir(), nil, []config.TraceType{config.TraceTypeCannon})
+		require.NoError(t, disk.Reserve(game1))
+	})
 }
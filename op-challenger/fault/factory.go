@@ -8,12 +8,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
 	ErrMissingBlockNumber = errors.New("game loader missing block number")
+	ErrUnknownGame        = errors.New("game was not created by the dispute game factory")
 )
 
+// gameFetchBatchSize is the maximum number of games fetched from the factory concurrently.
+const gameFetchBatchSize = 50
+
 // MinimalDisputeGameFactoryCaller is a minimal interface around [bindings.DisputeGameFactoryCaller].
 // This needs to be updated if the [bindings.DisputeGameFactoryCaller] interface changes.
 type MinimalDisputeGameFactoryCaller interface {
@@ -32,17 +37,22 @@ type FaultDisputeGame struct {
 }
 
 type gameLoader struct {
-	caller MinimalDisputeGameFactoryCaller
+	caller    MinimalDisputeGameFactoryCaller
+	batchSize uint64
 }
 
 // NewGameLoader creates a new services that can be used to fetch on chain dispute games.
 func NewGameLoader(caller MinimalDisputeGameFactoryCaller) *gameLoader {
 	return &gameLoader{
-		caller: caller,
+		caller:    caller,
+		batchSize: gameFetchBatchSize,
 	}
 }
 
-// FetchAllGamesAtBlock fetches all dispute games from the factory at a given block number.
+// FetchAllGamesAtBlock fetches all dispute games created at or after earliestTimestamp from the factory at a given
+// block number, newest first.
+// The first game in the window is found with a binary search so factories with long histories don't require loading
+// every game. Games in the window are then fetched concurrently in batches.
 func (l *gameLoader) FetchAllGamesAtBlock(ctx context.Context, earliestTimestamp uint64, blockNumber *big.Int) ([]FaultDisputeGame, error) {
 	if blockNumber == nil {
 		return nil, ErrMissingBlockNumber
@@ -55,21 +65,115 @@ func (l *gameLoader) FetchAllGamesAtBlock(ctx context.Context, earliestTimestamp
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch game count: %w", err)
 	}
+	count := gameCount.Uint64()
+	start, err := l.findFirstGameIndex(callOpts, count, earliestTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]FaultDisputeGame, count-start)
+	for batchEnd := count; batchEnd > start; {
+		batchStart := start
+		if batchEnd-start > l.batchSize {
+			batchStart = batchEnd - l.batchSize
+		}
+		var group errgroup.Group
+		for i := batchStart; i < batchEnd; i++ {
+			i := i
+			group.Go(func() error {
+				game, err := l.caller.GameAtIndex(callOpts, new(big.Int).SetUint64(i))
+				if err != nil {
+					return fmt.Errorf("failed to fetch game at index %d: %w", i, err)
+				}
+				games[count-1-i] = game
+				return nil
+			})
+		}
+		if err := group.Wait(); err != nil {
+			return nil, err
+		}
+		batchEnd = batchStart
+	}
+	return games, nil
+}
 
-	games := make([]FaultDisputeGame, 0)
-	if gameCount.Uint64() == 0 {
-		return games, nil
+// findFirstGameIndex returns the index of the first game created at or after earliestTimestamp, or count if there
+// are no such games.
+// The factory stores games in the order they were created, so their timestamps are non-decreasing and can be searched
+// with a binary search.
+func (l *gameLoader) findFirstGameIndex(callOpts *bind.CallOpts, count uint64, earliestTimestamp uint64) (uint64, error) {
+	if earliestTimestamp == 0 {
+		return 0, nil
 	}
-	for i := gameCount.Uint64(); i > 0; i-- {
-		game, err := l.caller.GameAtIndex(callOpts, big.NewInt(int64(i-1)))
+	low, high := uint64(0), count
+	for low < high {
+		mid := low + (high-low)/2
+		game, err := l.caller.GameAtIndex(callOpts, new(big.Int).SetUint64(mid))
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch game at index %d: %w", i, err)
+			return 0, fmt.Errorf("failed to fetch game at index %d: %w", mid, err)
 		}
 		if game.Timestamp < earliestTimestamp {
-			break
+			low = mid + 1
+		} else {
+			high = mid
 		}
-		games = append(games, game)
 	}
+	return low, nil
+}
 
-	return games, nil
+// FactoryGamesCaller looks up the game created by the factory with the specified creation data.
+// This needs to be updated if the [bindings.DisputeGameFactoryCaller] interface changes.
+type FactoryGamesCaller interface {
+	Games(opts *bind.CallOpts, _gameType uint8, _rootClaim [32]byte, _extraData []byte) (struct {
+		Proxy     common.Address
+		Timestamp uint64
+	}, error)
+}
+
+// GameDataCaller loads the data a game was created with.
+// This needs to be updated if the [bindings.FaultDisputeGameCaller] interface changes.
+type GameDataCaller interface {
+	GameData(opts *bind.CallOpts) (struct {
+		GameType  uint8
+		RootClaim [32]byte
+		ExtraData []byte
+	}, error)
+}
+
+type gameDataCallerCreator func(game common.Address) (GameDataCaller, error)
+
+// factoryGames checks that games were created by the dispute game factory, so APIs that act on a game specified by
+// a third party can't be used with arbitrary contracts.
+type factoryGames struct {
+	factory    FactoryGamesCaller
+	createGame gameDataCallerCreator
+}
+
+func newFactoryGames(factory FactoryGamesCaller, createGame gameDataCallerCreator) *factoryGames {
+	return &factoryGames{
+		factory:    factory,
+		createGame: createGame,
+	}
+}
+
+// CheckGame returns [ErrUnknownGame] if the game was not created by the factory.
+// The factory is queried for the game created with the game's creation data, which must be the game itself.
+func (f *factoryGames) CheckGame(ctx context.Context, game common.Address) error {
+	caller, err := f.createGame(game)
+	if err != nil {
+		return fmt.Errorf("failed to bind game %v: %w", game, err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	data, err := caller.GameData(opts)
+	if err != nil {
+		return fmt.Errorf("failed to load game data of %v: %w", game, err)
+	}
+	created, err := f.factory.Games(opts, data.GameType, data.RootClaim, data.ExtraData)
+	if err != nil {
+		return fmt.Errorf("failed to load game from factory: %w", err)
+	}
+	if created.Proxy != game {
+		return fmt.Errorf("%w: %v", ErrUnknownGame, game)
+	}
+	return nil
 }
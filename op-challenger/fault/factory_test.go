@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
@@ -87,6 +88,56 @@ func TestGameLoader_FetchAllGames(t *testing.T) {
 	}
 }
 
+func TestGameLoader_FetchGamesInWindow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewestFirst", func(t *testing.T) {
+		caller := newMockMinimalDisputeGameFactoryCaller(10, false, false)
+		loader := NewGameLoader(caller)
+		loader.batchSize = 3
+		games, err := loader.FetchAllGamesAtBlock(context.Background(), 0, big.NewInt(1))
+		require.NoError(t, err)
+		require.Len(t, games, 10)
+		for i, game := range games {
+			require.Equal(t, caller.games[9-i].Proxy, game.Proxy)
+		}
+	})
+
+	t.Run("LargeFactory", func(t *testing.T) {
+		caller := newMockMinimalDisputeGameFactoryCaller(100_000, false, false)
+		loader := NewGameLoader(caller)
+		loader.batchSize = 7
+		games, err := loader.FetchAllGamesAtBlock(context.Background(), 99_990*100, big.NewInt(1))
+		require.NoError(t, err)
+		require.Len(t, games, 10)
+		for i, game := range games {
+			require.Equal(t, caller.games[99_999-i].Proxy, game.Proxy)
+		}
+		// Binary search requires at most 17 lookups to find the window start in 100,000 games
+		require.LessOrEqual(t, caller.indexCalls.Load(), int64(10+17))
+	})
+
+	t.Run("RepeatedTimestamps", func(t *testing.T) {
+		caller := newMockMinimalDisputeGameFactoryCaller(10, false, false)
+		for i := 3; i < 8; i++ {
+			caller.games[i].Timestamp = 500
+		}
+		loader := NewGameLoader(caller)
+		games, err := loader.FetchAllGamesAtBlock(context.Background(), 500, big.NewInt(1))
+		require.NoError(t, err)
+		require.Len(t, games, 7)
+		require.Equal(t, caller.games[3].Proxy, games[6].Proxy)
+	})
+
+	t.Run("AllGamesExpired", func(t *testing.T) {
+		caller := newMockMinimalDisputeGameFactoryCaller(10, false, false)
+		loader := NewGameLoader(caller)
+		games, err := loader.FetchAllGamesAtBlock(context.Background(), 1_000_000, big.NewInt(1))
+		require.NoError(t, err)
+		require.Empty(t, games)
+	})
+}
+
 func generateMockGames(count uint64) []FaultDisputeGame {
 	games := make([]FaultDisputeGame, count)
 
@@ -134,6 +185,7 @@ type mockMinimalDisputeGameFactoryCaller struct {
 	indexErrors  []bool
 	gameCount    uint64
 	games        []FaultDisputeGame
+	indexCalls   atomic.Int64
 }
 
 func newMockMinimalDisputeGameFactoryCaller(count uint64, gameCountErr bool, indexErrors bool) *mockMinimalDisputeGameFactoryCaller {
@@ -161,6 +213,7 @@ func (m *mockMinimalDisputeGameFactoryCaller) GameAtIndex(opts *bind.CallOpts, _
 	Timestamp uint64
 	Proxy     common.Address
 }, error) {
+	m.indexCalls.Add(1)
 	index := _index.Uint64()
 	if m.indexErrors[index] {
 		return struct {
@@ -180,3 +233,82 @@ func (m *mockMinimalDisputeGameFactoryCaller) GameAtIndex(opts *bind.CallOpts, _
 		Proxy:     m.games[index].Proxy,
 	}, nil
 }
+
+func TestFactoryGames_CheckGame(t *testing.T) {
+	game := common.Address{0xaa}
+	gameData := stubGameData{gameType: 1, rootClaim: common.Hash{0x01}, extraData: []byte{0x02}}
+	games := func(factory *stubFactoryGames, data *stubGameData) *factoryGames {
+		return newFactoryGames(factory, func(addr common.Address) (GameDataCaller, error) {
+			require.Equal(t, game, addr)
+			return data, nil
+		})
+	}
+
+	t.Run("CreatedByFactory", func(t *testing.T) {
+		data := gameData
+		factory := &stubFactoryGames{proxy: game}
+		require.NoError(t, games(factory, &data).CheckGame(context.Background(), game))
+		require.Equal(t, data, factory.requested)
+	})
+
+	t.Run("NotCreatedByFactory", func(t *testing.T) {
+		data := gameData
+		factory := &stubFactoryGames{proxy: common.Address{0xbb}}
+		require.ErrorIs(t, games(factory, &data).CheckGame(context.Background(), game), ErrUnknownGame)
+	})
+
+	t.Run("NoGameWithCreationData", func(t *testing.T) {
+		data := gameData
+		factory := &stubFactoryGames{}
+		require.ErrorIs(t, games(factory, &data).CheckGame(context.Background(), game), ErrUnknownGame)
+	})
+
+	t.Run("GameDataUnavailable", func(t *testing.T) {
+		data := gameData
+		data.err = errors.New("boom")
+		factory := &stubFactoryGames{proxy: game}
+		require.ErrorIs(t, games(factory, &data).CheckGame(context.Background(), game), data.err)
+	})
+
+	t.Run("FactoryError", func(t *testing.T) {
+		data := gameData
+		factory := &stubFactoryGames{err: errors.New("boom")}
+		require.ErrorIs(t, games(factory, &data).CheckGame(context.Background(), game), factory.err)
+	})
+}
+
+type stubGameData struct {
+	gameType  uint8
+	rootClaim common.Hash
+	extraData []byte
+	err       error
+}
+
+func (s *stubGameData) GameData(_ *bind.CallOpts) (struct {
+	GameType  uint8
+	RootClaim [32]byte
+	ExtraData []byte
+}, error) {
+	return struct {
+		GameType  uint8
+		RootClaim [32]byte
+		ExtraData []byte
+	}{GameType: s.gameType, RootClaim: s.rootClaim, ExtraData: s.extraData}, s.err
+}
+
+type stubFactoryGames struct {
+	proxy     common.Address
+	err       error
+	requested stubGameData
+}
+
+func (s *stubFactoryGames) Games(_ *bind.CallOpts, gameType uint8, rootClaim [32]byte, extraData []byte) (struct {
+	Proxy     common.Address
+	Timestamp uint64
+}, error) {
+	s.requested = stubGameData{gameType: gameType, rootClaim: rootClaim, extraData: extraData}
+	return struct {
+		Proxy     common.Address
+		Timestamp uint64
+	}{Proxy: s.proxy}, s.err
+}
@@ -0,0 +1,36 @@
+package fault
+
+import (
+	"io"
+
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// newGameLogger creates a logger that writes to both the parent logger and a dedicated, rotating log file for
+// a single game so that the full history of the game can be reviewed without the logs of other games.
+// All records at debug level or above are written to the file, regardless of the level of the parent logger.
+// If maxSizeMB is 0, no log file is written and the parent logger is returned.
+// The returned io.Closer must be closed once the game is no longer being played.
+func newGameLogger(parent log.Logger, file string, maxSizeMB int, maxBackups int) (log.Logger, io.Closer) {
+	if maxSizeMB == 0 {
+		return parent, nopCloser{}
+	}
+	out := &lumberjack.Logger{
+		Filename:   file,
+		MaxSize:    maxSizeMB,
+		MaxBackups: maxBackups,
+	}
+	logger := parent.New()
+	logger.SetHandler(log.MultiHandler(
+		parent.GetHandler(),
+		log.LvlFilterHandler(log.LvlDebug, log.StreamHandler(out, log.LogfmtFormat())),
+	))
+	return logger, out
+}
@@ -0,0 +1,42 @@
+package fault
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameLogger(t *testing.T) {
+	t.Run("WritesToFileAndParent", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "logs", "game.log")
+		parent := testlog.Logger(t, log.LvlInfo)
+		handler := testlog.Capture(parent)
+		logger, closer := newGameLogger(parent, file, 1, 1)
+		logger.New("game", "0x1234").Info("Game info", "claims", 3)
+		logger.Debug("Attempting step", "trace_index", 7)
+		require.NoError(t, closer.Close())
+
+		require.NotNil(t, handler.FindLog(log.LvlInfo, "Game info"), "should log to parent")
+
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.Contains(t, string(content), `msg="Game info" game=0x1234 claims=3`)
+		require.Contains(t, string(content), `msg="Attempting step" trace_index=7`)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		dir := t.TempDir()
+		parent := testlog.Logger(t, log.LvlInfo)
+		logger, closer := newGameLogger(parent, filepath.Join(dir, "game.log"), 0, 1)
+		require.Same(t, parent, logger)
+		logger.Info("Game info")
+		require.NoError(t, closer.Close())
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
@@ -0,0 +1,132 @@
+package fault
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// SoftPause determines whether actions responding to a claim should be deferred.
+type SoftPause interface {
+	// DeferClaim returns true if responding to the claim should be deferred.
+	DeferClaim(claim types.Claim) bool
+}
+
+// combinedPause defers a claim if any of the contained [SoftPause] implementations defer it.
+type combinedPause []SoftPause
+
+func (p combinedPause) DeferClaim(claim types.Claim) bool {
+	for _, pause := range p {
+		if pause.DeferClaim(claim) {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncStatusProvider provides the sync status of a rollup node.
+type SyncStatusProvider interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+type HaltMetricer interface {
+	RecordSoftPaused(paused bool)
+}
+
+// haltDetector detects when L1 has stopped producing blocks or the rollup node's unsafe head has stalled and
+// soft-pauses the challenger. While soft-paused, new trace generation is only started for urgent claims,
+// which have been waiting for a response for longer than the urgent claim age.
+// The challenger resumes automatically once the chain recovers.
+type haltDetector struct {
+	logger      log.Logger
+	clock       clock.Clock
+	metrics     HaltMetricer
+	rollup      SyncStatusProvider
+	l1Threshold time.Duration
+	l2Threshold time.Duration
+	urgentAge   time.Duration
+
+	lastL1Block      uint64
+	lastL1Change     time.Time
+	lastUnsafeHead   uint64
+	lastUnsafeChange time.Time
+
+	paused atomic.Bool
+}
+
+// newHaltDetector creates a new haltDetector. rollup may be nil in which case only L1 halts are detected.
+func newHaltDetector(
+	logger log.Logger,
+	cl clock.Clock,
+	m HaltMetricer,
+	rollup SyncStatusProvider,
+	l1Threshold time.Duration,
+	l2Threshold time.Duration,
+	urgentAge time.Duration,
+) *haltDetector {
+	now := cl.Now()
+	return &haltDetector{
+		logger:           logger,
+		clock:            cl,
+		metrics:          m,
+		rollup:           rollup,
+		l1Threshold:      l1Threshold,
+		l2Threshold:      l2Threshold,
+		urgentAge:        urgentAge,
+		lastL1Change:     now,
+		lastUnsafeChange: now,
+	}
+}
+
+// Check updates the halt state given the latest L1 block number.
+func (d *haltDetector) Check(ctx context.Context, l1Block uint64) {
+	now := d.clock.Now()
+	if l1Block != d.lastL1Block {
+		d.lastL1Block = l1Block
+		d.lastL1Change = now
+	}
+	l1Halted := d.l1Threshold > 0 && now.Sub(d.lastL1Change) >= d.l1Threshold
+
+	l2Halted := false
+	if d.rollup != nil && d.l2Threshold > 0 {
+		status, err := d.rollup.SyncStatus(ctx)
+		if err != nil {
+			// Treat an unreachable rollup node the same as a stalled one so we don't generate traces from bad data.
+			d.logger.Warn("Failed to load rollup node sync status", "err", err)
+		} else if status.UnsafeL2.Number != d.lastUnsafeHead {
+			d.lastUnsafeHead = status.UnsafeL2.Number
+			d.lastUnsafeChange = now
+		}
+		l2Halted = now.Sub(d.lastUnsafeChange) >= d.l2Threshold
+	}
+
+	paused := l1Halted || l2Halted
+	if prev := d.paused.Swap(paused); prev != paused {
+		if paused {
+			d.logger.Warn("Chain halt detected, soft-pausing new trace generation",
+				"l1_halted", l1Halted, "l1_block", d.lastL1Block, "l1_since", d.lastL1Change,
+				"l2_halted", l2Halted, "unsafe_head", d.lastUnsafeHead, "unsafe_since", d.lastUnsafeChange)
+		} else {
+			d.logger.Info("Chain recovered, resuming normal operation", "l1_block", d.lastL1Block, "unsafe_head", d.lastUnsafeHead)
+		}
+	}
+	d.metrics.RecordSoftPaused(paused)
+}
+
+// Paused returns true if the challenger is currently soft-paused.
+func (d *haltDetector) Paused() bool {
+	return d.paused.Load()
+}
+
+// DeferClaim returns true if the challenger is soft-paused and the claim is not yet urgent.
+func (d *haltDetector) DeferClaim(claim types.Claim) bool {
+	if !d.Paused() {
+		return false
+	}
+	return d.clock.Now().Sub(claim.Clock.Timestamp) < d.urgentAge
+}
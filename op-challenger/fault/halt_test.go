@@ -0,0 +1,112 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaltDetector_L1(t *testing.T) {
+	detector, cl, _ := setupHaltDetectorTest(t, false)
+	ctx := context.Background()
+
+	detector.Check(ctx, 1)
+	require.False(t, detector.Paused())
+
+	cl.AdvanceTime(time.Minute)
+	detector.Check(ctx, 1)
+	require.False(t, detector.Paused(), "should not pause before threshold")
+
+	cl.AdvanceTime(5 * time.Minute)
+	detector.Check(ctx, 1)
+	require.True(t, detector.Paused(), "should pause when no new L1 block")
+
+	detector.Check(ctx, 2)
+	require.False(t, detector.Paused(), "should resume on new L1 block")
+}
+
+func TestHaltDetector_L2(t *testing.T) {
+	detector, cl, rollup := setupHaltDetectorTest(t, true)
+	ctx := context.Background()
+
+	rollup.unsafeHead = 10
+	detector.Check(ctx, 1)
+	require.False(t, detector.Paused())
+
+	cl.AdvanceTime(11 * time.Minute)
+	detector.Check(ctx, 2)
+	require.True(t, detector.Paused(), "should pause when unsafe head stalls")
+
+	rollup.unsafeHead = 11
+	detector.Check(ctx, 3)
+	require.False(t, detector.Paused(), "should resume when unsafe head advances")
+
+	cl.AdvanceTime(11 * time.Minute)
+	rollup.err = errors.New("boom")
+	detector.Check(ctx, 4)
+	require.True(t, detector.Paused(), "should stay paused while rollup node unavailable")
+}
+
+func TestHaltDetector_DeferClaim(t *testing.T) {
+	detector, cl, _ := setupHaltDetectorTest(t, false)
+	ctx := context.Background()
+	recent := types.Claim{Clock: types.Clock{Timestamp: cl.Now()}}
+	old := types.Claim{Clock: types.Clock{Timestamp: cl.Now().Add(-13 * time.Hour)}}
+
+	detector.Check(ctx, 1)
+	require.False(t, detector.DeferClaim(recent), "should not defer when not paused")
+	require.False(t, detector.DeferClaim(old), "should not defer when not paused")
+
+	cl.AdvanceTime(10 * time.Minute)
+	detector.Check(ctx, 1)
+	require.True(t, detector.Paused())
+	require.True(t, detector.DeferClaim(recent), "should defer non-urgent claim")
+	require.False(t, detector.DeferClaim(old), "should not defer urgent claim")
+}
+
+func TestCombinedPause(t *testing.T) {
+	claim := types.Claim{}
+	require.False(t, combinedPause{}.DeferClaim(claim))
+	require.False(t, combinedPause{stubPause(false), stubPause(false)}.DeferClaim(claim))
+	require.True(t, combinedPause{stubPause(false), stubPause(true)}.DeferClaim(claim))
+	require.True(t, combinedPause{stubPause(true), stubPause(false)}.DeferClaim(claim))
+}
+
+type stubPause bool
+
+func (s stubPause) DeferClaim(_ types.Claim) bool {
+	return bool(s)
+}
+
+func setupHaltDetectorTest(t *testing.T, withRollup bool) (*haltDetector, *clock.DeterministicClock, *stubSyncStatusProvider) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	cl := clock.NewDeterministicClock(time.Unix(100_000, 0))
+	rollup := &stubSyncStatusProvider{}
+	var provider SyncStatusProvider
+	if withRollup {
+		provider = rollup
+	}
+	detector := newHaltDetector(logger, cl, metrics.NoopMetrics, provider, 5*time.Minute, 10*time.Minute, 12*time.Hour)
+	return detector, cl, rollup
+}
+
+type stubSyncStatusProvider struct {
+	unsafeHead uint64
+	err        error
+}
+
+func (s *stubSyncStatusProvider) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &eth.SyncStatus{UnsafeL2: eth.L2BlockRef{Number: s.unsafeHead}}, nil
+}
@@ -0,0 +1,18 @@
+package fault
+
+type overloadReporter interface {
+	Overloaded() bool
+}
+
+// healthCheck reports the reasons the challenger is degraded to the health endpoint.
+type healthCheck struct {
+	sched overloadReporter
+}
+
+func (h *healthCheck) Degraded() []string {
+	var reasons []string
+	if h.sched.Overloaded() {
+		reasons = append(reasons, "scheduler overloaded: games are being shed")
+	}
+	return reasons
+}
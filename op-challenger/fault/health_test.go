@@ -0,0 +1,24 @@
+package fault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthCheck(t *testing.T) {
+	sched := &stubOverloadReporter{}
+	health := &healthCheck{sched}
+	require.Empty(t, health.Degraded())
+
+	sched.overloaded = true
+	require.Equal(t, []string{"scheduler overloaded: games are being shed"}, health.Degraded())
+}
+
+type stubOverloadReporter struct {
+	overloaded bool
+}
+
+func (s *stubOverloadReporter) Overloaded() bool {
+	return s.overloaded
+}
@@ -3,6 +3,7 @@ package fault
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
@@ -25,6 +26,13 @@ type MinimalFaultDisputeGameCaller interface {
 	ClaimDataLen(opts *bind.CallOpts) (*big.Int, error)
 	MAXGAMEDEPTH(opts *bind.CallOpts) (*big.Int, error)
 	ABSOLUTEPRESTATE(opts *bind.CallOpts) ([32]byte, error)
+	CreatedAt(opts *bind.CallOpts) (uint64, error)
+	GAMEDURATION(opts *bind.CallOpts) (uint64, error)
+	GameType(opts *bind.CallOpts) (uint8, error)
+	Proposals(opts *bind.CallOpts) (struct {
+		Starting bindings.IFaultDisputeGameOutputProposal
+		Disputed bindings.IFaultDisputeGameOutputProposal
+	}, error)
 }
 
 // loader pulls in fault dispute game claim data periodically and over subscriptions.
@@ -77,6 +85,55 @@ func (l *loader) FetchGameDepth(ctx context.Context) (uint64, error) {
 	return gameDepth.Uint64(), nil
 }
 
+// FetchGameDeadline fetches the unix timestamp at which the game's duration expires.
+// After the deadline no further moves can be made and the game can be resolved.
+func (l *loader) FetchGameDeadline(ctx context.Context) (uint64, error) {
+	callOpts := bind.CallOpts{
+		Context: ctx,
+	}
+	createdAt, err := l.caller.CreatedAt(&callOpts)
+	if err != nil {
+		return 0, err
+	}
+	duration, err := l.caller.GAMEDURATION(&callOpts)
+	if err != nil {
+		return 0, err
+	}
+	return createdAt + duration, nil
+}
+
+// FetchGameDuration fetches the total duration of the game. Each team may use at most half of it.
+func (l *loader) FetchGameDuration(ctx context.Context) (time.Duration, error) {
+	duration, err := l.caller.GAMEDURATION(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(duration) * time.Second, nil
+}
+
+// FetchGameType fetches the type of the fault dispute game.
+func (l *loader) FetchGameType(ctx context.Context) (uint8, error) {
+	return l.caller.GameType(&bind.CallOpts{Context: ctx})
+}
+
+// FetchRootClaim fetches the value of the game's root claim.
+func (l *loader) FetchRootClaim(ctx context.Context) (common.Hash, error) {
+	claim, err := l.caller.ClaimData(&bind.CallOpts{Context: ctx}, big.NewInt(0))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return claim.Claim, nil
+}
+
+// FetchDisputedOutput fetches the output root and L2 block number of the output proposal disputed by the game.
+func (l *loader) FetchDisputedOutput(ctx context.Context) (common.Hash, uint64, error) {
+	proposals, err := l.caller.Proposals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+	return proposals.Disputed.OutputRoot, proposals.Disputed.L2BlockNumber.Uint64(), nil
+}
+
 // fetchClaim fetches a single [Claim] with a hydrated parent.
 func (l *loader) fetchClaim(ctx context.Context, arrIndex uint64) (types.Claim, error) {
 	callOpts := bind.CallOpts{
@@ -87,31 +144,19 @@ func (l *loader) fetchClaim(ctx context.Context, arrIndex uint64) (types.Claim,
 	if err != nil {
 		return types.Claim{}, err
 	}
+	claim := types.NewGameClaim(arrIndex, fetchedClaim)
 
-	claim := types.Claim{
-		ClaimData: types.ClaimData{
-			Value:    fetchedClaim.Claim,
-			Position: types.NewPositionFromGIndex(fetchedClaim.Position.Uint64()),
-		},
-		Countered:           fetchedClaim.Countered,
-		Clock:               fetchedClaim.Clock.Uint64(),
-		ContractIndex:       int(arrIndex),
-		ParentContractIndex: int(fetchedClaim.ParentIndex),
-	}
-
-	if !claim.IsRootPosition() {
-		parentIndex := uint64(fetchedClaim.ParentIndex)
+	var parent types.GameClaim
+	if !claim.Position.IsRootPosition() {
+		parentIndex := claim.ParentIndex
 		parentClaim, err := l.caller.ClaimData(&callOpts, new(big.Int).SetUint64(parentIndex))
 		if err != nil {
 			return types.Claim{}, err
 		}
-		claim.Parent = types.ClaimData{
-			Value:    parentClaim.Claim,
-			Position: types.NewPositionFromGIndex(parentClaim.Position.Uint64()),
-		}
+		parent = types.NewGameClaim(parentIndex, parentClaim)
 	}
 
-	return claim, nil
+	return claim.ToClaim(parent), nil
 }
 
 // FetchClaims fetches all claims from the fault dispute game.
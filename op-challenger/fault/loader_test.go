@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -19,6 +22,9 @@ var (
 	mockMaxGameDepthError = fmt.Errorf("max game depth errored")
 	mockPrestateError     = fmt.Errorf("prestate errored")
 	mockStatusError       = fmt.Errorf("status errored")
+	mockCreatedAtError    = fmt.Errorf("created at errored")
+	mockGameTypeError     = fmt.Errorf("game type errored")
+	mockProposalsError    = fmt.Errorf("proposals errored")
 )
 
 // TestLoader_GetGameStatus tests fetching the game status.
@@ -84,6 +90,37 @@ func TestLoader_FetchGameDepth(t *testing.T) {
 	})
 }
 
+// TestLoader_FetchGameDeadline tests fetching the game deadline.
+func TestLoader_FetchGameDeadline(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.createdAt = 1000
+		mockCaller.gameDuration = 500
+		loader := NewLoader(mockCaller)
+		deadline, err := loader.FetchGameDeadline(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(1500), deadline)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.createdAtError = true
+		loader := NewLoader(mockCaller)
+		_, err := loader.FetchGameDeadline(context.Background())
+		require.ErrorIs(t, err, mockCreatedAtError)
+	})
+}
+
+// TestLoader_FetchGameDuration tests fetching the game duration.
+func TestLoader_FetchGameDuration(t *testing.T) {
+	mockCaller := newMockCaller()
+	mockCaller.gameDuration = 500
+	loader := NewLoader(mockCaller)
+	duration, err := loader.FetchGameDuration(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Second, duration)
+}
+
 // TestLoader_FetchAbsolutePrestateHash tests fetching the absolute prestate hash.
 func TestLoader_FetchAbsolutePrestateHash(t *testing.T) {
 	t.Run("Succeeds", func(t *testing.T) {
@@ -108,6 +145,7 @@ func TestLoader_FetchAbsolutePrestateHash(t *testing.T) {
 func TestLoader_FetchClaims(t *testing.T) {
 	t.Run("Succeeds", func(t *testing.T) {
 		mockCaller := newMockCaller()
+		mockCaller.returnClaims[2].Clock = types.NewClock(60, 1000).Packed()
 		expectedClaims := mockCaller.returnClaims
 		loader := NewLoader(mockCaller)
 		claims, err := loader.FetchClaims(context.Background())
@@ -116,40 +154,40 @@ func TestLoader_FetchClaims(t *testing.T) {
 			{
 				ClaimData: types.ClaimData{
 					Value:    expectedClaims[0].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[0].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[0].Position),
 				},
 				Parent: types.ClaimData{
 					Value:    expectedClaims[0].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[0].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[0].Position),
 				},
 				Countered:     false,
-				Clock:         uint64(0),
+				Clock:         types.NewClock(0, 0),
 				ContractIndex: 0,
 			},
 			{
 				ClaimData: types.ClaimData{
 					Value:    expectedClaims[1].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[1].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[1].Position),
 				},
 				Parent: types.ClaimData{
 					Value:    expectedClaims[0].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[1].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[1].Position),
 				},
 				Countered:     false,
-				Clock:         uint64(0),
+				Clock:         types.NewClock(0, 0),
 				ContractIndex: 1,
 			},
 			{
 				ClaimData: types.ClaimData{
 					Value:    expectedClaims[2].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[2].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[2].Position),
 				},
 				Parent: types.ClaimData{
 					Value:    expectedClaims[0].Claim,
-					Position: types.NewPositionFromGIndex(expectedClaims[2].Position.Uint64()),
+					Position: positions.NewPositionFromGIndex(expectedClaims[2].Position),
 				},
 				Countered:     false,
-				Clock:         uint64(0),
+				Clock:         types.NewClock(60, 1000),
 				ContractIndex: 2,
 			},
 		}, claims)
@@ -174,15 +212,83 @@ func TestLoader_FetchClaims(t *testing.T) {
 	})
 }
 
+// TestLoader_FetchGameType tests fetching the game type.
+func TestLoader_FetchGameType(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.gameType = 255
+		loader := NewLoader(mockCaller)
+		gameType, err := loader.FetchGameType(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint8(255), gameType)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.gameTypeError = true
+		loader := NewLoader(mockCaller)
+		_, err := loader.FetchGameType(context.Background())
+		require.ErrorIs(t, err, mockGameTypeError)
+	})
+}
+
+// TestLoader_FetchRootClaim tests fetching the root claim.
+func TestLoader_FetchRootClaim(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.returnClaims[0].Claim = [32]byte{0xaa}
+		loader := NewLoader(mockCaller)
+		root, err := loader.FetchRootClaim(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{0xaa}, root)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.claimDataError = true
+		loader := NewLoader(mockCaller)
+		_, err := loader.FetchRootClaim(context.Background())
+		require.ErrorIs(t, err, mockClaimDataError)
+	})
+}
+
+// TestLoader_FetchDisputedOutput tests fetching the disputed output proposal.
+func TestLoader_FetchDisputedOutput(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.disputed = bindings.IFaultDisputeGameOutputProposal{OutputRoot: [32]byte{0xbb}, L2BlockNumber: big.NewInt(42)}
+		loader := NewLoader(mockCaller)
+		root, blockNum, err := loader.FetchDisputedOutput(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{0xbb}, root)
+		require.Equal(t, uint64(42), blockNum)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.proposalsError = true
+		loader := NewLoader(mockCaller)
+		_, _, err := loader.FetchDisputedOutput(context.Background())
+		require.ErrorIs(t, err, mockProposalsError)
+	})
+}
+
 type mockCaller struct {
 	claimDataError    bool
 	claimLenError     bool
 	maxGameDepthError bool
 	prestateError     bool
 	statusError       bool
+	createdAtError    bool
+	gameTypeError     bool
+	proposalsError    bool
 	maxGameDepth      uint64
+	createdAt         uint64
+	gameDuration      uint64
 	currentIndex      uint64
 	status            uint8
+	gameType          uint8
+	disputed          bindings.IFaultDisputeGameOutputProposal
 	returnClaims      []struct {
 		ParentIndex uint32
 		Countered   bool
@@ -271,3 +377,36 @@ func (m *mockCaller) ABSOLUTEPRESTATE(opts *bind.CallOpts) ([32]byte, error) {
 	}
 	return common.HexToHash("0xdEad"), nil
 }
+
+func (m *mockCaller) CreatedAt(opts *bind.CallOpts) (uint64, error) {
+	if m.createdAtError {
+		return 0, mockCreatedAtError
+	}
+	return m.createdAt, nil
+}
+
+func (m *mockCaller) GAMEDURATION(opts *bind.CallOpts) (uint64, error) {
+	return m.gameDuration, nil
+}
+
+func (m *mockCaller) GameType(opts *bind.CallOpts) (uint8, error) {
+	if m.gameTypeError {
+		return 0, mockGameTypeError
+	}
+	return m.gameType, nil
+}
+
+func (m *mockCaller) Proposals(opts *bind.CallOpts) (struct {
+	Starting bindings.IFaultDisputeGameOutputProposal
+	Disputed bindings.IFaultDisputeGameOutputProposal
+}, error) {
+	var proposals struct {
+		Starting bindings.IFaultDisputeGameOutputProposal
+		Disputed bindings.IFaultDisputeGameOutputProposal
+	}
+	if m.proposalsError {
+		return proposals, mockProposalsError
+	}
+	proposals.Disputed = m.disputed
+	return proposals, nil
+}
@@ -0,0 +1,226 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// pendingMoveTTL is how long a pending move is kept after it is seen in the mempool.
+	// Moves are expected to be included in a block, or dropped, well before this.
+	pendingMoveTTL = 5 * time.Minute
+	// resubscribeDelay is the time to wait before resubscribing after the subscription fails.
+	resubscribeDelay = 5 * time.Second
+)
+
+var errNotAMove = errors.New("not an attack or defend call")
+
+// PendingMove is an attack or defend call to a dispute game that has been broadcast but not yet included in a block.
+type PendingMove struct {
+	TxHash      common.Hash
+	ParentIndex uint64
+	Claim       common.Hash
+	IsAttack    bool
+}
+
+// TxSubscriber subscribes to the transactions entering the mempool of an L1 node.
+type TxSubscriber interface {
+	SubscribePendingTransactions(ctx context.Context, ch chan<- *ethtypes.Transaction) (ethereum.Subscription, error)
+}
+
+type rpcSubscriber struct {
+	client *rpc.Client
+}
+
+// NewRPCSubscriber creates a [TxSubscriber] using the newPendingTransactions subscription of an L1 node.
+// The client must use a transport that supports subscriptions, such as websockets.
+func NewRPCSubscriber(client *rpc.Client) TxSubscriber {
+	return &rpcSubscriber{client: client}
+}
+
+func (s *rpcSubscriber) SubscribePendingTransactions(ctx context.Context, ch chan<- *ethtypes.Transaction) (ethereum.Subscription, error) {
+	return s.client.EthSubscribe(ctx, ch, "newPendingTransactions", true)
+}
+
+type pendingMove struct {
+	PendingMove
+	lastSeen time.Time
+}
+
+// Watcher tracks the attack and defend calls to dispute games that are waiting in the mempool.
+type Watcher struct {
+	logger     log.Logger
+	clock      clock.Clock
+	subscriber TxSubscriber
+	fdgAbi     *abi.ABI
+
+	lock    sync.Mutex
+	pending map[common.Address]map[common.Hash]pendingMove
+}
+
+func NewWatcher(logger log.Logger, cl clock.Clock, subscriber TxSubscriber) (*Watcher, error) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Watcher{
+		logger:     logger.New("component", "mempool"),
+		clock:      cl,
+		subscriber: subscriber,
+		fdgAbi:     fdgAbi,
+		pending:    make(map[common.Address]map[common.Hash]pendingMove),
+	}, nil
+}
+
+// Start watches the mempool in the background until ctx is done.
+// The subscription is re-established if it fails.
+func (w *Watcher) Start(ctx context.Context) {
+	go func() {
+		for {
+			if err := w.watch(ctx); err != nil {
+				w.logger.Warn("Mempool subscription failed", "err", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.clock.After(resubscribeDelay):
+			}
+		}
+	}()
+}
+
+func (w *Watcher) watch(ctx context.Context) error {
+	txs := make(chan *ethtypes.Transaction, 100)
+	sub, err := w.subscriber.SubscribePendingTransactions(ctx, txs)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to pending transactions: %w", err)
+	}
+	defer sub.Unsubscribe()
+	w.logger.Info("Watching mempool for pending moves")
+	ticker := w.clock.NewTicker(pendingMoveTTL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case tx := <-txs:
+			w.AddTransaction(tx)
+		case <-ticker.Ch():
+			w.prune()
+		}
+	}
+}
+
+// AddTransaction records the transaction if it is an attack or defend call to a dispute game.
+func (w *Watcher) AddTransaction(tx *ethtypes.Transaction) {
+	if tx.To() == nil {
+		return
+	}
+	move, err := w.decodeMove(tx)
+	if err != nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	game := *tx.To()
+	moves, ok := w.pending[game]
+	if !ok {
+		moves = make(map[common.Hash]pendingMove)
+		w.pending[game] = moves
+	}
+	if _, ok := moves[move.TxHash]; !ok {
+		w.logger.Debug("Found pending move", "game", game, "tx", move.TxHash, "parent", move.ParentIndex, "is_attack", move.IsAttack)
+	}
+	moves[move.TxHash] = pendingMove{PendingMove: move, lastSeen: w.clock.Now()}
+}
+
+func (w *Watcher) decodeMove(tx *ethtypes.Transaction) (PendingMove, error) {
+	data := tx.Data()
+	if len(data) < 4 {
+		return PendingMove{}, errNotAMove
+	}
+	method, err := w.fdgAbi.MethodById(data[:4])
+	if err != nil || (method.Name != "attack" && method.Name != "defend") {
+		return PendingMove{}, errNotAMove
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return PendingMove{}, fmt.Errorf("invalid %v call: %w", method.Name, err)
+	}
+	parentIndex, ok := args[0].(*big.Int)
+	if !ok || !parentIndex.IsUint64() {
+		return PendingMove{}, fmt.Errorf("invalid %v parent index: %v", method.Name, args[0])
+	}
+	claim, ok := args[1].([32]byte)
+	if !ok {
+		return PendingMove{}, fmt.Errorf("invalid %v claim: %v", method.Name, args[1])
+	}
+	return PendingMove{
+		TxHash:      tx.Hash(),
+		ParentIndex: parentIndex.Uint64(),
+		Claim:       claim,
+		IsAttack:    method.Name == "attack",
+	}, nil
+}
+
+// PendingMoves returns the moves against the game that are waiting in the mempool.
+func (w *Watcher) PendingMoves(game common.Address) []PendingMove {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	cutoff := w.clock.Now().Add(-pendingMoveTTL)
+	var moves []PendingMove
+	for _, move := range w.pending[game] {
+		if move.lastSeen.Before(cutoff) {
+			continue
+		}
+		moves = append(moves, move.PendingMove)
+	}
+	return moves
+}
+
+// prune removes moves that have not been seen within the TTL.
+func (w *Watcher) prune() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	cutoff := w.clock.Now().Add(-pendingMoveTTL)
+	for game, moves := range w.pending {
+		for hash, move := range moves {
+			if move.lastSeen.Before(cutoff) {
+				delete(moves, hash)
+			}
+		}
+		if len(moves) == 0 {
+			delete(w.pending, game)
+		}
+	}
+}
+
+// ForGame returns a view of the pending moves for a single game.
+func (w *Watcher) ForGame(game common.Address) *GameWatcher {
+	return &GameWatcher{watcher: w, game: game}
+}
+
+// GameWatcher provides the pending moves for a single game.
+type GameWatcher struct {
+	watcher *Watcher
+	game    common.Address
+}
+
+func (g *GameWatcher) PendingMoves() []PendingMove {
+	return g.watcher.PendingMoves(g.game)
+}
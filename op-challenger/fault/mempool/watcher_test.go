@@ -0,0 +1,145 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	game  = common.Address{0xaa}
+	other = common.Address{0xbb}
+	claim = common.Hash{0xcc}
+)
+
+func TestAddTransaction(t *testing.T) {
+	t.Run("Attack", func(t *testing.T) {
+		watcher, _ := setupWatcher(t, nil)
+		tx := moveTx(t, game, "attack", 3, 0)
+		watcher.AddTransaction(tx)
+		require.Equal(t, []PendingMove{{TxHash: tx.Hash(), ParentIndex: 3, Claim: claim, IsAttack: true}}, watcher.PendingMoves(game))
+		require.Empty(t, watcher.PendingMoves(other))
+	})
+
+	t.Run("Defend", func(t *testing.T) {
+		watcher, _ := setupWatcher(t, nil)
+		tx := moveTx(t, game, "defend", 5, 0)
+		watcher.AddTransaction(tx)
+		require.Equal(t, []PendingMove{{TxHash: tx.Hash(), ParentIndex: 5, Claim: claim, IsAttack: false}}, watcher.PendingMoves(game))
+	})
+
+	t.Run("IgnoreOtherCalls", func(t *testing.T) {
+		watcher, _ := setupWatcher(t, nil)
+		fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+		require.NoError(t, err)
+		data, err := fdgAbi.Pack("resolve")
+		require.NoError(t, err)
+		watcher.AddTransaction(ethtypes.NewTx(&ethtypes.LegacyTx{To: &game, Data: data}))
+		watcher.AddTransaction(ethtypes.NewTx(&ethtypes.LegacyTx{To: &game, Data: []byte{1, 2}}))
+		watcher.AddTransaction(ethtypes.NewTx(&ethtypes.LegacyTx{Data: moveTx(t, game, "attack", 3, 0).Data()}))
+		require.Empty(t, watcher.PendingMoves(game))
+	})
+
+	t.Run("IgnoreInvalidArguments", func(t *testing.T) {
+		watcher, _ := setupWatcher(t, nil)
+		data := moveTx(t, game, "attack", 3, 0).Data()
+		watcher.AddTransaction(ethtypes.NewTx(&ethtypes.LegacyTx{To: &game, Data: data[:20]}))
+		require.Empty(t, watcher.PendingMoves(game))
+	})
+}
+
+func TestExpirePendingMoves(t *testing.T) {
+	watcher, cl := setupWatcher(t, nil)
+	first := moveTx(t, game, "attack", 1, 0)
+	second := moveTx(t, game, "attack", 2, 1)
+	watcher.AddTransaction(first)
+	cl.AdvanceTime(pendingMoveTTL / 2)
+	watcher.AddTransaction(second)
+	require.Len(t, watcher.PendingMoves(game), 2)
+
+	cl.AdvanceTime(pendingMoveTTL/2 + time.Second)
+	require.Equal(t, []PendingMove{{TxHash: second.Hash(), ParentIndex: 2, Claim: claim, IsAttack: true}}, watcher.PendingMoves(game))
+
+	watcher.prune()
+	require.Len(t, watcher.pending[game], 1)
+
+	cl.AdvanceTime(pendingMoveTTL)
+	watcher.prune()
+	require.Empty(t, watcher.pending)
+}
+
+func TestForGame(t *testing.T) {
+	watcher, _ := setupWatcher(t, nil)
+	tx := moveTx(t, game, "attack", 3, 0)
+	watcher.AddTransaction(tx)
+	require.Equal(t, watcher.PendingMoves(game), watcher.ForGame(game).PendingMoves())
+	require.Empty(t, watcher.ForGame(other).PendingMoves())
+}
+
+func TestWatchSubscription(t *testing.T) {
+	subscriber := &stubSubscriber{subscribed: make(chan chan<- *ethtypes.Transaction, 1), failures: 1}
+	watcher, cl := setupWatcher(t, subscriber)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher.Start(ctx)
+
+	// First subscription attempt fails so should retry after a delay
+	require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second))
+	cl.AdvanceTime(resubscribeDelay)
+
+	var ch chan<- *ethtypes.Transaction
+	select {
+	case ch = <-subscriber.subscribed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("did not resubscribe")
+	}
+	tx := moveTx(t, game, "attack", 3, 0)
+	ch <- tx
+	require.Eventually(t, func() bool {
+		return len(watcher.PendingMoves(game)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func setupWatcher(t *testing.T, subscriber TxSubscriber) (*Watcher, *clock.DeterministicClock) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	watcher, err := NewWatcher(testlog.Logger(t, log.LvlInfo), cl, subscriber)
+	require.NoError(t, err)
+	return watcher, cl
+}
+
+func moveTx(t *testing.T, to common.Address, method string, parentIndex int64, nonce uint64) *ethtypes.Transaction {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+	data, err := fdgAbi.Pack(method, big.NewInt(parentIndex), claim)
+	require.NoError(t, err)
+	return ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, To: &to, Data: data})
+}
+
+type stubSubscriber struct {
+	subscribed chan chan<- *ethtypes.Transaction
+	failures   int
+}
+
+func (s *stubSubscriber) SubscribePendingTransactions(_ context.Context, ch chan<- *ethtypes.Transaction) (ethereum.Subscription, error) {
+	if s.failures > 0 {
+		s.failures--
+		return nil, errors.New("subscriptions not supported")
+	}
+	s.subscribed <- ch
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
@@ -15,6 +16,9 @@ import (
 
 type blockNumberFetcher func(ctx context.Context) (uint64, error)
 
+// blockTimestampFetcher returns the timestamp of the L1 block with the specified number.
+type blockTimestampFetcher func(ctx context.Context, number uint64) (uint64, error)
+
 // gameSource loads information about the games available to play
 type gameSource interface {
 	FetchAllGamesAtBlock(ctx context.Context, earliest uint64, blockNumber *big.Int) ([]FaultDisputeGame, error)
@@ -24,6 +28,43 @@ type gameScheduler interface {
 	Schedule([]common.Address) error
 }
 
+type haltChecker interface {
+	Check(ctx context.Context, l1Block uint64)
+}
+
+type balanceChecker interface {
+	Check(ctx context.Context, l1Block uint64)
+}
+
+type runtimeModeSource interface {
+	Refresh(ctx context.Context, blockNum uint64)
+	Paused() bool
+}
+
+type pauseChecker interface {
+	Paused() bool
+}
+
+type cacheWarmer interface {
+	Warm(ctx context.Context, games []common.Address)
+}
+
+type confirmationTracker interface {
+	Update(head uint64) uint64
+}
+
+type pendingGameRecorder interface {
+	UpdatePendingGames(games []common.Address)
+}
+
+type cycleRecorder interface {
+	RecordMonitorCycle(d time.Duration)
+}
+
+type gameCreationRecorder interface {
+	RecordGames(games []FaultDisputeGame)
+}
+
 type gameMonitor struct {
 	logger           log.Logger
 	clock            clock.Clock
@@ -32,6 +73,52 @@ type gameMonitor struct {
 	gameWindow       time.Duration
 	fetchBlockNumber blockNumberFetcher
 	allowedGames     []common.Address
+	halt             haltChecker
+	balance          balanceChecker
+	runtime          runtimeModeSource
+	admin            pauseChecker
+	cache            cacheWarmer
+	confirmations    confirmationTracker
+	pendingGames     pendingGameRecorder
+	// cycles receives the time taken to progress games on each new block. May be nil
+	cycles cycleRecorder
+	// gameCreations receives the allowed games on each new block to detect attacks. May be nil
+	gameCreations gameCreationRecorder
+
+	// backfillFromBlock is the L1 block to load games created since, even if they are older than the game window.
+	// 0 disables backfilling.
+	backfillFromBlock   uint64
+	fetchBlockTimestamp blockTimestampFetcher
+	// backfillTimestamp is the timestamp of backfillFromBlock, once loaded.
+	backfillTimestamp *uint64
+
+	// warmed is set once the cache has been warmed before the first games are scheduled
+	warmed bool
+}
+
+// monitorDeps are the settings and services used by a [gameMonitor] in addition to the games source and scheduler.
+type monitorDeps struct {
+	// GameWindow is how long after creation games are played. 0 plays every game.
+	GameWindow time.Duration
+	// AllowedGames restricts the games played. If empty, every game is played.
+	AllowedGames  []common.Address
+	Halt          haltChecker
+	Balance       balanceChecker
+	Runtime       runtimeModeSource
+	Admin         pauseChecker
+	Cache         cacheWarmer
+	Confirmations confirmationTracker
+	PendingGames  pendingGameRecorder
+	// Cycles receives the time taken to progress games on each new block. May be nil
+	Cycles cycleRecorder
+	// GameCreations receives the allowed games on each new block to detect attacks. May be nil
+	GameCreations gameCreationRecorder
+	// BackfillFromBlock is the L1 block to load games created since, even if they are older than the game window.
+	// 0 disables backfilling.
+	BackfillFromBlock uint64
+	// FetchBlockTimestamp loads the timestamp of BackfillFromBlock and of the confirmed block.
+	// Only required if backfilling is enabled or a confirmation depth is set.
+	FetchBlockTimestamp blockTimestampFetcher
 }
 
 func newGameMonitor(
@@ -39,18 +126,29 @@ func newGameMonitor(
 	cl clock.Clock,
 	source gameSource,
 	scheduler gameScheduler,
-	gameWindow time.Duration,
 	fetchBlockNumber blockNumberFetcher,
-	allowedGames []common.Address,
+	deps monitorDeps,
 ) *gameMonitor {
 	return &gameMonitor{
 		logger:           logger,
 		clock:            cl,
 		scheduler:        scheduler,
 		source:           source,
-		gameWindow:       gameWindow,
+		gameWindow:       deps.GameWindow,
 		fetchBlockNumber: fetchBlockNumber,
-		allowedGames:     allowedGames,
+		allowedGames:     deps.AllowedGames,
+		halt:             deps.Halt,
+		balance:          deps.Balance,
+		runtime:          deps.Runtime,
+		admin:            deps.Admin,
+		cache:            deps.Cache,
+		confirmations:    deps.Confirmations,
+		pendingGames:     deps.PendingGames,
+		cycles:           deps.Cycles,
+		gameCreations:    deps.GameCreations,
+
+		backfillFromBlock:   deps.BackfillFromBlock,
+		fetchBlockTimestamp: deps.FetchBlockTimestamp,
 	}
 }
 
@@ -78,18 +176,60 @@ func (m *gameMonitor) minGameTimestamp() uint64 {
 	return 0
 }
 
-func (m *gameMonitor) progressGames(ctx context.Context, blockNum uint64) error {
-	games, err := m.source.FetchAllGamesAtBlock(ctx, m.minGameTimestamp(), new(big.Int).SetUint64(blockNum))
+// earliestGameTimestamp returns the creation timestamp of the oldest games to load.
+// Games created since the backfill block are loaded even if they are outside the game window.
+func (m *gameMonitor) earliestGameTimestamp(ctx context.Context) uint64 {
+	earliest := m.minGameTimestamp()
+	if backfill := m.minBackfillTimestamp(ctx); backfill < earliest {
+		return backfill
+	}
+	return earliest
+}
+
+// minBackfillTimestamp returns the timestamp of the backfill block, or math.MaxUint64 if backfilling is disabled.
+// The timestamp is loaded once, and retried on the next update if it can't be loaded.
+func (m *gameMonitor) minBackfillTimestamp(ctx context.Context) uint64 {
+	if m.backfillFromBlock == 0 {
+		return math.MaxUint64
+	}
+	if m.backfillTimestamp != nil {
+		return *m.backfillTimestamp
+	}
+	timestamp, err := m.fetchBlockTimestamp(ctx, m.backfillFromBlock)
+	if err != nil {
+		m.logger.Error("Failed to load backfill block, only loading games in the game window", "block", m.backfillFromBlock, "err", err)
+		return math.MaxUint64
+	}
+	m.logger.Info("Backfilling games created since block", "block", m.backfillFromBlock, "timestamp", timestamp)
+	m.backfillTimestamp = &timestamp
+	return timestamp
+}
+
+// progressGames schedules the games that exist at the confirmed block for the specified L1 head.
+// Games created since the confirmed block are reported as pending but not played until they are confirmed.
+func (m *gameMonitor) progressGames(ctx context.Context, head uint64) error {
+	blockNum := m.confirmations.Update(head)
+	earliest := m.earliestGameTimestamp(ctx)
+	games, err := m.source.FetchAllGamesAtBlock(ctx, earliest, new(big.Int).SetUint64(head))
 	if err != nil {
 		return fmt.Errorf("failed to load games: %w", err)
 	}
-	var gamesToPlay []common.Address
-	for _, game := range games {
-		if !m.allowedGame(game.Proxy) {
-			m.logger.Debug("Skipping game not on allow list", "game", game.Proxy)
-			continue
+	if blockNum < head {
+		var pending []FaultDisputeGame
+		games, pending, err = m.splitConfirmedGames(ctx, blockNum, games)
+		if err != nil {
+			return err
 		}
-		gamesToPlay = append(gamesToPlay, game.Proxy)
+		m.pendingGames.UpdatePendingGames(m.allowedGamesIn(pending))
+	}
+	gamesToPlay := m.allowedGamesIn(games)
+	if m.gameCreations != nil {
+		m.recordGameCreations(games)
+	}
+	if !m.warmed {
+		// Pre-fetch the claims of all games concurrently so the first update of each game doesn't have to load them.
+		m.cache.Warm(ctx, gamesToPlay)
+		m.warmed = true
 	}
 	if err := m.scheduler.Schedule(gamesToPlay); errors.Is(err, scheduler.ErrBusy) {
 		m.logger.Info("Scheduler still busy with previous update")
@@ -99,6 +239,48 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockNum uint64) error
 	return nil
 }
 
+func (m *gameMonitor) allowedGamesIn(games []FaultDisputeGame) []common.Address {
+	var allowed []common.Address
+	for _, game := range games {
+		if !m.allowedGame(game.Proxy) {
+			m.logger.Debug("Skipping game not on allow list", "game", game.Proxy)
+			continue
+		}
+		allowed = append(allowed, game.Proxy)
+	}
+	return allowed
+}
+
+// recordGameCreations reports the allowed games so a burst of new games can be detected.
+func (m *gameMonitor) recordGameCreations(games []FaultDisputeGame) {
+	var allowed []FaultDisputeGame
+	for _, game := range games {
+		if m.allowedGame(game.Proxy) {
+			allowed = append(allowed, game)
+		}
+	}
+	m.gameCreations.RecordGames(allowed)
+}
+
+// splitConfirmedGames separates the games loaded at the L1 head into those that exist at the confirmed block and those
+// created since. Games are created in blocks with strictly increasing timestamps, so a game exists at the confirmed
+// block if it was created no later than the confirmed block's timestamp.
+func (m *gameMonitor) splitConfirmedGames(ctx context.Context, confirmedBlock uint64, games []FaultDisputeGame) ([]FaultDisputeGame, []FaultDisputeGame, error) {
+	confirmedTime, err := m.fetchBlockTimestamp(ctx, confirmedBlock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load confirmed block %v: %w", confirmedBlock, err)
+	}
+	var confirmed, pending []FaultDisputeGame
+	for _, game := range games {
+		if game.Timestamp <= confirmedTime {
+			confirmed = append(confirmed, game)
+		} else {
+			pending = append(pending, game)
+		}
+	}
+	return confirmed, pending, nil
+}
+
 func (m *gameMonitor) MonitorGames(ctx context.Context) error {
 	m.logger.Info("Monitoring fault dispute games")
 
@@ -113,11 +295,22 @@ func (m *gameMonitor) MonitorGames(ctx context.Context) error {
 				m.logger.Error("Failed to load current block number", "err", err)
 				continue
 			}
-			if nextBlockNum > blockNum {
+			m.halt.Check(ctx, nextBlockNum)
+			m.balance.Check(ctx, nextBlockNum)
+			m.runtime.Refresh(ctx, nextBlockNum)
+			if m.runtime.Paused() {
+				m.logger.Debug("Challenger paused by runtime config, not progressing games", "block", nextBlockNum)
+			} else if m.admin.Paused() {
+				m.logger.Debug("Scheduler paused through admin API, not progressing games", "block", nextBlockNum)
+			} else if nextBlockNum > blockNum {
 				blockNum = nextBlockNum
+				start := m.clock.Now()
 				if err := m.progressGames(ctx, nextBlockNum); err != nil {
 					m.logger.Error("Failed to progress games", "err", err)
 				}
+				if m.cycles != nil {
+					m.cycles.RecordMonitorCycle(m.clock.Now().Sub(start))
+				}
 			}
 			if err := m.clock.SleepCtx(ctx, time.Second); err != nil {
 				return err
@@ -2,6 +2,7 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -39,6 +40,61 @@ func TestMonitorMinGameTimestamp(t *testing.T) {
 	})
 }
 
+func TestMonitorBackfill(t *testing.T) {
+	frozen := time.Unix(int64(time.Hour.Seconds()), 0)
+	windowStart := uint64(frozen.Add(-time.Minute).Unix())
+
+	setup := func(t *testing.T, backfillFrom uint64, blockTime uint64) (*gameMonitor, *stubGameSource, *[]uint64) {
+		monitor, source, _ := setupMonitorTest(t, []common.Address{})
+		monitor.gameWindow = time.Minute
+		monitor.clock = clock.NewDeterministicClock(frozen)
+		monitor.backfillFromBlock = backfillFrom
+		var requested []uint64
+		monitor.fetchBlockTimestamp = func(_ context.Context, number uint64) (uint64, error) {
+			requested = append(requested, number)
+			return blockTime, nil
+		}
+		return monitor, source, &requested
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		monitor, source, requested := setup(t, 0, 100)
+		require.NoError(t, monitor.progressGames(context.Background(), 1))
+		require.Equal(t, windowStart, source.earliest)
+		require.Empty(t, *requested)
+	})
+
+	t.Run("BeforeGameWindow", func(t *testing.T) {
+		monitor, source, requested := setup(t, 50, 100)
+		require.NoError(t, monitor.progressGames(context.Background(), 1))
+		require.Equal(t, uint64(100), source.earliest)
+		require.NoError(t, monitor.progressGames(context.Background(), 2))
+		require.Equal(t, uint64(100), source.earliest)
+		require.Equal(t, []uint64{50}, *requested, "should only load the backfill block once")
+	})
+
+	t.Run("WithinGameWindow", func(t *testing.T) {
+		monitor, source, _ := setup(t, 50, windowStart+10)
+		require.NoError(t, monitor.progressGames(context.Background(), 1))
+		require.Equal(t, windowStart, source.earliest)
+	})
+
+	t.Run("RetryOnError", func(t *testing.T) {
+		monitor, source, _ := setup(t, 50, 100)
+		monitor.fetchBlockTimestamp = func(_ context.Context, _ uint64) (uint64, error) {
+			return 0, errors.New("boom")
+		}
+		require.NoError(t, monitor.progressGames(context.Background(), 1))
+		require.Equal(t, windowStart, source.earliest, "should fall back to game window")
+
+		monitor.fetchBlockTimestamp = func(_ context.Context, _ uint64) (uint64, error) {
+			return 100, nil
+		}
+		require.NoError(t, monitor.progressGames(context.Background(), 2))
+		require.Equal(t, uint64(100), source.earliest)
+	})
+}
+
 func TestMonitorExitsWhenContextDone(t *testing.T) {
 	monitor, _, _ := setupMonitorTest(t, []common.Address{{}})
 	ctx, cancel := context.WithCancel(context.Background())
@@ -91,6 +147,106 @@ func TestMonitorOnlyScheduleSpecifiedGame(t *testing.T) {
 	require.Equal(t, []common.Address{addr2}, sched.scheduled[0])
 }
 
+func TestMonitorRecordsGameCreations(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, _ := setupMonitorTest(t, []common.Address{addr2})
+	creations := &stubGameCreations{}
+	monitor.gameCreations = creations
+	source.games = []FaultDisputeGame{{Proxy: addr1, Timestamp: 9999}, {Proxy: addr2, Timestamp: 9999}}
+
+	require.NoError(t, monitor.progressGames(context.Background(), uint64(1)))
+	require.Equal(t, [][]FaultDisputeGame{{{Proxy: addr2, Timestamp: 9999}}}, creations.recorded)
+}
+
+type stubGameCreations struct {
+	recorded [][]FaultDisputeGame
+}
+
+func (s *stubGameCreations) RecordGames(games []FaultDisputeGame) {
+	s.recorded = append(s.recorded, games)
+}
+
+func TestMonitorWarmsCacheBeforeFirstSchedule(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, sched := setupMonitorTest(t, []common.Address{addr2})
+	cache := &stubCacheWarmer{sched: sched}
+	monitor.cache = cache
+	source.games = []FaultDisputeGame{{Proxy: addr1, Timestamp: 9999}, {Proxy: addr2, Timestamp: 9999}}
+
+	require.NoError(t, monitor.progressGames(context.Background(), uint64(1)))
+	require.Equal(t, [][]common.Address{{addr2}}, cache.warmed)
+	require.Equal(t, []int{0}, cache.scheduledBeforeWarm, "should warm cache before scheduling")
+	require.Len(t, sched.scheduled, 1)
+
+	// Cache is only warmed on startup
+	require.NoError(t, monitor.progressGames(context.Background(), uint64(2)))
+	require.Len(t, cache.warmed, 1)
+	require.Len(t, sched.scheduled, 2)
+}
+
+func TestMonitorConfirmationDepth(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	addr3 := common.Address{0xcc}
+	setup := func(t *testing.T, allowedGames []common.Address) (*gameMonitor, *stubGameSource, *stubScheduler, *stubPendingGames, *confirmedHead) {
+		monitor, source, sched := setupMonitorTest(t, allowedGames)
+		confirmations := newConfirmedHead(5)
+		monitor.confirmations = confirmations
+		pending := &stubPendingGames{}
+		monitor.pendingGames = pending
+		source.games = []FaultDisputeGame{{Proxy: addr3, Timestamp: 10_020}, {Proxy: addr2, Timestamp: 10_016}, {Proxy: addr1, Timestamp: 10_015}}
+		monitor.fetchBlockTimestamp = func(_ context.Context, number uint64) (uint64, error) {
+			return 10_000 + number, nil
+		}
+		return monitor, source, sched, pending, confirmations
+	}
+
+	t.Run("ScheduleConfirmedGames", func(t *testing.T) {
+		monitor, source, sched, pending, confirmations := setup(t, nil)
+		require.NoError(t, monitor.progressGames(context.Background(), 20))
+		require.Equal(t, []uint64{20}, source.blocks, "should only load games once")
+		require.Equal(t, [][]common.Address{{addr1}}, sched.scheduled)
+		require.Equal(t, [][]common.Address{{addr3, addr2}}, pending.updates)
+		require.Equal(t, big.NewInt(15), confirmations.BlockNumber())
+	})
+
+	t.Run("PendingGamesMustBeAllowed", func(t *testing.T) {
+		monitor, _, _, pending, _ := setup(t, []common.Address{addr1, addr3})
+		require.NoError(t, monitor.progressGames(context.Background(), 20))
+		require.Equal(t, [][]common.Address{{addr3}}, pending.updates)
+	})
+
+	t.Run("ChainShorterThanDepth", func(t *testing.T) {
+		monitor, source, sched, pending, confirmations := setup(t, nil)
+		require.NoError(t, monitor.progressGames(context.Background(), 3))
+		require.Equal(t, []uint64{3}, source.blocks)
+		require.Equal(t, [][]common.Address{nil}, sched.scheduled)
+		require.Equal(t, [][]common.Address{{addr3, addr2, addr1}}, pending.updates)
+		require.Equal(t, big.NewInt(0), confirmations.BlockNumber())
+	})
+
+	t.Run("ConfirmedBlockUnavailable", func(t *testing.T) {
+		monitor, _, sched, pending, _ := setup(t, nil)
+		monitor.fetchBlockTimestamp = func(_ context.Context, _ uint64) (uint64, error) {
+			return 0, errors.New("boom")
+		}
+		require.ErrorContains(t, monitor.progressGames(context.Background(), 20), "boom")
+		require.Empty(t, sched.scheduled)
+		require.Empty(t, pending.updates)
+	})
+
+	t.Run("NoDepth", func(t *testing.T) {
+		monitor, source, sched, pending, _ := setup(t, nil)
+		monitor.confirmations = newConfirmedHead(0)
+		require.NoError(t, monitor.progressGames(context.Background(), 20))
+		require.Equal(t, []uint64{20}, source.blocks)
+		require.Len(t, sched.scheduled[0], 3)
+		require.Empty(t, pending.updates)
+	})
+}
+
 func setupMonitorTest(t *testing.T, allowedGames []common.Address) (*gameMonitor, *stubGameSource, *stubScheduler) {
 	logger := testlog.Logger(t, log.LvlDebug)
 	source := &stubGameSource{}
@@ -100,18 +256,52 @@ func setupMonitorTest(t *testing.T, allowedGames []common.Address) (*gameMonitor
 		return i, nil
 	}
 	sched := &stubScheduler{}
-	monitor := newGameMonitor(logger, clock.SystemClock, source, sched, time.Duration(0), fetchBlockNum, allowedGames)
+	monitor := newGameMonitor(logger, clock.SystemClock, source, sched, fetchBlockNum, monitorDeps{
+		AllowedGames:  allowedGames,
+		Halt:          &stubHaltChecker{},
+		Balance:       &stubBalanceChecker{},
+		Runtime:       &stubRuntimeMode{},
+		Admin:         &adminPause{},
+		Cache:         &stubCacheWarmer{},
+		Confirmations: newConfirmedHead(0),
+		PendingGames:  &stubPendingGames{},
+	})
 	return monitor, source, sched
 }
 
+type stubCacheWarmer struct {
+	sched               *stubScheduler
+	warmed              [][]common.Address
+	scheduledBeforeWarm []int
+}
+
+func (s *stubCacheWarmer) Warm(_ context.Context, games []common.Address) {
+	s.warmed = append(s.warmed, games)
+	if s.sched != nil {
+		s.scheduledBeforeWarm = append(s.scheduledBeforeWarm, len(s.sched.scheduled))
+	}
+}
+
 type stubGameSource struct {
-	games []FaultDisputeGame
+	games    []FaultDisputeGame
+	earliest uint64
+	blocks   []uint64
 }
 
 func (s *stubGameSource) FetchAllGamesAtBlock(ctx context.Context, earliest uint64, blockNumber *big.Int) ([]FaultDisputeGame, error) {
+	s.earliest = earliest
+	s.blocks = append(s.blocks, blockNumber.Uint64())
 	return s.games, nil
 }
 
+type stubPendingGames struct {
+	updates [][]common.Address
+}
+
+func (s *stubPendingGames) UpdatePendingGames(games []common.Address) {
+	s.updates = append(s.updates, games)
+}
+
 type stubScheduler struct {
 	scheduled [][]common.Address
 }
@@ -120,3 +310,152 @@ func (s *stubScheduler) Schedule(games []common.Address) error {
 	s.scheduled = append(s.scheduled, games)
 	return nil
 }
+
+type stubHaltChecker struct {
+	checked []uint64
+}
+
+func (s *stubHaltChecker) Check(_ context.Context, l1Block uint64) {
+	s.checked = append(s.checked, l1Block)
+}
+
+type stubBalanceChecker struct {
+	checked []uint64
+}
+
+func (s *stubBalanceChecker) Check(_ context.Context, l1Block uint64) {
+	s.checked = append(s.checked, l1Block)
+}
+
+func TestMonitorSkipsGamesWhenRuntimePaused(t *testing.T) {
+	tests := []struct {
+		name          string
+		paused        bool
+		expectedCalls int
+	}{
+		{name: "Paused", paused: true, expectedCalls: 0},
+		{name: "NotPaused", paused: false, expectedCalls: 1},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			monitor, source, sched := setupMonitorTest(t, []common.Address{})
+			source.games = []FaultDisputeGame{{Proxy: common.Address{0xaa}, Timestamp: 9999}}
+			runtime := &stubRuntimeMode{paused: test.paused}
+			monitor.runtime = runtime
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			monitor.fetchBlockNumber = func(ctx context.Context) (uint64, error) {
+				cancel()
+				return 5, nil
+			}
+			err := monitor.MonitorGames(ctx)
+			require.ErrorIs(t, err, context.Canceled)
+			require.Equal(t, []uint64{5}, runtime.refreshed)
+			require.Len(t, sched.scheduled, test.expectedCalls)
+		})
+	}
+}
+
+func TestMonitorPollsWithClock(t *testing.T) {
+	monitor, _, _ := setupMonitorTest(t, []common.Address{})
+	cl := clock.NewDeterministicClock(time.Unix(10_000, 0))
+	monitor.clock = cl
+	fetched := make(chan uint64, 10)
+	blockNum := uint64(0)
+	monitor.fetchBlockNumber = func(ctx context.Context) (uint64, error) {
+		blockNum++
+		fetched <- blockNum
+		return blockNum, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.MonitorGames(ctx)
+	}()
+
+	require.Equal(t, uint64(1), <-fetched)
+	require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second), "should sleep until next poll")
+	require.Empty(t, fetched, "should not poll again before time advances")
+
+	cl.AdvanceTime(time.Second)
+	require.Equal(t, uint64(2), <-fetched)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestMonitorRecordsCycleTime(t *testing.T) {
+	monitor, _, _ := setupMonitorTest(t, []common.Address{})
+	cl := clock.NewDeterministicClock(time.Unix(10_000, 0))
+	monitor.clock = cl
+	monitor.source = &slowGameSource{clock: cl, delay: 5 * time.Second}
+	cycles := &stubCycleRecorder{cycles: make(chan time.Duration, 1)}
+	monitor.cycles = cycles
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.MonitorGames(ctx)
+	}()
+	require.Equal(t, 5*time.Second, <-cycles.cycles)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+type slowGameSource struct {
+	clock *clock.DeterministicClock
+	delay time.Duration
+}
+
+func (s *slowGameSource) FetchAllGamesAtBlock(_ context.Context, _ uint64, _ *big.Int) ([]FaultDisputeGame, error) {
+	s.clock.AdvanceTime(s.delay)
+	return nil, nil
+}
+
+type stubCycleRecorder struct {
+	cycles chan time.Duration
+}
+
+func (s *stubCycleRecorder) RecordMonitorCycle(d time.Duration) {
+	s.cycles <- d
+}
+
+func TestMonitorSkipsGamesWhenAdminPaused(t *testing.T) {
+	monitor, source, sched := setupMonitorTest(t, []common.Address{})
+	source.games = []FaultDisputeGame{{Proxy: common.Address{0xaa}, Timestamp: 9999}}
+	pause := &adminPause{}
+	pause.PauseScheduler()
+	monitor.admin = pause
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	monitor.fetchBlockNumber = func(ctx context.Context) (uint64, error) {
+		cancel()
+		return 5, nil
+	}
+	err := monitor.MonitorGames(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, sched.scheduled)
+
+	pause.ResumeScheduler()
+	require.NoError(t, monitor.progressGames(context.Background(), 6))
+	require.Len(t, sched.scheduled, 1)
+}
+
+type stubRuntimeMode struct {
+	paused    bool
+	refreshed []uint64
+}
+
+func (s *stubRuntimeMode) Refresh(_ context.Context, blockNum uint64) {
+	s.refreshed = append(s.refreshed, blockNum)
+}
+
+func (s *stubRuntimeMode) Paused() bool {
+	return s.paused
+}
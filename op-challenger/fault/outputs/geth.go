@@ -0,0 +1,120 @@
+package outputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var ErrGethOutputRootMismatch = errors.New("rollup node output root does not match the output root computed by op-geth")
+
+// GethClient is the subset of the op-geth RPC API used to compute output roots.
+type GethClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	GetProof(ctx context.Context, address common.Address, blockNum *big.Int) (*eth.AccountResult, error)
+}
+
+// OutputRootProvider provides the output root at an L2 block.
+type OutputRootProvider interface {
+	OutputRoot(ctx context.Context, l2BlockNum uint64) (common.Hash, error)
+}
+
+// GethSource computes output roots from the state of an archive op-geth node, independently of the rollup node.
+// The output root is reconstructed from the block's header and the storage root of the L2ToL1MessagePasser, which is
+// verified against the block's state root with a merkle proof.
+type GethSource struct {
+	client GethClient
+}
+
+// NewGethSource creates a [GethSource] that loads state from client.
+func NewGethSource(client GethClient) *GethSource {
+	return &GethSource{client: client}
+}
+
+// OutputRoot computes the output root at the L2 block number.
+func (g *GethSource) OutputRoot(ctx context.Context, l2BlockNum uint64) (common.Hash, error) {
+	blockNum := new(big.Int).SetUint64(l2BlockNum)
+	header, err := g.client.HeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch header of block %v: %w", l2BlockNum, err)
+	}
+	// The block hash is computed from the header fields rather than trusting the hash reported by the node
+	blockHash := header.Hash()
+	proof, err := g.client.GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, blockNum)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch message passer proof at block %v: %w", l2BlockNum, err)
+	}
+	if err := proof.Verify(header.Root); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid message passer proof at block %v: %w", l2BlockNum, err)
+	}
+	output := &eth.OutputV0{
+		StateRoot:                eth.Bytes32(header.Root),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                blockHash,
+	}
+	return common.Hash(eth.OutputRoot(output)), nil
+}
+
+// VerifiedSource only returns output roots from the rollup nodes once they match the output root computed from
+// op-geth's state, so decisions don't depend on the rollup node software alone being correct.
+type VerifiedSource struct {
+	logger log.Logger
+	rollup OutputRootProvider
+	geth   OutputRootProvider
+}
+
+// NewVerifiedSource creates a [VerifiedSource] that checks output roots from rollup against those computed by geth.
+func NewVerifiedSource(logger log.Logger, rollup OutputRootProvider, geth OutputRootProvider) *VerifiedSource {
+	return &VerifiedSource{
+		logger: logger.New("component", "geth-verifier"),
+		rollup: rollup,
+		geth:   geth,
+	}
+}
+
+// OutputRoot returns the output root at the L2 block number.
+// Returns [ErrGethOutputRootMismatch] if the rollup nodes and op-geth disagree.
+func (v *VerifiedSource) OutputRoot(ctx context.Context, l2BlockNum uint64) (common.Hash, error) {
+	rollupRoot, err := v.rollup.OutputRoot(ctx, l2BlockNum)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	gethRoot, err := v.geth.OutputRoot(ctx, l2BlockNum)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to compute output root from op-geth: %w", err)
+	}
+	if rollupRoot != gethRoot {
+		v.logger.Error("Rollup node output root does not match op-geth state", "block", l2BlockNum,
+			"rollupRoot", rollupRoot, "gethRoot", gethRoot)
+		return common.Hash{}, fmt.Errorf("%w at block %v: %v from rollup nodes but %v from op-geth",
+			ErrGethOutputRootMismatch, l2BlockNum, rollupRoot, gethRoot)
+	}
+	return rollupRoot, nil
+}
+
+// gethRPCClient adapts an [ethclient.Client] to a [GethClient].
+type gethRPCClient struct {
+	*ethclient.Client
+}
+
+// NewGethClient creates a [GethClient] that sends requests through client.
+func NewGethClient(client *ethclient.Client) GethClient {
+	return &gethRPCClient{Client: client}
+}
+
+func (c *gethRPCClient) GetProof(ctx context.Context, address common.Address, blockNum *big.Int) (*eth.AccountResult, error) {
+	var result eth.AccountResult
+	if err := c.Client.Client().CallContext(ctx, &result, "eth_getProof", address, []common.Hash{}, hexutil.EncodeBig(blockNum)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,141 @@
+package outputs
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGethSource(t *testing.T) {
+	header, proof := messagePasserState(t)
+	expected := common.Hash(eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(header.Root),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                header.Hash(),
+	}))
+
+	t.Run("ComputeOutputRoot", func(t *testing.T) {
+		client := &stubGethClient{header: header, proof: proof}
+		root, err := NewGethSource(client).OutputRoot(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, expected, root)
+		require.Equal(t, []uint64{10, 10}, client.blocks)
+	})
+
+	t.Run("InvalidProof", func(t *testing.T) {
+		invalid := *proof
+		invalid.StorageHash = common.Hash{0xbb}
+		_, err := NewGethSource(&stubGethClient{header: header, proof: &invalid}).OutputRoot(context.Background(), 10)
+		require.ErrorContains(t, err, "invalid message passer proof")
+	})
+
+	t.Run("HeaderUnavailable", func(t *testing.T) {
+		_, err := NewGethSource(&stubGethClient{err: errBoom, proof: proof}).OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, errBoom)
+	})
+
+	t.Run("ProofUnavailable", func(t *testing.T) {
+		_, err := NewGethSource(&stubGethClient{header: header, proofErr: errBoom}).OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, errBoom)
+	})
+}
+
+func TestVerifiedSource(t *testing.T) {
+	root := common.Hash{0xaa}
+	logger := testlog.Logger(t, log.LvlCrit)
+
+	t.Run("Match", func(t *testing.T) {
+		actual, err := NewVerifiedSource(logger, &stubOutputRoots{root: root}, &stubOutputRoots{root: root}).OutputRoot(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, root, actual)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		_, err := NewVerifiedSource(logger, &stubOutputRoots{root: root}, &stubOutputRoots{root: common.Hash{0xbb}}).OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, ErrGethOutputRootMismatch)
+	})
+
+	t.Run("RollupError", func(t *testing.T) {
+		geth := &stubOutputRoots{root: root}
+		_, err := NewVerifiedSource(logger, &stubOutputRoots{err: errBoom}, geth).OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, errBoom)
+		require.Zero(t, geth.calls, "should not query op-geth without a rollup node output root")
+	})
+
+	t.Run("GethError", func(t *testing.T) {
+		_, err := NewVerifiedSource(logger, &stubOutputRoots{root: root}, &stubOutputRoots{err: errBoom}).OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, errBoom)
+	})
+}
+
+// messagePasserState creates a state with storage in the L2ToL1MessagePasser and returns a header for the state and
+// the proof of the message passer account.
+func messagePasserState(t *testing.T) (*types.Header, *eth.AccountResult) {
+	addr := predeploys.L2ToL1MessagePasserAddr
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	statedb, err := state.New(types.EmptyRootHash, db, nil)
+	require.NoError(t, err)
+	statedb.SetNonce(addr, 1)
+	statedb.SetState(addr, common.Hash{0x01}, common.Hash{0x02})
+	stateRoot, err := statedb.Commit(false)
+	require.NoError(t, err)
+
+	statedb, err = state.New(stateRoot, db, nil)
+	require.NoError(t, err)
+	accountProof, err := statedb.GetProof(addr)
+	require.NoError(t, err)
+	storageTrie, err := statedb.StorageTrie(addr)
+	require.NoError(t, err)
+	proof := &eth.AccountResult{
+		Address:     addr,
+		Balance:     (*hexutil.Big)(new(big.Int)),
+		CodeHash:    types.EmptyCodeHash,
+		Nonce:       1,
+		StorageHash: storageTrie.Hash(),
+	}
+	for _, node := range accountProof {
+		proof.AccountProof = append(proof.AccountProof, node)
+	}
+	header := &types.Header{Number: big.NewInt(10), Root: stateRoot}
+	return header, proof
+}
+
+type stubGethClient struct {
+	header   *types.Header
+	err      error
+	proof    *eth.AccountResult
+	proofErr error
+	blocks   []uint64
+}
+
+func (s *stubGethClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	s.blocks = append(s.blocks, number.Uint64())
+	return s.header, s.err
+}
+
+func (s *stubGethClient) GetProof(_ context.Context, _ common.Address, blockNum *big.Int) (*eth.AccountResult, error) {
+	s.blocks = append(s.blocks, blockNum.Uint64())
+	return s.proof, s.proofErr
+}
+
+type stubOutputRoots struct {
+	root  common.Hash
+	err   error
+	calls int
+}
+
+func (s *stubOutputRoots) OutputRoot(_ context.Context, _ uint64) (common.Hash, error) {
+	s.calls++
+	return s.root, s.err
+}
@@ -0,0 +1,184 @@
+package outputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrOutputRootMismatch     = errors.New("rollup nodes returned different output roots")
+	ErrInsufficientAgreement  = errors.New("insufficient rollup nodes returned an output root")
+	ErrNoHealthyRollupNodes   = errors.New("no rollup node returned a sync status")
+	errNoRollupNodesAvailable = errors.New("no rollup nodes configured")
+)
+
+// healthCheckInterval is the time between checks of the health of each rollup node.
+const healthCheckInterval = 30 * time.Second
+
+// RollupClient is the subset of the rollup node RPC API used by the [Source].
+type RollupClient interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+type node struct {
+	name    string
+	client  RollupClient
+	healthy bool
+}
+
+// Source provides output roots and sync status from one or more rollup nodes.
+// Requests are sent to healthy nodes first, in the order they were configured, failing over to the next node when a
+// request fails. Output roots are only returned once minAgreement nodes have returned the same output root.
+type Source struct {
+	logger       log.Logger
+	clock        clock.Clock
+	minAgreement int
+
+	lock  sync.Mutex
+	nodes []*node
+}
+
+// NewSource creates a [Source] for the rollup node clients, keyed by a name used when logging.
+// All nodes are assumed to be healthy until a request to them fails.
+func NewSource(logger log.Logger, cl clock.Clock, minAgreement int, names []string, clients []RollupClient) *Source {
+	nodes := make([]*node, len(clients))
+	for i, client := range clients {
+		nodes[i] = &node{name: names[i], client: client, healthy: true}
+	}
+	return &Source{
+		logger:       logger.New("component", "rollup-nodes"),
+		clock:        cl,
+		minAgreement: minAgreement,
+		nodes:        nodes,
+	}
+}
+
+// Start periodically checks the health of each rollup node in the background until ctx is done.
+func (s *Source) Start(ctx context.Context) {
+	go func() {
+		ticker := s.clock.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Ch():
+				s.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+// CheckHealth requests the sync status from every rollup node, recording which nodes are healthy.
+func (s *Source) CheckHealth(ctx context.Context) {
+	for _, n := range s.ordered() {
+		_, err := n.client.SyncStatus(ctx)
+		s.recordResult(n, err)
+	}
+}
+
+// Healthy returns the number of rollup nodes that are currently healthy.
+func (s *Source) Healthy() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	count := 0
+	for _, n := range s.nodes {
+		if n.healthy {
+			count++
+		}
+	}
+	return count
+}
+
+// SyncStatus returns the sync status from the first rollup node to respond.
+func (s *Source) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	var errs []error
+	for _, n := range s.ordered() {
+		status, err := n.client.SyncStatus(ctx)
+		s.recordResult(n, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", n.name, err))
+			continue
+		}
+		return status, nil
+	}
+	return nil, fmt.Errorf("%w: %w", ErrNoHealthyRollupNodes, errors.Join(errs...))
+}
+
+// OutputRoot returns the output root at the L2 block number once minAgreement rollup nodes have returned it.
+// Returns [ErrOutputRootMismatch] if any nodes return different output roots, and [ErrInsufficientAgreement] if
+// fewer than minAgreement nodes return an output root.
+func (s *Source) OutputRoot(ctx context.Context, l2BlockNum uint64) (common.Hash, error) {
+	var root common.Hash
+	var agreed []string
+	var errs []error
+	for _, n := range s.ordered() {
+		output, err := n.client.OutputAtBlock(ctx, l2BlockNum)
+		s.recordResult(n, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", n.name, err))
+			continue
+		}
+		nodeRoot := common.Hash(output.OutputRoot)
+		if len(agreed) == 0 {
+			root = nodeRoot
+		} else if nodeRoot != root {
+			s.logger.Error("Rollup nodes returned different output roots", "block", l2BlockNum,
+				"nodes", agreed, "root", root, "node", n.name, "nodeRoot", nodeRoot)
+			return common.Hash{}, fmt.Errorf("%w at block %v: %v from %v but %v from %v",
+				ErrOutputRootMismatch, l2BlockNum, root, agreed, nodeRoot, n.name)
+		}
+		agreed = append(agreed, n.name)
+		if len(agreed) >= s.minAgreement {
+			return root, nil
+		}
+	}
+	if len(s.nodes) == 0 {
+		return common.Hash{}, errNoRollupNodesAvailable
+	}
+	return common.Hash{}, fmt.Errorf("%w at block %v: %v of %v required: %w",
+		ErrInsufficientAgreement, l2BlockNum, len(agreed), s.minAgreement, errors.Join(errs...))
+}
+
+// ordered returns the nodes with the healthy nodes first, otherwise preserving the configured order.
+// Unhealthy nodes are still included so requests can succeed if they have recovered.
+func (s *Source) ordered() []*node {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	ordered := make([]*node, 0, len(s.nodes))
+	for _, n := range s.nodes {
+		if n.healthy {
+			ordered = append(ordered, n)
+		}
+	}
+	for _, n := range s.nodes {
+		if !n.healthy {
+			ordered = append(ordered, n)
+		}
+	}
+	return ordered
+}
+
+func (s *Source) recordResult(n *node, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	healthy := err == nil
+	if n.healthy == healthy {
+		return
+	}
+	n.healthy = healthy
+	if healthy {
+		s.logger.Info("Rollup node recovered", "node", n.name)
+	} else {
+		s.logger.Warn("Rollup node unhealthy, failing over to other nodes", "node", n.name, "err", err)
+	}
+}
@@ -0,0 +1,158 @@
+package outputs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var errBoom = errors.New("boom")
+
+func TestOutputRoot(t *testing.T) {
+	root := common.Hash{0xaa}
+
+	t.Run("Agreement", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 3)
+		for _, n := range nodes {
+			n.root = root
+		}
+		actual, err := source.OutputRoot(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, root, actual)
+		require.Equal(t, uint64(10), nodes[0].lastBlock)
+		require.Equal(t, 1, nodes[1].outputCalls)
+		require.Zero(t, nodes[2].outputCalls, "should stop once enough nodes agree")
+	})
+
+	t.Run("FailoverToNextNode", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 3)
+		for _, n := range nodes {
+			n.root = root
+		}
+		nodes[0].err = errBoom
+		actual, err := source.OutputRoot(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, root, actual)
+		require.Equal(t, 1, nodes[2].outputCalls)
+		require.Equal(t, 2, source.Healthy())
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 2)
+		nodes[0].root = root
+		nodes[1].root = common.Hash{0xbb}
+		_, err := source.OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, ErrOutputRootMismatch)
+	})
+
+	t.Run("InsufficientAgreement", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 2)
+		nodes[0].root = root
+		nodes[1].err = errBoom
+		_, err := source.OutputRoot(context.Background(), 10)
+		require.ErrorIs(t, err, ErrInsufficientAgreement)
+		require.ErrorIs(t, err, errBoom)
+	})
+
+	t.Run("SingleNode", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 1, 1)
+		nodes[0].root = root
+		actual, err := source.OutputRoot(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, root, actual)
+	})
+}
+
+func TestSyncStatus(t *testing.T) {
+	t.Run("FirstHealthyNode", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 2)
+		nodes[0].err = errBoom
+		nodes[1].syncStatus = &eth.SyncStatus{HeadL1: eth.L1BlockRef{Number: 5}}
+		status, err := source.SyncStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, nodes[1].syncStatus, status)
+
+		// The failed node is now only used after healthy nodes
+		status, err = source.SyncStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, nodes[1].syncStatus, status)
+		require.Equal(t, 1, nodes[0].syncCalls)
+	})
+
+	t.Run("AllNodesFail", func(t *testing.T) {
+		source, nodes := setupSourceTest(t, 2, 2)
+		for _, n := range nodes {
+			n.err = errBoom
+		}
+		_, err := source.SyncStatus(context.Background())
+		require.ErrorIs(t, err, ErrNoHealthyRollupNodes)
+		require.ErrorIs(t, err, errBoom)
+	})
+}
+
+func TestCheckHealth(t *testing.T) {
+	source, nodes := setupSourceTest(t, 2, 3)
+	nodes[1].err = errBoom
+	source.CheckHealth(context.Background())
+	require.Equal(t, 2, source.Healthy())
+
+	nodes[1].err = nil
+	source.CheckHealth(context.Background())
+	require.Equal(t, 3, source.Healthy())
+}
+
+func TestOrderHealthyNodesFirst(t *testing.T) {
+	source, nodes := setupSourceTest(t, 2, 3)
+	nodes[0].err = errBoom
+	source.CheckHealth(context.Background())
+	ordered := source.ordered()
+	require.Equal(t, []string{"node1", "node2", "node0"}, []string{ordered[0].name, ordered[1].name, ordered[2].name})
+}
+
+func setupSourceTest(t *testing.T, minAgreement int, count int) (*Source, []*stubRollupClient) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	names := make([]string, count)
+	stubs := make([]*stubRollupClient, count)
+	clients := make([]RollupClient, count)
+	for i := 0; i < count; i++ {
+		names[i] = "node" + string(rune('0'+i))
+		stubs[i] = &stubRollupClient{syncStatus: &eth.SyncStatus{}}
+		clients[i] = stubs[i]
+	}
+	return NewSource(logger, cl, minAgreement, names, clients), stubs
+}
+
+type stubRollupClient struct {
+	root        common.Hash
+	syncStatus  *eth.SyncStatus
+	err         error
+	outputCalls int
+	syncCalls   int
+	lastBlock   uint64
+}
+
+func (s *stubRollupClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	s.outputCalls++
+	s.lastBlock = blockNum
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &eth.OutputResponse{OutputRoot: eth.Bytes32(s.root)}, nil
+}
+
+func (s *stubRollupClient) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	s.syncCalls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.syncStatus, nil
+}
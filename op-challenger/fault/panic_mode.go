@@ -0,0 +1,214 @@
+package fault
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// panicCheckInterval is how often the recent games and hostile claims are compared to the thresholds.
+const panicCheckInterval = 10 * time.Second
+
+type PanicMetricer interface {
+	ConcurrencyMetricer
+	RecordPanicMode(active bool)
+}
+
+// hostileClaim identifies a claim the challenger disagrees with.
+type hostileClaim struct {
+	game  common.Address
+	index int
+}
+
+// panicMode detects coordinated attacks, where many games are created or many hostile claims are posted within a
+// short window, and switches the challenger into a defensive mode until the attack subsides.
+// While active, concurrency is raised, moves and steps are sent with urgent fees and resolving games and claiming
+// bonds are deferred so all resources go to countering claims. Panic mode lasts for a fixed duration after the
+// thresholds were last exceeded, then reverts automatically.
+type panicMode struct {
+	logger         log.Logger
+	clock          clock.Clock
+	metrics        PanicMetricer
+	alerter        alert.Alerter
+	limiter        ConcurrencyLimiter
+	window         time.Duration
+	duration       time.Duration
+	gameThreshold  uint
+	claimThreshold uint
+	maxConcurrency uint
+
+	lock   sync.Mutex
+	games  map[common.Address]time.Time
+	claims map[hostileClaim]time.Time
+	// until is the time panic mode ends unless the thresholds are exceeded again.
+	until time.Time
+	// prevConcurrency is the concurrency to restore when panic mode ends, or 0 if it wasn't changed.
+	prevConcurrency uint
+
+	active atomic.Bool
+}
+
+// newPanicMode creates a new panicMode that activates when at least gameThreshold games are created or at least
+// claimThreshold hostile claims are posted within window. A zero threshold disables that check.
+// While active, the concurrency is raised to maxConcurrency if it is currently lower.
+func newPanicMode(
+	logger log.Logger,
+	cl clock.Clock,
+	m PanicMetricer,
+	alerter alert.Alerter,
+	limiter ConcurrencyLimiter,
+	window time.Duration,
+	duration time.Duration,
+	gameThreshold uint,
+	claimThreshold uint,
+	maxConcurrency uint,
+) *panicMode {
+	return &panicMode{
+		logger:         logger.New("component", "panic"),
+		clock:          cl,
+		metrics:        m,
+		alerter:        alerter,
+		limiter:        limiter,
+		window:         window,
+		duration:       duration,
+		gameThreshold:  gameThreshold,
+		claimThreshold: claimThreshold,
+		maxConcurrency: maxConcurrency,
+		games:          make(map[common.Address]time.Time),
+		claims:         make(map[hostileClaim]time.Time),
+	}
+}
+
+// RecordGames records the games created within the window.
+func (p *panicMode) RecordGames(games []FaultDisputeGame) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := p.clock.Now()
+	for _, game := range games {
+		created := time.Unix(int64(game.Timestamp), 0)
+		if now.Sub(created) < p.window {
+			p.games[game.Proxy] = created
+		}
+	}
+}
+
+// recordHostileClaim records a claim in game that the challenger disagrees with, if it was posted within the window.
+func (p *panicMode) recordHostileClaim(game common.Address, claim types.Claim) {
+	if p.clock.Now().Sub(claim.Clock.Timestamp) >= p.window {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.claims[hostileClaim{game: game, index: claim.ContractIndex}] = claim.Clock.Timestamp
+}
+
+// Active returns true if panic mode is active.
+func (p *panicMode) Active() bool {
+	return p.active.Load()
+}
+
+// DeferResolution returns true if resolving games and claiming bonds should be deferred.
+func (p *panicMode) DeferResolution() bool {
+	return p.Active()
+}
+
+// ForGame returns an [AttackDetector] that records hostile claims in game.
+func (p *panicMode) ForGame(game common.Address) AttackDetector {
+	return &gamePanicMode{panicMode: p, game: game}
+}
+
+// Start checks for attacks in the background until ctx is done.
+func (p *panicMode) Start(ctx context.Context) {
+	go func() {
+		ticker := p.clock.NewTicker(panicCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Ch():
+				p.check()
+			}
+		}
+	}()
+}
+
+func (p *panicMode) check() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := p.clock.Now()
+	for game, created := range p.games {
+		if now.Sub(created) >= p.window {
+			delete(p.games, game)
+		}
+	}
+	for claim, posted := range p.claims {
+		if now.Sub(posted) >= p.window {
+			delete(p.claims, claim)
+		}
+	}
+	games, claims := uint(len(p.games)), uint(len(p.claims))
+	attacked := (p.gameThreshold > 0 && games >= p.gameThreshold) || (p.claimThreshold > 0 && claims >= p.claimThreshold)
+	if attacked {
+		p.until = now.Add(p.duration)
+		if !p.active.Load() {
+			p.activate(games, claims)
+		}
+	} else if p.active.Load() && !now.Before(p.until) {
+		p.deactivate(games, claims)
+	}
+	p.metrics.RecordPanicMode(p.active.Load())
+}
+
+func (p *panicMode) activate(games uint, claims uint) {
+	p.active.Store(true)
+	p.logger.Error("Coordinated attack detected, entering panic mode",
+		"games", games, "hostile_claims", claims, "window", p.window, "until", p.until)
+	if current := p.limiter.MaxConcurrency(); p.maxConcurrency > current {
+		p.prevConcurrency = current
+		p.setConcurrency(p.maxConcurrency)
+	}
+	p.alerter.Alert(alert.Alert{
+		Kind:    alert.KindPanicMode,
+		Key:     "attack",
+		Summary: "Coordinated attack detected, challenger entered panic mode",
+		Details: map[string]string{
+			"games":         strconv.FormatUint(uint64(games), 10),
+			"hostileClaims": strconv.FormatUint(uint64(claims), 10),
+			"window":        p.window.String(),
+		},
+	})
+}
+
+func (p *panicMode) deactivate(games uint, claims uint) {
+	p.active.Store(false)
+	p.logger.Info("Attack subsided, leaving panic mode", "games", games, "hostile_claims", claims)
+	if p.prevConcurrency != 0 {
+		p.setConcurrency(p.prevConcurrency)
+		p.prevConcurrency = 0
+	}
+}
+
+func (p *panicMode) setConcurrency(concurrency uint) {
+	p.logger.Info("Adjusting concurrency", "from", p.limiter.MaxConcurrency(), "to", concurrency, "reason", "panic mode")
+	p.limiter.SetMaxConcurrency(concurrency)
+	p.metrics.RecordMaxConcurrency(concurrency)
+}
+
+// gamePanicMode is the [AttackDetector] for a single game.
+type gamePanicMode struct {
+	*panicMode
+	game common.Address
+}
+
+func (g *gamePanicMode) RecordHostileClaim(claim types.Claim) {
+	g.recordHostileClaim(g.game, claim)
+}
@@ -0,0 +1,147 @@
+package fault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPanicMode(t *testing.T) {
+	window := 10 * time.Minute
+	duration := 30 * time.Minute
+	setup := func(t *testing.T, gameThreshold uint, claimThreshold uint) (*panicMode, *clock.DeterministicClock, *stubLimiter, *stubPanicMetrics, *stubAlerter) {
+		cl := clock.NewDeterministicClock(time.Unix(100_000, 0))
+		limiter := &stubLimiter{maxConcurrency: 4}
+		m := &stubPanicMetrics{}
+		alerter := &stubAlerter{}
+		mode := newPanicMode(testlog.Logger(t, log.LvlInfo), cl, m, alerter, limiter, window, duration, gameThreshold, claimThreshold, 16)
+		return mode, cl, limiter, m, alerter
+	}
+	gamesCreatedAt := func(created time.Time, count int) []FaultDisputeGame {
+		games := make([]FaultDisputeGame, 0, count)
+		for i := 0; i < count; i++ {
+			games = append(games, FaultDisputeGame{Proxy: common.Address{byte(i + 1)}, Timestamp: uint64(created.Unix())})
+		}
+		return games
+	}
+	hostileClaim := func(index int, posted time.Time) types.Claim {
+		return types.Claim{ContractIndex: index, Clock: types.Clock{Timestamp: posted}}
+	}
+
+	t.Run("InactiveBelowThresholds", func(t *testing.T) {
+		mode, cl, limiter, m, alerter := setup(t, 3, 3)
+		mode.RecordGames(gamesCreatedAt(cl.Now(), 2))
+		detector := mode.ForGame(common.Address{0xaa})
+		detector.RecordHostileClaim(hostileClaim(1, cl.Now()))
+		detector.RecordHostileClaim(hostileClaim(2, cl.Now()))
+		mode.check()
+		require.False(t, mode.Active())
+		require.False(t, mode.DeferResolution())
+		require.Equal(t, uint(4), limiter.maxConcurrency)
+		require.False(t, m.active)
+		require.Empty(t, alerter.alerts)
+	})
+
+	t.Run("ActivatesOnGames", func(t *testing.T) {
+		mode, cl, limiter, m, alerter := setup(t, 3, 0)
+		mode.RecordGames(gamesCreatedAt(cl.Now(), 3))
+		mode.check()
+		require.True(t, mode.Active())
+		require.True(t, mode.DeferResolution())
+		require.Equal(t, uint(16), limiter.maxConcurrency)
+		require.Equal(t, uint(16), m.maxConcurrency)
+		require.True(t, m.active)
+		require.Len(t, alerter.alerts, 1)
+		require.Equal(t, alert.KindPanicMode, alerter.alerts[0].Kind)
+	})
+
+	t.Run("ActivatesOnHostileClaims", func(t *testing.T) {
+		mode, cl, _, _, _ := setup(t, 0, 3)
+		mode.ForGame(common.Address{0xaa}).RecordHostileClaim(hostileClaim(1, cl.Now()))
+		mode.ForGame(common.Address{0xbb}).RecordHostileClaim(hostileClaim(1, cl.Now()))
+		mode.check()
+		require.False(t, mode.Active())
+
+		// The same claim is only counted once
+		mode.ForGame(common.Address{0xbb}).RecordHostileClaim(hostileClaim(1, cl.Now()))
+		mode.check()
+		require.False(t, mode.Active())
+
+		mode.ForGame(common.Address{0xbb}).RecordHostileClaim(hostileClaim(2, cl.Now()))
+		mode.check()
+		require.True(t, mode.Active())
+	})
+
+	t.Run("IgnoreOldGamesAndClaims", func(t *testing.T) {
+		mode, cl, _, _, _ := setup(t, 1, 1)
+		old := cl.Now().Add(-window)
+		mode.RecordGames(gamesCreatedAt(old, 5))
+		mode.ForGame(common.Address{0xaa}).RecordHostileClaim(hostileClaim(1, old))
+		mode.check()
+		require.False(t, mode.Active())
+	})
+
+	t.Run("RevertsAfterDuration", func(t *testing.T) {
+		mode, cl, limiter, m, _ := setup(t, 3, 0)
+		mode.RecordGames(gamesCreatedAt(cl.Now(), 3))
+		mode.check()
+		require.True(t, mode.Active())
+
+		// Games leave the window but panic mode lasts for the full duration
+		cl.AdvanceTime(window)
+		mode.check()
+		require.True(t, mode.Active())
+
+		cl.AdvanceTime(duration - window)
+		mode.check()
+		require.False(t, mode.Active())
+		require.Equal(t, uint(4), limiter.maxConcurrency)
+		require.Equal(t, uint(4), m.maxConcurrency)
+		require.False(t, m.active)
+	})
+
+	t.Run("ExtendedWhileAttackContinues", func(t *testing.T) {
+		mode, cl, _, _, alerter := setup(t, 0, 1)
+		mode.ForGame(common.Address{0xaa}).RecordHostileClaim(hostileClaim(1, cl.Now()))
+		mode.check()
+		require.True(t, mode.Active())
+
+		cl.AdvanceTime(duration - time.Minute)
+		mode.ForGame(common.Address{0xaa}).RecordHostileClaim(hostileClaim(2, cl.Now()))
+		mode.check()
+		cl.AdvanceTime(time.Minute)
+		mode.check()
+		require.True(t, mode.Active())
+		require.Len(t, alerter.alerts, 1, "should only alert when entering panic mode")
+	})
+
+	t.Run("DoesNotLowerConcurrency", func(t *testing.T) {
+		mode, cl, limiter, _, _ := setup(t, 1, 0)
+		limiter.maxConcurrency = 32
+		mode.RecordGames(gamesCreatedAt(cl.Now(), 1))
+		mode.check()
+		require.True(t, mode.Active())
+		require.Equal(t, uint(32), limiter.maxConcurrency)
+
+		cl.AdvanceTime(duration + window)
+		mode.check()
+		require.False(t, mode.Active())
+		require.Equal(t, uint(32), limiter.maxConcurrency)
+	})
+}
+
+type stubPanicMetrics struct {
+	stubConcurrencyMetrics
+	active bool
+}
+
+func (s *stubPanicMetrics) RecordPanicMode(active bool) {
+	s.active = active
+}
@@ -2,20 +2,38 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/corpus"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/mempool"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/reporter"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// maxClaimCreditAttempts is the number of times claiming bond credit is attempted before giving up, so a claim that keeps
+// failing doesn't keep the completed game's data from being removed.
+const maxClaimCreditAttempts = 10
+
 type Actor interface {
 	Act(ctx context.Context) error
 }
@@ -25,79 +43,381 @@ type GameInfo interface {
 	GetClaimCount(context.Context) (uint64, error)
 }
 
+// ClaimCache provides previously loaded claims for games.
+type ClaimCache interface {
+	ClaimLoader(game common.Address, loader ClaimLoader) ClaimLoader
+}
+
+type BondTracker interface {
+	BondedValue() *big.Int
+}
+
+// CreditClaimer claims the bonds paid out to the challenger by a resolved game.
+type CreditClaimer interface {
+	ClaimCredit(ctx context.Context) error
+}
+
+// ActivityTracker reports the transactions sent to a game.
+type ActivityTracker interface {
+	Activity() responder.Activity
+}
+
+// OutcomeReporter reports the outcome of completed games.
+type OutcomeReporter interface {
+	ReportOutcome(outcome reporter.Outcome)
+}
+
+// multiOutcomeReporter reports outcomes to each of its reporters.
+type multiOutcomeReporter []OutcomeReporter
+
+func (m multiOutcomeReporter) ReportOutcome(outcome reporter.Outcome) {
+	for _, r := range m {
+		r.ReportOutcome(outcome)
+	}
+}
+
+// ClaimCounter loads the number of claims in a game.
+type ClaimCounter interface {
+	GetClaimCount(ctx context.Context) (uint64, error)
+}
+
+// OutputRootSource provides output roots that have been cross-checked between rollup nodes.
+type OutputRootSource interface {
+	OutputRoot(ctx context.Context, l2BlockNum uint64) (common.Hash, error)
+}
+
+// DisputedOutputLoader fetches the output proposal disputed by a game.
+type DisputedOutputLoader interface {
+	FetchDisputedOutput(ctx context.Context) (common.Hash, uint64, error)
+}
+
 type GamePlayer struct {
 	agent                   Actor
 	agreeWithProposedOutput bool
 	loader                  GameInfo
+	headClaims              ClaimCounter
 	logger                  log.Logger
+	metrics                 metrics.Metricer
+	bonds                   BondTracker
+	logFile                 io.Closer
+	addr                    common.Address
+	traceType               config.TraceType
+	deadline                uint64
+	createdAt               uint64
+	status                  StatusRecorder
+	pending                 PendingMoves
 
-	completed bool
+	clock     clock.Clock
+	rootClaim common.Hash
+	activity  ActivityTracker
+	outcomes  OutcomeReporter
+	alerter   alert.Alerter
+	credit    CreditClaimer
+	attacks   AttackDetector
+	// releaseProvider releases the game's trace provider when the player is closed.
+	releaseProvider func()
+
+	completed     bool
+	claimed       bool
+	claimAttempts int
+	// inProgress is set once the game has been seen in progress, so a loss is only alerted when the game resolves
+	// while the challenger is running rather than again for every lost game after each restart.
+	inProgress bool
+}
+
+// AttackMonitor detects coordinated attacks across all games the challenger plays.
+type AttackMonitor interface {
+	// Active returns true while an attack is in progress.
+	Active() bool
+	// ForGame returns an [AttackDetector] that records hostile claims in game.
+	ForGame(game common.Address) AttackDetector
+}
+
+// PlayerDeps are the services shared by every game player and defense watch.
+type PlayerDeps struct {
+	TxMgr  txmgr.TxManager
+	Client bind.ContractCaller
+	// HeadClient reads game contracts at the latest block when Client only reads confirmed state. If nil, claims
+	// pending confirmation are not reported.
+	HeadClient bind.ContractCaller
+	Pause      SoftPause
+	Status     StatusRecorder
+	Cache      ClaimCache
+	Outcomes   OutcomeReporter
+	// Outputs reports the output roots of the rollup nodes. If nil, players use the configured agreement with the
+	// proposed output. Required by defense watches.
+	Outputs OutputRootSource
+	// PendingMoves provides pending moves to precompute responses to. If nil, no responses are precomputed.
+	PendingMoves *mempool.Watcher
+	// StuckTxs monitors transactions that fail to confirm. If nil, stuck transactions are not reported.
+	StuckTxs *responder.StuckTxMonitor
+	Tokens   responder.TokenTracker
+	AuditLog audit.Recorder
+	// Providers shares trace providers with claim verifications. If nil, the player creates its own provider.
+	Providers *providerRegistry
+	// Attacks detects coordinated attacks. If nil, attacks are not detected.
+	Attacks AttackMonitor
+	// Alerter raises alerts for the game. If nil, alerts are discarded.
+	Alerter alert.Alerter
 }
 
 func NewGamePlayer(
 	ctx context.Context,
 	logger log.Logger,
+	m metrics.Metricer,
+	cl clock.Clock,
 	cfg *config.Config,
 	dir string,
+	logFile string,
 	addr common.Address,
-	txMgr txmgr.TxManager,
-	client bind.ContractCaller,
-) (*GamePlayer, error) {
+	deps PlayerDeps,
+) (player *GamePlayer, err error) {
+	if deps.Alerter == nil {
+		deps.Alerter = alert.NoopAlerter
+	}
+	logger, logCloser := newGameLogger(logger, logFile, cfg.GameLogMaxSize, cfg.GameLogMaxBackups)
+	defer func() {
+		if err != nil {
+			_ = logCloser.Close()
+		}
+	}()
 	logger = logger.New("game", addr)
-	contract, err := bindings.NewFaultDisputeGameCaller(addr, client)
+	contract, err := bindings.NewFaultDisputeGameCaller(addr, deps.Client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
 	}
 
 	loader := NewLoader(contract)
 
-	gameDepth, err := loader.FetchGameDepth(ctx)
+	var headClaims ClaimCounter
+	if deps.HeadClient != nil {
+		headContract, err := bindings.NewFaultDisputeGameCaller(addr, deps.HeadClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
+		}
+		headClaims = NewLoader(headContract)
+	}
+
+	params, err := fetchGameParams(ctx, loader)
+	if err != nil {
+		return nil, err
+	}
+	gameDepth := params.depth
+	chessClock := types.NewChessClock(params.duration)
+
+	agreeWithProposedOutput := cfg.AgreeWithProposedOutput
+	if deps.Outputs != nil {
+		agreeWithProposedOutput, err = agreeWithDisputedOutput(ctx, loader, deps.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the disputed output root: %w", err)
+		}
+		logger.Info("Checked disputed output root against rollup nodes", "agree", agreeWithProposedOutput)
+	}
+
+	gameType, err := loader.FetchGameType(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the game type: %w", err)
+	}
+	traceType, err := cfg.TraceTypeForGame(gameType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch the game depth: %w", err)
+		return nil, err
 	}
+	logger = logger.New("traceType", traceType)
 
+	createProvider := func() (types.TraceProvider, error) {
+		return NewTraceProvider(ctx, logger, m, cl, cfg, traceType, deps.Client, dir, addr, gameDepth)
+	}
 	var provider types.TraceProvider
-	var updater types.OracleUpdater
-	switch cfg.TraceType {
-	case config.TraceTypeCannon:
-		cannonProvider, err := cannon.NewTraceProvider(ctx, logger, cfg, client, dir, addr)
+	releaseProvider := func() {}
+	if deps.Providers != nil {
+		provider, releaseProvider, err = deps.Providers.Acquire(addr, createProvider)
+	} else {
+		provider, err = createProvider()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
 		if err != nil {
-			return nil, fmt.Errorf("create cannon trace provider: %w", err)
+			releaseProvider()
 		}
-		provider = cannonProvider
-		updater, err = cannon.NewOracleUpdater(ctx, logger, txMgr, addr, client)
+	}()
+	var updater types.OracleUpdater
+	switch traceType {
+	case config.TraceTypeCannon:
+		updater, err = cannon.NewOracleUpdater(ctx, logger, deps.TxMgr, addr, deps.Client)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create the cannon updater: %w", err)
 		}
 	case config.TraceTypeAlphabet:
-		provider = alphabet.NewTraceProvider(cfg.AlphabetTrace, gameDepth)
 		updater = alphabet.NewOracleUpdater(logger)
-	default:
-		return nil, fmt.Errorf("unsupported trace type: %v", cfg.TraceType)
 	}
 
 	if err := ValidateAbsolutePrestate(ctx, provider, loader); err != nil {
+		if errors.Is(err, ErrPrestateMismatch) {
+			// Alert once per trace type as every game using it is affected
+			deps.Alerter.Alert(alert.Alert{
+				Kind:    alert.KindPrestateMismatch,
+				Key:     string(traceType),
+				Summary: fmt.Sprintf("Configured %v absolute prestate does not match the game's", traceType),
+				Details: map[string]string{"game": addr.Hex(), "traceType": string(traceType)},
+			})
+		}
 		return nil, fmt.Errorf("failed to validate absolute prestate: %w", err)
 	}
 
-	responder, err := responder.NewFaultResponder(logger, txMgr, addr)
+	var attackState responder.AttackState
+	var attackDetector AttackDetector
+	if deps.Attacks != nil {
+		attackState = deps.Attacks
+		attackDetector = deps.Attacks.ForGame(addr)
+	}
+	urgency := responder.NewUrgencyPolicy(cl, params.deadline, cfg.UrgentMoveWindow, cfg.EconomicalResolutionWindow, attackState)
+	responder, err := responder.NewFaultResponder(logger, deps.TxMgr, addr, m, responder.ResponderOptions{
+		MaxBond:  cfg.MaxBond,
+		Urgency:  urgency,
+		Stuck:    deps.StuckTxs,
+		Tokens:   deps.Tokens,
+		AuditLog: deps.AuditLog,
+		Activity: responder.NewFileActivityStore(dir),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the responder: %w", err)
 	}
 
+	var steps StepRecorder
+	if cfg.StepCorpusDir != "" {
+		recorder, err := corpus.NewRecorder(logger, cfg.StepCorpusDir)
+		if err != nil {
+			return nil, err
+		}
+		steps = recorder.ForGame(addr)
+	}
+
+	var lookahead LookaheadSource
+	if deps.PendingMoves != nil {
+		lookahead = deps.PendingMoves.ForGame(addr)
+	}
+
+	disagreements := diagnostics.NewRecorder(logger, cl, addr, dir, provider, int(gameDepth))
+
 	return &GamePlayer{
-		agent:                   NewAgent(loader, int(gameDepth), provider, responder, updater, cfg.AgreeWithProposedOutput, logger),
-		agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
+		agent:                   NewAgent(deps.Cache.ClaimLoader(addr, loader), int(gameDepth), provider, responder, updater, agreeWithProposedOutput, cl, m, logger, AgentOptions{Pause: deps.Pause, ChessClock: &chessClock, Steps: steps, Lookahead: lookahead, Disagreements: disagreements, Attacks: attackDetector, TimeSlice: cfg.ActTimeSlice, Progress: newFileActProgress(dir)}),
+		agreeWithProposedOutput: agreeWithProposedOutput,
 		loader:                  loader,
+		headClaims:              headClaims,
 		logger:                  logger,
+		metrics:                 m,
+		bonds:                   responder,
+		logFile:                 logCloser,
+		addr:                    addr,
+		traceType:               traceType,
+		deadline:                params.deadline,
+		createdAt:               params.createdAt(),
+		status:                  deps.Status,
+		pending:                 responder,
+
+		clock:     cl,
+		rootClaim: params.rootClaim,
+		activity:  responder,
+		outcomes:  deps.Outcomes,
+		alerter:   deps.Alerter,
+		credit:    responder,
+		attacks:   attackDetector,
+
+		releaseProvider: releaseProvider,
 	}, nil
 }
 
+// TraceType returns the trace type used to play the game.
+func (g *GamePlayer) TraceType() config.TraceType {
+	return g.traceType
+}
+
+// gameParams are the parameters of a game that don't change once it is created.
+type gameParams struct {
+	depth     uint64
+	deadline  uint64
+	duration  time.Duration
+	rootClaim common.Hash
+}
+
+// createdAt returns the unix timestamp the game was created at.
+func (p gameParams) createdAt() uint64 {
+	return p.deadline - uint64(p.duration/time.Second)
+}
+
+func fetchGameParams(ctx context.Context, loader *loader) (gameParams, error) {
+	depth, err := loader.FetchGameDepth(ctx)
+	if err != nil {
+		return gameParams{}, fmt.Errorf("failed to fetch the game depth: %w", err)
+	}
+	deadline, err := loader.FetchGameDeadline(ctx)
+	if err != nil {
+		return gameParams{}, fmt.Errorf("failed to fetch the game deadline: %w", err)
+	}
+	duration, err := loader.FetchGameDuration(ctx)
+	if err != nil {
+		return gameParams{}, fmt.Errorf("failed to fetch the game duration: %w", err)
+	}
+	rootClaim, err := loader.FetchRootClaim(ctx)
+	if err != nil {
+		return gameParams{}, fmt.Errorf("failed to fetch the root claim: %w", err)
+	}
+	return gameParams{
+		depth:     depth,
+		deadline:  deadline,
+		duration:  duration,
+		rootClaim: rootClaim,
+	}, nil
+}
+
+// agreeWithDisputedOutput returns true if the output root disputed by the game matches the output root reported by
+// the rollup nodes for the same L2 block.
+func agreeWithDisputedOutput(ctx context.Context, loader DisputedOutputLoader, outputs OutputRootSource) (bool, error) {
+	disputedRoot, l2BlockNum, err := loader.FetchDisputedOutput(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch the disputed output: %w", err)
+	}
+	expectedRoot, err := outputs.OutputRoot(ctx, l2BlockNum)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch the output root at block %v: %w", l2BlockNum, err)
+	}
+	return disputedRoot == expectedRoot, nil
+}
+
+// NewTraceProvider creates a trace provider of the specified trace type, storing any generated data in dir.
+func NewTraceProvider(
+	ctx context.Context,
+	logger log.Logger,
+	m cannon.SubprocessMetricer,
+	cl clock.Clock,
+	cfg *config.Config,
+	traceType config.TraceType,
+	client bind.ContractCaller,
+	dir string,
+	addr common.Address,
+	gameDepth uint64,
+) (types.TraceProvider, error) {
+	switch traceType {
+	case config.TraceTypeCannon:
+		provider, err := cannon.NewTraceProvider(ctx, logger, m, cl, cfg, client, dir, addr)
+		if err != nil {
+			return nil, fmt.Errorf("create cannon trace provider: %w", err)
+		}
+		return provider, nil
+	case config.TraceTypeAlphabet:
+		return alphabet.NewTraceProvider(cfg.Alphabet.Trace, gameDepth), nil
+	default:
+		return nil, fmt.Errorf("unsupported trace type: %v", traceType)
+	}
+}
+
 func (g *GamePlayer) ProgressGame(ctx context.Context) bool {
 	if g.completed {
 		// Game is already complete so don't try to perform further actions.
 		g.logger.Trace("Skipping completed game")
-		return true
+		return g.claimCredit(ctx)
 	}
 	g.logger.Trace("Checking if actions are required")
 	if err := g.agent.Act(ctx); err != nil {
@@ -108,11 +428,58 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) bool {
 	} else {
 		g.logGameStatus(ctx, status)
 		g.completed = status != types.GameStatusInProgress
-		return g.completed
+		if g.completed && g.bonds != nil {
+			// Bonds are no longer at risk once the game is complete
+			g.metrics.RecordBondsReleased(g.bonds.BondedValue())
+		}
+		return g.completed && g.claimCredit(ctx)
 	}
 	return false
 }
 
+// claimCredit claims any bonds paid out to the challenger by the completed game.
+// Returns true once there is nothing left to claim. Failed or deferred claims are retried the next time the game is
+// progressed, up to maxClaimCreditAttempts failures after which the credit is left to be claimed manually.
+func (g *GamePlayer) claimCredit(ctx context.Context) bool {
+	if g.claimed || g.credit == nil {
+		return true
+	}
+	if g.attacks != nil && g.attacks.DeferResolution() {
+		g.logger.Debug("Under attack, deferring bond credit claim")
+		return false
+	}
+	if err := g.credit.ClaimCredit(ctx); err != nil {
+		g.claimAttempts++
+		if g.claimAttempts >= maxClaimCreditAttempts {
+			g.logger.Error("Giving up claiming bond credit", "attempts", g.claimAttempts, "err", err)
+			g.claimed = true
+			return true
+		}
+		g.logger.Warn("Failed to claim bond credit", "attempts", g.claimAttempts, "err", err)
+		return false
+	}
+	g.claimed = true
+	return true
+}
+
+// Close stops the game's agent, releases its trace provider and log file and stops reporting its status.
+// The player must not be used after it is closed.
+func (g *GamePlayer) Close() error {
+	if closer, ok := g.agent.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	if g.releaseProvider != nil {
+		g.releaseProvider()
+	}
+	if g.status != nil {
+		g.status.RemoveGame(g.addr)
+	}
+	if g.logFile == nil {
+		return nil
+	}
+	return g.logFile.Close()
+}
+
 func (g *GamePlayer) logGameStatus(ctx context.Context, status types.GameStatus) {
 	if status == types.GameStatusInProgress {
 		claimCount, err := g.loader.GetClaimCount(ctx)
@@ -121,6 +488,8 @@ func (g *GamePlayer) logGameStatus(ctx context.Context, status types.GameStatus)
 			return
 		}
 		g.logger.Info("Game info", "claims", claimCount, "status", status)
+		g.inProgress = true
+		g.recordInProgress(ctx, status, claimCount)
 		return
 	}
 	var expectedStatus types.GameStatus
@@ -133,5 +502,78 @@ func (g *GamePlayer) logGameStatus(ctx context.Context, status types.GameStatus)
 		g.logger.Info("Game won", "status", status)
 	} else {
 		g.logger.Error("Game lost", "status", status)
+		if g.inProgress {
+			g.alertLost(status)
+		}
+	}
+	if g.status != nil {
+		g.status.GameResolved(g.addr, status, expectedStatus == status)
+	}
+	g.reportOutcome(status, expectedStatus == status)
+}
+
+// alertLost raises an alert that the game resolved against the challenger.
+func (g *GamePlayer) alertLost(status types.GameStatus) {
+	g.alerter.Alert(alert.Alert{
+		Kind:    alert.KindGameLost,
+		Key:     g.addr.Hex(),
+		Summary: fmt.Sprintf("Game %v lost", g.addr),
+		Details: map[string]string{"game": g.addr.Hex(), "status": status.String(), "rootClaim": g.rootClaim.Hex()},
+	})
+}
+
+func (g *GamePlayer) reportOutcome(status types.GameStatus, won bool) {
+	if g.outcomes == nil {
+		return
+	}
+	winner := "defender"
+	if status == types.GameStatusChallengerWon {
+		winner = "challenger"
+	}
+	outcome := reporter.Outcome{
+		Game:       g.addr,
+		RootClaim:  g.rootClaim,
+		Status:     status,
+		Winner:     winner,
+		Won:        won,
+		GasCost:    (*hexutil.Big)(big.NewInt(0)),
+		Bonded:     (*hexutil.Big)(big.NewInt(0)),
+		ResolvedAt: uint64(g.clock.Now().Unix()),
+		CreatedAt:  g.createdAt,
+	}
+	if g.activity != nil {
+		activity := g.activity.Activity()
+		outcome.Moves = activity.Moves
+		outcome.Steps = activity.Steps
+		outcome.GasUsed = activity.GasUsed
+		outcome.GasCost = (*hexutil.Big)(activity.GasCost)
+	}
+	if g.bonds != nil {
+		outcome.Bonded = (*hexutil.Big)(g.bonds.BondedValue())
+	}
+	g.outcomes.ReportOutcome(outcome)
+}
+
+func (g *GamePlayer) recordInProgress(ctx context.Context, status types.GameStatus, claimCount uint64) {
+	if g.status == nil {
+		return
+	}
+	info := rpc.GameInfo{
+		Address:    g.addr,
+		Status:     status,
+		ClaimCount: claimCount,
+		Deadline:   g.deadline,
+	}
+	if g.headClaims != nil {
+		headCount, err := g.headClaims.GetClaimCount(ctx)
+		if err != nil {
+			g.logger.Warn("Failed to get unconfirmed claim count", "err", err)
+		} else if headCount > claimCount {
+			info.PendingClaims = headCount - claimCount
+		}
+	}
+	if g.bonds != nil {
+		info.BondedValue = (*hexutil.Big)(g.bonds.BondedValue())
 	}
+	g.status.UpdateGame(info, g.pending)
 }
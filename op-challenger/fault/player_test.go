@@ -3,10 +3,20 @@ package fault
 import (
 	"context"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/reporter"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
@@ -105,6 +115,58 @@ func TestDoNotActOnCompleteGame(t *testing.T) {
 	}
 }
 
+func TestAgreeWithDisputedOutput(t *testing.T) {
+	disputed := common.Hash{0xaa}
+	loader := &stubDisputedOutputLoader{root: disputed, l2BlockNum: 42}
+
+	t.Run("Agree", func(t *testing.T) {
+		outputs := &stubOutputRootSource{root: disputed}
+		agree, err := agreeWithDisputedOutput(context.Background(), loader, outputs)
+		require.NoError(t, err)
+		require.True(t, agree)
+		require.Equal(t, uint64(42), outputs.l2BlockNum)
+	})
+
+	t.Run("Disagree", func(t *testing.T) {
+		agree, err := agreeWithDisputedOutput(context.Background(), loader, &stubOutputRootSource{root: common.Hash{0xbb}})
+		require.NoError(t, err)
+		require.False(t, agree)
+	})
+
+	t.Run("OutputRootUnavailable", func(t *testing.T) {
+		err := errors.New("boom")
+		_, actual := agreeWithDisputedOutput(context.Background(), loader, &stubOutputRootSource{err: err})
+		require.ErrorIs(t, actual, err)
+	})
+
+	t.Run("DisputedOutputUnavailable", func(t *testing.T) {
+		err := errors.New("boom")
+		_, actual := agreeWithDisputedOutput(context.Background(), &stubDisputedOutputLoader{err: err}, &stubOutputRootSource{})
+		require.ErrorIs(t, actual, err)
+	})
+}
+
+type stubDisputedOutputLoader struct {
+	root       common.Hash
+	l2BlockNum uint64
+	err        error
+}
+
+func (s *stubDisputedOutputLoader) FetchDisputedOutput(_ context.Context) (common.Hash, uint64, error) {
+	return s.root, s.l2BlockNum, s.err
+}
+
+type stubOutputRootSource struct {
+	root       common.Hash
+	err        error
+	l2BlockNum uint64
+}
+
+func (s *stubOutputRootSource) OutputRoot(_ context.Context, l2BlockNum uint64) (common.Hash, error) {
+	s.l2BlockNum = l2BlockNum
+	return s.root, s.err
+}
+
 func setupProgressGameTest(t *testing.T, agreeWithProposedRoot bool) (*testlog.CapturingHandler, *GamePlayer, *stubGameState) {
 	logger := testlog.Logger(t, log.LvlDebug)
 	handler := &testlog.CapturingHandler{
@@ -117,6 +179,8 @@ func setupProgressGameTest(t *testing.T, agreeWithProposedRoot bool) (*testlog.C
 		agreeWithProposedOutput: agreeWithProposedRoot,
 		loader:                  gameState,
 		logger:                  logger,
+		metrics:                 metrics.NoopMetrics,
+		alerter:                 alert.NoopAlerter,
 	}
 	return handler, game, gameState
 }
@@ -141,3 +205,286 @@ func (s *stubGameState) GetGameStatus(ctx context.Context) (types.GameStatus, er
 func (s *stubGameState) GetClaimCount(ctx context.Context) (uint64, error) {
 	return s.claimCount, nil
 }
+
+func TestCloseGamePlayer(t *testing.T) {
+	_, game, _ := setupProgressGameTest(t, true)
+	require.NoError(t, game.Close(), "should close without a log file")
+
+	closer := &stubCloser{}
+	game.logFile = closer
+	require.NoError(t, game.Close())
+	require.True(t, closer.closed)
+}
+
+func TestProgressGame_RecordsStatus(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	recorder := &stubStatusRecorder{}
+	bonds := &stubBonds{value: big.NewInt(50)}
+	pending := &stubPendingMoves{}
+	game.addr = common.Address{0xaa}
+	game.deadline = 1234
+	game.status = recorder
+	game.bonds = bonds
+	game.pending = pending
+
+	gameState.claimCount = 3
+	game.ProgressGame(context.Background())
+	require.Equal(t, rpc.GameInfo{
+		Address:     game.addr,
+		Status:      types.GameStatusInProgress,
+		ClaimCount:  3,
+		Deadline:    1234,
+		BondedValue: (*hexutil.Big)(big.NewInt(50)),
+	}, recorder.info)
+	require.Same(t, pending, recorder.pending)
+	require.Nil(t, recorder.resolved)
+
+	gameState.status = types.GameStatusChallengerWon
+	game.ProgressGame(context.Background())
+	require.Equal(t, &rpc.GameResolution{Address: game.addr, Status: types.GameStatusChallengerWon, Won: true}, recorder.resolved)
+
+	require.NoError(t, game.Close())
+	require.True(t, recorder.removed)
+}
+
+func TestProgressGame_RecordsPendingClaims(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	recorder := &stubStatusRecorder{}
+	game.status = recorder
+	headClaims := &stubGameState{claimCount: 5}
+	game.headClaims = headClaims
+
+	gameState.claimCount = 3
+	game.ProgressGame(context.Background())
+	require.Equal(t, uint64(3), recorder.info.ClaimCount)
+	require.Equal(t, uint64(2), recorder.info.PendingClaims)
+
+	// Claims at the head may briefly lag the confirmed claims if L1 endpoints are out of sync
+	headClaims.claimCount = 2
+	game.ProgressGame(context.Background())
+	require.Zero(t, recorder.info.PendingClaims)
+}
+
+func TestProgressGame_ReportsOutcome(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, false)
+	outcomes := &stubOutcomeReporter{}
+	game.addr = common.Address{0xaa}
+	game.rootClaim = common.Hash{0xbb}
+	game.createdAt = 1000
+	game.clock = clock.NewDeterministicClock(time.Unix(5000, 0))
+	game.bonds = &stubBonds{value: big.NewInt(50)}
+	game.activity = &stubActivity{activity: responder.Activity{Moves: 3, Steps: 1, GasUsed: 400, GasCost: big.NewInt(800)}}
+	game.outcomes = outcomes
+
+	game.ProgressGame(context.Background())
+	require.Empty(t, outcomes.reported, "should not report in progress games")
+
+	gameState.status = types.GameStatusDefenderWon
+	game.ProgressGame(context.Background())
+	require.Equal(t, []reporter.Outcome{{
+		Game:       game.addr,
+		RootClaim:  common.Hash{0xbb},
+		Status:     types.GameStatusDefenderWon,
+		Winner:     "defender",
+		Won:        true,
+		Moves:      3,
+		Steps:      1,
+		GasUsed:    400,
+		GasCost:    (*hexutil.Big)(big.NewInt(800)),
+		Bonded:     (*hexutil.Big)(big.NewInt(50)),
+		ResolvedAt: 5000,
+		CreatedAt:  1000,
+	}}, outcomes.reported)
+
+	game.ProgressGame(context.Background())
+	require.Len(t, outcomes.reported, 1, "should only report the outcome once")
+}
+
+func TestProgressGame_ReportsOutcomeWithoutActivity(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	outcomes := &stubOutcomeReporter{}
+	game.clock = clock.NewDeterministicClock(time.Unix(5000, 0))
+	game.outcomes = outcomes
+	gameState.status = types.GameStatusDefenderWon
+
+	game.ProgressGame(context.Background())
+	require.Len(t, outcomes.reported, 1)
+	require.Equal(t, "defender", outcomes.reported[0].Winner)
+	require.False(t, outcomes.reported[0].Won)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(0)), outcomes.reported[0].GasCost)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(0)), outcomes.reported[0].Bonded)
+}
+
+func TestProgressGame_AlertsLostGame(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, false)
+	alerter := &stubAlerter{}
+	game.alerter = alerter
+	gameState.status = types.GameStatusDefenderWon
+	game.ProgressGame(context.Background())
+	require.Empty(t, alerter.alerts, "should not alert when game won")
+
+	_, game, gameState = setupProgressGameTest(t, false)
+	game.addr = common.Address{0xaa}
+	game.rootClaim = common.Hash{0xbb}
+	game.alerter = alerter
+	gameState.status = types.GameStatusInProgress
+	game.ProgressGame(context.Background())
+	require.Empty(t, alerter.alerts, "should not alert while game in progress")
+	gameState.status = types.GameStatusChallengerWon
+	game.ProgressGame(context.Background())
+	require.Len(t, alerter.alerts, 1)
+	require.Equal(t, alert.KindGameLost, alerter.alerts[0].Kind)
+	require.Equal(t, game.addr.Hex(), alerter.alerts[0].Key)
+	require.Equal(t, common.Hash{0xbb}.Hex(), alerter.alerts[0].Details["rootClaim"])
+}
+
+func TestProgressGame_DoNotAlertGameAlreadyLost(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, false)
+	alerter := &stubAlerter{}
+	game.alerter = alerter
+	gameState.status = types.GameStatusChallengerWon
+	game.ProgressGame(context.Background())
+	require.Empty(t, alerter.alerts, "should not alert for a game that was already lost when first seen")
+}
+
+func TestProgressGame_ClaimsCredit(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	credit := &stubCreditClaimer{err: errors.New("boom")}
+	game.credit = credit
+
+	require.False(t, game.ProgressGame(context.Background()))
+	require.Zero(t, credit.claims, "should not claim before game is complete")
+
+	gameState.status = types.GameStatusChallengerWon
+	require.False(t, game.ProgressGame(context.Background()), "should not be done until credit is claimed")
+	require.Equal(t, 1, credit.claims)
+	require.Equal(t, 2, gameState.callCount)
+
+	credit.err = nil
+	require.True(t, game.ProgressGame(context.Background()))
+	require.Equal(t, 2, credit.claims)
+	require.Equal(t, 2, gameState.callCount, "should not act on complete game while claiming credit")
+
+	require.True(t, game.ProgressGame(context.Background()))
+	require.Equal(t, 2, credit.claims, "should only claim credit once")
+}
+
+func TestProgressGame_GivesUpClaimingCredit(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	credit := &stubCreditClaimer{err: errors.New("boom")}
+	game.credit = credit
+	gameState.status = types.GameStatusChallengerWon
+
+	for i := 1; i < maxClaimCreditAttempts; i++ {
+		require.False(t, game.ProgressGame(context.Background()))
+	}
+	require.True(t, game.ProgressGame(context.Background()), "should complete after the last failed attempt")
+	require.Equal(t, maxClaimCreditAttempts, credit.claims)
+
+	require.True(t, game.ProgressGame(context.Background()))
+	require.Equal(t, maxClaimCreditAttempts, credit.claims, "should not claim again after giving up")
+}
+
+func TestProgressGame_DefersClaimingCreditUnderAttack(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	credit := &stubCreditClaimer{}
+	game.credit = credit
+	attacks := &stubAttackDetector{deferResolution: true}
+	game.attacks = attacks
+	gameState.status = types.GameStatusChallengerWon
+
+	require.False(t, game.ProgressGame(context.Background()))
+	require.Zero(t, credit.claims, "should not claim credit while under attack")
+
+	attacks.deferResolution = false
+	require.True(t, game.ProgressGame(context.Background()))
+	require.Equal(t, 1, credit.claims)
+}
+
+func TestMultiOutcomeReporter(t *testing.T) {
+	first := &stubOutcomeReporter{}
+	second := &stubOutcomeReporter{}
+	outcome := reporter.Outcome{Game: common.Address{0xaa}}
+	multiOutcomeReporter{first, second}.ReportOutcome(outcome)
+	require.Equal(t, []reporter.Outcome{outcome}, first.reported)
+	require.Equal(t, []reporter.Outcome{outcome}, second.reported)
+}
+
+type stubOutcomeReporter struct {
+	reported []reporter.Outcome
+}
+
+func (s *stubOutcomeReporter) ReportOutcome(outcome reporter.Outcome) {
+	s.reported = append(s.reported, outcome)
+}
+
+type stubActivity struct {
+	activity responder.Activity
+}
+
+func (s *stubActivity) Activity() responder.Activity {
+	return s.activity
+}
+
+type stubStatusRecorder struct {
+	info     rpc.GameInfo
+	pending  PendingMoves
+	resolved *rpc.GameResolution
+	removed  bool
+}
+
+func (s *stubStatusRecorder) UpdateGame(info rpc.GameInfo, pending PendingMoves) {
+	s.info = info
+	s.pending = pending
+}
+
+func (s *stubStatusRecorder) GameResolved(addr common.Address, status types.GameStatus, won bool) {
+	s.resolved = &rpc.GameResolution{Address: addr, Status: status, Won: won}
+}
+
+func (s *stubStatusRecorder) RemoveGame(_ common.Address) {
+	s.removed = true
+}
+
+type stubBonds struct {
+	value *big.Int
+}
+
+func (s *stubBonds) BondedValue() *big.Int {
+	return s.value
+}
+
+type stubPendingMoves struct {
+	count uint64
+}
+
+func (s *stubPendingMoves) PendingMoves() uint64 {
+	return s.count
+}
+
+type stubCloser struct {
+	closed bool
+}
+
+func (s *stubCloser) Close() error {
+	s.closed = true
+	return nil
+}
+
+type stubAlerter struct {
+	alerts []alert.Alert
+}
+
+func (s *stubAlerter) Alert(a alert.Alert) {
+	s.alerts = append(s.alerts, a)
+}
+
+type stubCreditClaimer struct {
+	claims int
+	err    error
+}
+
+func (s *stubCreditClaimer) ClaimCredit(_ context.Context) error {
+	s.claims++
+	return s.err
+}
@@ -0,0 +1,121 @@
+package positions
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Position is a golang wrapper around the dispute game Position type.
+// The index at depth is stored as a [big.Int] so positions deeper than 63 can be represented.
+type Position struct {
+	depth        int
+	indexAtDepth *big.Int
+}
+
+// NewPosition creates a new Position. The indexAtDepth is copied so later changes to it
+// don't affect the Position.
+func NewPosition(depth int, indexAtDepth *big.Int) Position {
+	// A zero index is stored as nil so positions remain comparable with the zero value Position, which is the root.
+	var index *big.Int
+	if indexAtDepth.Sign() != 0 {
+		index = new(big.Int).Set(indexAtDepth)
+	}
+	return Position{
+		depth:        depth,
+		indexAtDepth: index,
+	}
+}
+
+// NewPositionFromGIndex creates a new Position from a generalized index.
+func NewPositionFromGIndex(x *big.Int) Position {
+	depth := bigMSB(x)
+	withoutMSB := new(big.Int).Not(new(big.Int).Lsh(big.NewInt(1), uint(depth)))
+	indexAtDepth := new(big.Int).And(x, withoutMSB)
+	return NewPosition(depth, indexAtDepth)
+}
+
+func (p Position) Depth() int {
+	return p.depth
+}
+
+// IndexAtDepth returns a copy of the index of this position at its depth.
+func (p Position) IndexAtDepth() *big.Int {
+	if p.indexAtDepth == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(p.indexAtDepth)
+}
+
+func (p Position) IsRootPosition() bool {
+	return p.depth == 0 && p.IndexAtDepth().Sign() == 0
+}
+
+// TraceIndex calculates the what the index of the claim value would be inside the trace.
+// It is equivalent to going right until the final depth has been reached.
+func (p Position) TraceIndex(maxDepth int) *big.Int {
+	// When we go right, we do a shift left and set the bottom bit to be 1.
+	// To do this in a single step, do all the shifts at once & or in all 1s for the bottom bits.
+	rd := uint(maxDepth - p.depth)
+	rhs := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), rd), big.NewInt(1))
+	return new(big.Int).Or(new(big.Int).Lsh(p.IndexAtDepth(), rd), rhs)
+}
+
+// move returns the left or right child.
+func (p Position) move(right bool) Position {
+	return NewPosition(p.depth+1, new(big.Int).Or(new(big.Int).Lsh(p.IndexAtDepth(), 1), big.NewInt(int64(boolToInt(right)))))
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	} else {
+		return 0
+	}
+}
+
+// Parent returns the position of the parent of this position.
+// The parent of the root position is the root position.
+func (p Position) Parent() Position {
+	if p.depth == 0 {
+		return p
+	}
+	return NewPosition(p.depth-1, new(big.Int).Rsh(p.IndexAtDepth(), 1))
+}
+
+// Ancestors returns the positions of all ancestors of this position, starting with
+// its parent and ending with the root position.
+func (p Position) Ancestors() []Position {
+	ancestors := make([]Position, 0, p.depth)
+	for curr := p; curr.depth > 0; {
+		curr = curr.Parent()
+		ancestors = append(ancestors, curr)
+	}
+	return ancestors
+}
+
+// Attack creates a new position which is the attack position of this one.
+func (p Position) Attack() Position {
+	return p.move(false)
+}
+
+// Defend creates a new position which is the defend position of this one.
+func (p Position) Defend() Position {
+	return p.Parent().move(true).move(false)
+}
+
+func (p Position) Print(maxDepth int) {
+	fmt.Printf("GIN: %4b\tTrace Position is %4b\tTrace Depth is: %d\tTrace Index is: %d\n", p.ToGIndex(), p.IndexAtDepth(), p.depth, p.TraceIndex(maxDepth))
+}
+
+// ToGIndex returns the generalized index of this position.
+func (p Position) ToGIndex() *big.Int {
+	return new(big.Int).Or(new(big.Int).Lsh(big.NewInt(1), uint(p.depth)), p.IndexAtDepth())
+}
+
+// bigMSB returns the index of the most significant bit
+func bigMSB(x *big.Int) int {
+	if x.Sign() == 0 {
+		return 0
+	}
+	return x.BitLen() - 1
+}
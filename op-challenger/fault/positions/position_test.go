@@ -0,0 +1,227 @@
+package positions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func bi(i int) *big.Int {
+	return big.NewInt(int64(i))
+}
+
+func TestBigMSB(t *testing.T) {
+	large, ok := new(big.Int).SetString("7fffffffffffffffffffffffffffffff", 16)
+	require.True(t, ok)
+	tests := []struct {
+		input    *big.Int
+		expected int
+	}{
+		{bi(0), 0},
+		{bi(1), 0},
+		{bi(2), 1},
+		{bi(4), 2},
+		{bi(8), 3},
+		{bi(16), 4},
+		{bi(255), 7},
+		{bi(1024), 10},
+		{new(big.Int).SetUint64(18446744073709551615), 63},
+		{new(big.Int).Lsh(bi(1), 64), 64},
+		{large, 126},
+	}
+
+	for _, test := range tests {
+		result := bigMSB(test.input)
+		if result != test.expected {
+			t.Errorf("bigMSB(%d) expected %d, but got %d", test.input, test.expected, result)
+		}
+	}
+
+}
+
+type testNodeInfo struct {
+	GIndex       uint64
+	Depth        int
+	IndexAtDepth int
+	TraceIndex   uint64
+	AttackGIndex uint64 // 0 indicates attack is not possible from this node
+	DefendGIndex uint64 // 0 indicates defend is not possible from this node
+}
+
+var treeNodesMaxDepth4 = []testNodeInfo{
+	{GIndex: 1, Depth: 0, IndexAtDepth: 0, TraceIndex: 15, AttackGIndex: 2},
+
+	{GIndex: 2, Depth: 1, IndexAtDepth: 0, TraceIndex: 7, AttackGIndex: 4, DefendGIndex: 6},
+	{GIndex: 3, Depth: 1, IndexAtDepth: 1, TraceIndex: 15, AttackGIndex: 6},
+
+	{GIndex: 4, Depth: 2, IndexAtDepth: 0, TraceIndex: 3, AttackGIndex: 8, DefendGIndex: 10},
+	{GIndex: 5, Depth: 2, IndexAtDepth: 1, TraceIndex: 7, AttackGIndex: 10},
+	{GIndex: 6, Depth: 2, IndexAtDepth: 2, TraceIndex: 11, AttackGIndex: 12, DefendGIndex: 14},
+	{GIndex: 7, Depth: 2, IndexAtDepth: 3, TraceIndex: 15, AttackGIndex: 14},
+
+	{GIndex: 8, Depth: 3, IndexAtDepth: 0, TraceIndex: 1, AttackGIndex: 16, DefendGIndex: 18},
+	{GIndex: 9, Depth: 3, IndexAtDepth: 1, TraceIndex: 3, AttackGIndex: 18},
+	{GIndex: 10, Depth: 3, IndexAtDepth: 2, TraceIndex: 5, AttackGIndex: 20, DefendGIndex: 22},
+	{GIndex: 11, Depth: 3, IndexAtDepth: 3, TraceIndex: 7, AttackGIndex: 22},
+	{GIndex: 12, Depth: 3, IndexAtDepth: 4, TraceIndex: 9, AttackGIndex: 24, DefendGIndex: 26},
+	{GIndex: 13, Depth: 3, IndexAtDepth: 5, TraceIndex: 11, AttackGIndex: 26},
+	{GIndex: 14, Depth: 3, IndexAtDepth: 6, TraceIndex: 13, AttackGIndex: 28, DefendGIndex: 30},
+	{GIndex: 15, Depth: 3, IndexAtDepth: 7, TraceIndex: 15, AttackGIndex: 30},
+
+	{GIndex: 16, Depth: 4, IndexAtDepth: 0, TraceIndex: 0},
+	{GIndex: 17, Depth: 4, IndexAtDepth: 1, TraceIndex: 1},
+	{GIndex: 18, Depth: 4, IndexAtDepth: 2, TraceIndex: 2},
+	{GIndex: 19, Depth: 4, IndexAtDepth: 3, TraceIndex: 3},
+	{GIndex: 20, Depth: 4, IndexAtDepth: 4, TraceIndex: 4},
+	{GIndex: 21, Depth: 4, IndexAtDepth: 5, TraceIndex: 5},
+	{GIndex: 22, Depth: 4, IndexAtDepth: 6, TraceIndex: 6},
+	{GIndex: 23, Depth: 4, IndexAtDepth: 7, TraceIndex: 7},
+	{GIndex: 24, Depth: 4, IndexAtDepth: 8, TraceIndex: 8},
+	{GIndex: 25, Depth: 4, IndexAtDepth: 9, TraceIndex: 9},
+	{GIndex: 26, Depth: 4, IndexAtDepth: 10, TraceIndex: 10},
+	{GIndex: 27, Depth: 4, IndexAtDepth: 11, TraceIndex: 11},
+	{GIndex: 28, Depth: 4, IndexAtDepth: 12, TraceIndex: 12},
+	{GIndex: 29, Depth: 4, IndexAtDepth: 13, TraceIndex: 13},
+	{GIndex: 30, Depth: 4, IndexAtDepth: 14, TraceIndex: 14},
+	{GIndex: 31, Depth: 4, IndexAtDepth: 15, TraceIndex: 15},
+}
+
+// TestGINConversions does To & From the generalized index on the treeNodesMaxDepth4 data
+func TestGINConversions(t *testing.T) {
+	for _, test := range treeNodesMaxDepth4 {
+		from := NewPositionFromGIndex(new(big.Int).SetUint64(test.GIndex))
+		pos := NewPosition(test.Depth, bi(test.IndexAtDepth))
+		require.Equal(t, pos, from)
+		to := pos.ToGIndex()
+		require.Equal(t, new(big.Int).SetUint64(test.GIndex), to)
+	}
+}
+
+// TestTraceIndex creates the position & then tests the trace index function on the treeNodesMaxDepth4 data
+func TestTraceIndex(t *testing.T) {
+	for _, test := range treeNodesMaxDepth4 {
+		pos := NewPosition(test.Depth, bi(test.IndexAtDepth))
+		result := pos.TraceIndex(4)
+		require.Equal(t, new(big.Int).SetUint64(test.TraceIndex), result)
+	}
+}
+
+func TestAttack(t *testing.T) {
+	for _, test := range treeNodesMaxDepth4 {
+		if test.AttackGIndex == 0 {
+			continue
+		}
+		pos := NewPosition(test.Depth, bi(test.IndexAtDepth))
+		result := pos.Attack()
+		require.Equalf(t, new(big.Int).SetUint64(test.AttackGIndex), result.ToGIndex(), "Attack from GIndex %v", pos.ToGIndex())
+	}
+}
+
+func TestDefend(t *testing.T) {
+	for _, test := range treeNodesMaxDepth4 {
+		if test.DefendGIndex == 0 {
+			continue
+		}
+		pos := NewPosition(test.Depth, bi(test.IndexAtDepth))
+		result := pos.Defend()
+		require.Equalf(t, new(big.Int).SetUint64(test.DefendGIndex), result.ToGIndex(), "Defend from GIndex %v", pos.ToGIndex())
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	pos := NewPosition(3, bi(5))
+	ancestors := pos.Ancestors()
+	require.Equal(t, []Position{NewPosition(2, bi(2)), NewPosition(1, bi(1)), NewPosition(0, bi(0))}, ancestors)
+
+	require.Empty(t, NewPosition(0, bi(0)).Ancestors())
+	require.Equal(t, NewPosition(0, bi(0)), NewPosition(0, bi(0)).Parent())
+}
+
+func TestIndexAtDepthIsCopied(t *testing.T) {
+	index := bi(3)
+	pos := NewPosition(2, index)
+	index.SetInt64(1)
+	require.Equal(t, bi(3), pos.IndexAtDepth())
+
+	pos.IndexAtDepth().SetInt64(2)
+	require.Equal(t, bi(3), pos.IndexAtDepth())
+}
+
+func TestZeroValuePosition(t *testing.T) {
+	var pos Position
+	require.True(t, pos.IsRootPosition())
+	require.Equal(t, bi(0), pos.IndexAtDepth())
+	require.Equal(t, bi(1), pos.ToGIndex())
+}
+
+func TestDeepPositions(t *testing.T) {
+	// Positions beyond depth 63 can't be represented as a uint64 generalized index.
+	maxDepth := 127
+	rightmost := new(big.Int).Sub(new(big.Int).Lsh(bi(1), uint(maxDepth)), bi(1))
+	leaf := NewPosition(maxDepth, rightmost)
+
+	gindex := leaf.ToGIndex()
+	require.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(bi(1), uint(maxDepth+1)), bi(1)), gindex)
+	require.Equal(t, leaf, NewPositionFromGIndex(gindex))
+	require.Equal(t, rightmost, leaf.TraceIndex(maxDepth))
+
+	root := NewPosition(0, bi(0))
+	require.Equal(t, rightmost, root.TraceIndex(maxDepth))
+
+	attack := NewPosition(64, bi(0)).Attack()
+	require.Equal(t, 65, attack.Depth())
+	require.Equal(t, new(big.Int).Lsh(bi(1), 65), attack.ToGIndex())
+
+	defend := NewPosition(70, new(big.Int).Lsh(bi(1), 69)).Defend()
+	require.Equal(t, 71, defend.Depth())
+	require.Equal(t, new(big.Int).Add(new(big.Int).Lsh(bi(1), 70), bi(2)), defend.IndexAtDepth())
+
+	require.Len(t, leaf.Ancestors(), maxDepth)
+}
+
+func randomPosition(depth uint8, index []byte) Position {
+	d := int(depth % 128)
+	indexAtDepth := new(big.Int).SetBytes(index)
+	// Limit the index to the number of nodes at depth
+	indexAtDepth.And(indexAtDepth, new(big.Int).Sub(new(big.Int).Lsh(bi(1), uint(d)), bi(1)))
+	return NewPosition(d, indexAtDepth)
+}
+
+// FuzzGIndexRoundTrip checks that converting a position to a generalized index and back is lossless.
+func FuzzGIndexRoundTrip(f *testing.F) {
+	f.Add(uint8(0), []byte{})
+	f.Add(uint8(4), []byte{0x0f})
+	f.Add(uint8(100), []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	f.Fuzz(func(t *testing.T, depth uint8, index []byte) {
+		pos := randomPosition(depth, index)
+		require.Equal(t, pos, NewPositionFromGIndex(pos.ToGIndex()))
+	})
+}
+
+// FuzzAttackDefend checks the relationship between a position, its attack and defend positions and their parents.
+func FuzzAttackDefend(f *testing.F) {
+	f.Add(uint8(1), []byte{})
+	f.Add(uint8(63), []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe})
+	f.Add(uint8(126), []byte{0x3f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe})
+	f.Fuzz(func(t *testing.T, depth uint8, index []byte) {
+		pos := randomPosition(depth, index)
+		maxDepth := pos.Depth() + 1
+
+		attack := pos.Attack()
+		require.Equal(t, pos, attack.Parent())
+		require.Equal(t, pos.Depth()+1, attack.Depth())
+		// The attack commits to the first half of the trace committed to by pos
+		require.Equal(t, -1, attack.TraceIndex(maxDepth).Cmp(pos.TraceIndex(maxDepth)))
+
+		if pos.IsRootPosition() {
+			return
+		}
+		defend := pos.Defend()
+		require.Equal(t, pos.Depth()+1, defend.Depth())
+		require.Equal(t, pos.Parent(), defend.Parent().Parent())
+		// A defend commits to a later trace index than the position it defends
+		require.Equal(t, 1, defend.TraceIndex(maxDepth).Cmp(pos.TraceIndex(maxDepth)))
+		require.Equal(t, pos.Depth(), len(pos.Ancestors()))
+	})
+}
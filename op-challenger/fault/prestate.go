@@ -0,0 +1,82 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/slices"
+)
+
+var ErrNoImplementation = errors.New("no implementation registered")
+
+// GameImplSource provides the implementation contract address registered for each game type.
+type GameImplSource interface {
+	GameImpls(opts *bind.CallOpts, gameType uint8) (common.Address, error)
+}
+
+// LoaderCreator creates a [Loader] for the game contract at the specified address.
+type LoaderCreator func(addr common.Address) (Loader, error)
+
+// PrestateResult is the outcome of validating an absolute prestate against a single game type.
+type PrestateResult struct {
+	GameType uint8
+	Name     string
+	Impl     common.Address
+	// Err is nil if the prestate matches the game implementation's absolute prestate.
+	Err error
+}
+
+// Matches returns true if the prestate matched the game implementation's absolute prestate.
+func (r PrestateResult) Matches() bool {
+	return r.Err == nil
+}
+
+// ValidatePrestateForGameTypes validates the absolute prestate from the provider against the on-chain absolute prestate
+// of the implementation registered in the factory for every known game type played with traceType. Game types played
+// with other trace types use different prestates so are not checked.
+// A result is returned for each game type. Game types without a registered implementation fail with [ErrNoImplementation].
+func ValidatePrestateForGameTypes(ctx context.Context, traceType config.TraceType, prestate types.PrestateProvider, factory GameImplSource, createLoader LoaderCreator) ([]PrestateResult, error) {
+	var gameTypes []uint8
+	for gameType, gameTraceType := range config.GameIdToTraceType {
+		if gameTraceType == traceType {
+			gameTypes = append(gameTypes, gameType)
+		}
+	}
+	slices.Sort(gameTypes)
+	results := make([]PrestateResult, 0, len(gameTypes))
+	for _, gameType := range gameTypes {
+		result := PrestateResult{
+			GameType: gameType,
+			Name:     config.GameIdToString[gameType],
+		}
+		impl, err := factory.GameImpls(&bind.CallOpts{Context: ctx}, gameType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load implementation for game type %v: %w", gameType, err)
+		}
+		result.Impl = impl
+		if impl == (common.Address{}) {
+			result.Err = ErrNoImplementation
+			results = append(results, result)
+			continue
+		}
+		loader, err := createLoader(impl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create loader for game type %v: %w", gameType, err)
+		}
+		result.Err = ValidateAbsolutePrestate(ctx, prestate, loader)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// NewPrestateLoaderCreator returns a function creating a [Loader] for the game contract at each address.
+func NewPrestateLoaderCreator(client bind.ContractCaller) LoaderCreator {
+	return func(addr common.Address) (Loader, error) {
+		return NewLoaderFromBindings(addr, client)
+	}
+}
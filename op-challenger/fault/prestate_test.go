@@ -0,0 +1,82 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePrestateForGameTypes(t *testing.T) {
+	prestate := []byte{0x00, 0x01, 0x02, 0x03}
+	cannonImpl := common.Address{0xaa}
+	alphabetImpl := common.Address{0xbb}
+	loaders := map[common.Address]Loader{
+		cannonImpl:   newMockLoader(false, crypto.Keccak256(prestate)),
+		alphabetImpl: newMockLoader(false, []byte{0x00}),
+	}
+	createLoader := func(addr common.Address) (Loader, error) {
+		return loaders[addr], nil
+	}
+
+	t.Run("ReportsEachGameTypeForTraceType", func(t *testing.T) {
+		factory := &stubGameImplSource{impls: map[uint8]common.Address{
+			config.CannonFaultGameID:   cannonImpl,
+			config.AlphabetFaultGameID: alphabetImpl,
+		}}
+		results, err := ValidatePrestateForGameTypes(context.Background(), config.TraceTypeAlphabet, newMockTraceProvider(false, prestate), factory, createLoader)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, uint8(config.AlphabetFaultGameID), results[0].GameType)
+		require.Equal(t, "Alphabet", results[0].Name)
+		require.Equal(t, alphabetImpl, results[0].Impl)
+		require.False(t, results[0].Matches())
+	})
+
+	t.Run("IgnoreGameTypesForOtherTraceTypes", func(t *testing.T) {
+		factory := &stubGameImplSource{impls: map[uint8]common.Address{
+			config.CannonFaultGameID:   cannonImpl,
+			config.AlphabetFaultGameID: alphabetImpl,
+		}}
+		results, err := ValidatePrestateForGameTypes(context.Background(), config.TraceTypeCannon, newMockTraceProvider(false, prestate), factory, createLoader)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, uint8(config.CannonFaultGameID), results[0].GameType)
+		require.Equal(t, "Cannon", results[0].Name)
+		require.Equal(t, cannonImpl, results[0].Impl)
+		require.True(t, results[0].Matches())
+	})
+
+	t.Run("NoImplementation", func(t *testing.T) {
+		factory := &stubGameImplSource{impls: map[uint8]common.Address{
+			config.AlphabetFaultGameID: alphabetImpl,
+		}}
+		results, err := ValidatePrestateForGameTypes(context.Background(), config.TraceTypeCannon, newMockTraceProvider(false, prestate), factory, createLoader)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.ErrorIs(t, results[0].Err, ErrNoImplementation)
+	})
+
+	t.Run("FactoryError", func(t *testing.T) {
+		factory := &stubGameImplSource{err: errors.New("boom")}
+		_, err := ValidatePrestateForGameTypes(context.Background(), config.TraceTypeCannon, newMockTraceProvider(false, prestate), factory, createLoader)
+		require.ErrorIs(t, err, factory.err)
+	})
+}
+
+type stubGameImplSource struct {
+	impls map[uint8]common.Address
+	err   error
+}
+
+func (s *stubGameImplSource) GameImpls(_ *bind.CallOpts, gameType uint8) (common.Address, error) {
+	if s.err != nil {
+		return common.Address{}, s.err
+	}
+	return s.impls[gameType], nil
+}
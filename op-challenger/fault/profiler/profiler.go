@@ -0,0 +1,207 @@
+package profiler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Reasons profiles are captured, used in the name of the capture directory and in metrics.
+const (
+	ReasonSlowCycle   = "slow-cycle"
+	ReasonMemory      = "memory"
+	ReasonCannonStall = "cannon-stall"
+)
+
+const (
+	// cpuProfileDuration is the time the CPU is sampled for in each capture.
+	cpuProfileDuration = 10 * time.Second
+	// captureCooldown is the minimum time between captures so a persistent anomaly doesn't fill the disk.
+	captureCooldown = 10 * time.Minute
+	// memoryCheckInterval is how often memory use is compared to the limit.
+	memoryCheckInterval = 30 * time.Second
+)
+
+type Metricer interface {
+	RecordAnomalyProfile(reason string)
+}
+
+// AnomalyProfiler captures CPU, heap and goroutine profiles when the challenger behaves abnormally so the cause can be
+// analysed later. Profiles are written to a new directory for each capture and only the most recent captures are
+// retained.
+type AnomalyProfiler struct {
+	logger      log.Logger
+	clock       clock.Clock
+	metrics     Metricer
+	dir         string
+	maxProfiles int
+	slowCycle   time.Duration
+	memoryLimit uint64
+	cpuDuration time.Duration
+	readMemory  func() uint64
+	triggers    chan string
+
+	// lastCapture is the time of the most recent capture. Only accessed by the capture loop.
+	lastCapture time.Time
+}
+
+// NewAnomalyProfiler creates an [AnomalyProfiler] storing captures in dir and keeping at most maxProfiles of them.
+// Profiles are captured when a monitor cycle takes longer than slowCycle or the memory obtained by the Go runtime
+// exceeds memoryLimit bytes. A zero slowCycle or memoryLimit disables that check.
+func NewAnomalyProfiler(logger log.Logger, cl clock.Clock, m Metricer, dir string, maxProfiles uint, slowCycle time.Duration, memoryLimit uint64) *AnomalyProfiler {
+	return &AnomalyProfiler{
+		logger:      logger.New("component", "profiler"),
+		clock:       cl,
+		metrics:     m,
+		dir:         dir,
+		maxProfiles: int(maxProfiles),
+		slowCycle:   slowCycle,
+		memoryLimit: memoryLimit,
+		cpuDuration: cpuProfileDuration,
+		readMemory:  runtimeMemory,
+		triggers:    make(chan string, 1),
+	}
+}
+
+func runtimeMemory() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.Sys
+}
+
+// RecordMonitorCycle captures profiles if the monitor cycle took longer than the slow cycle threshold.
+func (p *AnomalyProfiler) RecordMonitorCycle(d time.Duration) {
+	if p.slowCycle != 0 && d > p.slowCycle {
+		p.logger.Warn("Slow monitor cycle", "duration", d, "threshold", p.slowCycle)
+		p.Trigger(ReasonSlowCycle)
+	}
+}
+
+// Trigger requests profiles be captured in the background for the specified reason.
+// The request is dropped if a capture is already waiting to start.
+func (p *AnomalyProfiler) Trigger(reason string) {
+	select {
+	case p.triggers <- reason:
+	default:
+		p.logger.Debug("Profile capture already pending", "reason", reason)
+	}
+}
+
+// Start captures profiles in the background until ctx is done.
+func (p *AnomalyProfiler) Start(ctx context.Context) {
+	go p.loop(ctx)
+}
+
+func (p *AnomalyProfiler) loop(ctx context.Context) {
+	var memoryCheck <-chan time.Time
+	if p.memoryLimit != 0 {
+		ticker := p.clock.NewTicker(memoryCheckInterval)
+		defer ticker.Stop()
+		memoryCheck = ticker.Ch()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-memoryCheck:
+			if memory := p.readMemory(); memory > p.memoryLimit {
+				p.logger.Warn("Memory use above limit", "memory", memory, "limit", p.memoryLimit)
+				p.capture(ctx, ReasonMemory)
+			}
+		case reason := <-p.triggers:
+			p.capture(ctx, reason)
+		}
+	}
+}
+
+func (p *AnomalyProfiler) capture(ctx context.Context, reason string) {
+	now := p.clock.Now()
+	if !p.lastCapture.IsZero() && now.Sub(p.lastCapture) < captureCooldown {
+		p.logger.Debug("Skipping profile capture during cooldown", "reason", reason, "last", p.lastCapture)
+		return
+	}
+	p.lastCapture = now
+	dir := filepath.Join(p.dir, fmt.Sprintf("%v-%v", now.UTC().Format("20060102T150405Z"), reason))
+	if err := p.writeProfiles(ctx, dir); err != nil {
+		p.logger.Error("Failed to capture profiles", "reason", reason, "dir", dir, "err", err)
+		return
+	}
+	p.metrics.RecordAnomalyProfile(reason)
+	p.logger.Warn("Captured profiles after anomaly", "reason", reason, "dir", dir)
+	if err := p.prune(); err != nil {
+		p.logger.Error("Failed to remove old profiles", "err", err)
+	}
+}
+
+func (p *AnomalyProfiler) writeProfiles(ctx context.Context, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create profile dir: %w", err)
+	}
+	for _, name := range []string{"heap", "goroutine"} {
+		if err := writeFile(filepath.Join(dir, name+".pprof"), func(f *os.File) error {
+			return pprof.Lookup(name).WriteTo(f, 0)
+		}); err != nil {
+			return fmt.Errorf("write %v profile: %w", name, err)
+		}
+	}
+	cpuProfile := filepath.Join(dir, "cpu.pprof")
+	err := writeFile(cpuProfile, func(f *os.File) error {
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+		defer pprof.StopCPUProfile()
+		return p.clock.SleepCtx(ctx, p.cpuDuration)
+	})
+	if err != nil {
+		// Only one CPU profile can run at once, so this fails if one was requested through the pprof server.
+		// The heap and goroutine profiles are still useful without it.
+		p.logger.Warn("Failed to capture CPU profile", "err", err)
+		_ = os.Remove(cpuProfile)
+	}
+	return nil
+}
+
+func writeFile(path string, write func(f *os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// prune removes the oldest captures so at most maxProfiles are retained.
+func (p *AnomalyProfiler) prune() error {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		return err
+	}
+	var captures []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			captures = append(captures, entry.Name())
+		}
+	}
+	if len(captures) <= p.maxProfiles {
+		return nil
+	}
+	// Capture directories are prefixed with the time they were taken so sort oldest first
+	sort.Strings(captures)
+	for _, name := range captures[:len(captures)-p.maxProfiles] {
+		if err := os.RemoveAll(filepath.Join(p.dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
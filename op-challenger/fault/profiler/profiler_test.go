@@ -0,0 +1,124 @@
+package profiler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture(t *testing.T) {
+	setup := func(t *testing.T, maxProfiles uint) (*AnomalyProfiler, *stubMetrics, string) {
+		dir := t.TempDir()
+		m := &stubMetrics{}
+		p := NewAnomalyProfiler(testlog.Logger(t, log.LvlInfo), clock.SystemClock, m, dir, maxProfiles, time.Minute, 0)
+		p.cpuDuration = time.Millisecond
+		return p, m, dir
+	}
+
+	t.Run("WriteProfiles", func(t *testing.T) {
+		p, m, dir := setup(t, 5)
+		p.capture(context.Background(), ReasonCannonStall)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Contains(t, entries[0].Name(), ReasonCannonStall)
+		for _, name := range []string{"cpu.pprof", "heap.pprof", "goroutine.pprof"} {
+			require.FileExists(t, filepath.Join(dir, entries[0].Name(), name))
+		}
+		require.Equal(t, []string{ReasonCannonStall}, m.reasons())
+	})
+
+	t.Run("Cooldown", func(t *testing.T) {
+		p, m, dir := setup(t, 5)
+		p.lastCapture = time.Now().Add(-captureCooldown + time.Minute)
+		p.capture(context.Background(), ReasonSlowCycle)
+		require.Empty(t, m.reasons())
+
+		p.lastCapture = time.Now().Add(-captureCooldown - time.Minute)
+		p.capture(context.Background(), ReasonSlowCycle)
+		require.Equal(t, []string{ReasonSlowCycle}, m.reasons())
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("Retention", func(t *testing.T) {
+		p, _, dir := setup(t, 2)
+		for _, name := range []string{"20230101T000000Z-memory", "20230102T000000Z-memory", "20230103T000000Z-memory"} {
+			require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0755))
+		}
+		p.capture(context.Background(), ReasonMemory)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "20230103T000000Z-memory", entries[0].Name(), "should remove oldest captures")
+	})
+}
+
+func TestRecordMonitorCycle(t *testing.T) {
+	p := NewAnomalyProfiler(testlog.Logger(t, log.LvlInfo), clock.SystemClock, &stubMetrics{}, t.TempDir(), 5, time.Minute, 0)
+	p.RecordMonitorCycle(time.Minute)
+	require.Empty(t, p.triggers)
+
+	p.RecordMonitorCycle(time.Minute + time.Second)
+	require.Equal(t, ReasonSlowCycle, <-p.triggers)
+
+	p.slowCycle = 0
+	p.RecordMonitorCycle(time.Hour)
+	require.Empty(t, p.triggers, "should not trigger when disabled")
+}
+
+func TestCaptureWhenMemoryAboveLimit(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	m := &stubMetrics{}
+	p := NewAnomalyProfiler(testlog.Logger(t, log.LvlInfo), cl, m, t.TempDir(), 5, 0, 1000)
+	p.cpuDuration = time.Second
+	var memory uint64 = 1000
+	var lock sync.Mutex
+	p.readMemory = func() uint64 {
+		lock.Lock()
+		defer lock.Unlock()
+		return memory
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.Start(ctx)
+
+	require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second), "should start memory check ticker")
+	cl.AdvanceTime(memoryCheckInterval)
+	require.Never(t, func() bool { return len(m.reasons()) > 0 }, 100*time.Millisecond, 10*time.Millisecond, "should not capture at limit")
+
+	lock.Lock()
+	memory = 1001
+	lock.Unlock()
+	cl.AdvanceTime(memoryCheckInterval)
+	require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second), "should start CPU profile")
+	cl.AdvanceTime(time.Second)
+	require.Eventually(t, func() bool { return len(m.reasons()) == 1 }, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{ReasonMemory}, m.reasons())
+}
+
+type stubMetrics struct {
+	lock     sync.Mutex
+	profiles []string
+}
+
+func (s *stubMetrics) RecordAnomalyProfile(reason string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.profiles = append(s.profiles, reason)
+}
+
+func (s *stubMetrics) reasons() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.profiles...)
+}
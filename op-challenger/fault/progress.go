@@ -0,0 +1,62 @@
+package fault
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// actProgressFile is the file within a game's data directory that records the claims acted on in the current pass.
+const actProgressFile = "act-progress.json"
+
+// ActProgressStore persists the contract indices of the claims the agent has acted on since every claim in the game
+// was last processed, so a pass stopped by the time slice resumes with the remaining claims after the player is
+// recreated or the challenger restarts.
+type ActProgressStore interface {
+	Load() ([]int, error)
+	Save(processed []int) error
+}
+
+// fileActProgress stores the act progress in a file in the game's data directory, so it is removed with the rest of
+// the game data once the game is resolved.
+type fileActProgress struct {
+	path string
+}
+
+func newFileActProgress(dir string) *fileActProgress {
+	return &fileActProgress{path: filepath.Join(dir, actProgressFile)}
+}
+
+// Load returns the contract indices of the processed claims. Returns an empty list if no progress has been saved.
+func (f *fileActProgress) Load() ([]int, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var processed []int
+	if err := json.Unmarshal(data, &processed); err != nil {
+		return nil, err
+	}
+	return processed, nil
+}
+
+// Save replaces the stored progress with processed. The file is removed when no claims have been processed.
+func (f *fileActProgress) Save(processed []int) error {
+	if len(processed) == 0 {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(processed)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, data, 0644)
+}
@@ -0,0 +1,118 @@
+package fault
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type providerCreator func() (types.TraceProvider, error)
+
+// providerRegistry shares the trace provider of each game between its player and claim verifications.
+// Trace data generated for one is reused by the other, and calls to a game's provider are serialized so cannon is never
+// executed in the same data directory concurrently.
+type providerRegistry struct {
+	lock      sync.Mutex
+	providers map[common.Address]*sharedProvider
+}
+
+func newProviderRegistry() *providerRegistry {
+	return &providerRegistry{
+		providers: make(map[common.Address]*sharedProvider),
+	}
+}
+
+// Acquire returns the provider for the game, creating it with create if the game doesn't have one yet.
+// The returned release function must be called once the provider is no longer used. The provider is discarded when
+// every user has released it.
+func (r *providerRegistry) Acquire(game common.Address, create providerCreator) (types.TraceProvider, func(), error) {
+	if shared := r.acquireExisting(game); shared != nil {
+		return shared.provider(), r.releaser(game, shared), nil
+	}
+	// Create the provider without holding the lock as it may make network requests.
+	trace, err := create()
+	if err != nil {
+		return nil, nil, err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	shared, ok := r.providers[game]
+	if !ok {
+		// The provider created concurrently by another user is kept if there is one, so only one provider is used.
+		shared = &sharedProvider{trace: trace}
+		r.providers[game] = shared
+	}
+	shared.refs++
+	return shared.provider(), r.releaser(game, shared), nil
+}
+
+func (r *providerRegistry) acquireExisting(game common.Address) *sharedProvider {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	shared, ok := r.providers[game]
+	if !ok {
+		return nil
+	}
+	shared.refs++
+	return shared
+}
+
+func (r *providerRegistry) releaser(game common.Address, shared *sharedProvider) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			shared.refs--
+			if shared.refs == 0 && r.providers[game] == shared {
+				delete(r.providers, game)
+			}
+		})
+	}
+}
+
+// sharedProvider serializes calls to a trace provider with multiple users.
+type sharedProvider struct {
+	lock  sync.Mutex
+	trace types.TraceProvider
+	refs  int
+}
+
+// provider returns the shared provider, supporting diagnostics if the underlying provider does.
+func (s *sharedProvider) provider() types.TraceProvider {
+	if _, ok := s.trace.(diagnostics.TraceDiagnoser); ok {
+		return &sharedDiagnoser{s}
+	}
+	return s
+}
+
+func (s *sharedProvider) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.trace.Get(ctx, i)
+}
+
+func (s *sharedProvider) GetStepData(ctx context.Context, i uint64) ([]byte, []byte, *types.PreimageOracleData, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.trace.GetStepData(ctx, i)
+}
+
+func (s *sharedProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.trace.AbsolutePreState(ctx)
+}
+
+type sharedDiagnoser struct {
+	*sharedProvider
+}
+
+func (s *sharedDiagnoser) Diagnose(ctx context.Context, i uint64) (any, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.trace.(diagnostics.TraceDiagnoser).Diagnose(ctx, i)
+}
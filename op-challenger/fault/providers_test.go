@@ -0,0 +1,99 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderRegistry_ShareProviderUntilReleased(t *testing.T) {
+	game := common.Address{0xaa}
+	registry := newProviderRegistry()
+	created := 0
+	create := func() (types.TraceProvider, error) {
+		created++
+		return alphabet.NewTraceProvider("abcdefgh", 3), nil
+	}
+
+	first, releaseFirst, err := registry.Acquire(game, create)
+	require.NoError(t, err)
+	second, releaseSecond, err := registry.Acquire(game, create)
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+	require.Same(t, first, second)
+
+	releaseFirst()
+	releaseFirst() // Releasing more than once has no effect
+	_, releaseThird, err := registry.Acquire(game, create)
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+
+	releaseSecond()
+	releaseThird()
+	_, release, err := registry.Acquire(game, create)
+	require.NoError(t, err)
+	defer release()
+	require.Equal(t, 2, created, "should create a new provider once all users released the previous one")
+}
+
+func TestProviderRegistry_SeparateProviderPerGame(t *testing.T) {
+	registry := newProviderRegistry()
+	created := 0
+	create := func() (types.TraceProvider, error) {
+		created++
+		return alphabet.NewTraceProvider("abcdefgh", 3), nil
+	}
+	_, release1, err := registry.Acquire(common.Address{0xaa}, create)
+	require.NoError(t, err)
+	defer release1()
+	_, release2, err := registry.Acquire(common.Address{0xbb}, create)
+	require.NoError(t, err)
+	defer release2()
+	require.Equal(t, 2, created)
+}
+
+func TestProviderRegistry_CreateError(t *testing.T) {
+	game := common.Address{0xaa}
+	registry := newProviderRegistry()
+	createErr := errors.New("boom")
+	_, _, err := registry.Acquire(game, func() (types.TraceProvider, error) {
+		return nil, createErr
+	})
+	require.ErrorIs(t, err, createErr)
+	require.Empty(t, registry.providers)
+}
+
+func TestProviderRegistry_Diagnostics(t *testing.T) {
+	registry := newProviderRegistry()
+	plain, release1, err := registry.Acquire(common.Address{0xaa}, func() (types.TraceProvider, error) {
+		return alphabet.NewTraceProvider("abcdefgh", 3), nil
+	})
+	require.NoError(t, err)
+	defer release1()
+	_, ok := plain.(diagnostics.TraceDiagnoser)
+	require.False(t, ok)
+
+	diagnosing, release2, err := registry.Acquire(common.Address{0xbb}, func() (types.TraceProvider, error) {
+		return &stubDiagnosingProvider{TraceProvider: alphabet.NewTraceProvider("abcdefgh", 3)}, nil
+	})
+	require.NoError(t, err)
+	defer release2()
+	require.Implements(t, (*diagnostics.TraceDiagnoser)(nil), diagnosing)
+	detail, err := diagnosing.(diagnostics.TraceDiagnoser).Diagnose(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), detail)
+}
+
+type stubDiagnosingProvider struct {
+	types.TraceProvider
+}
+
+func (s *stubDiagnosingProvider) Diagnose(_ context.Context, i uint64) (any, error) {
+	return i, nil
+}
@@ -0,0 +1,132 @@
+package fault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var ErrQuorumNotReached = errors.New("L1 endpoints did not reach quorum")
+
+type QuorumMetricer interface {
+	RecordL1QuorumDisagreement()
+}
+
+// quorumCaller is a [bind.ContractCaller] that sends each call to multiple L1 endpoints and only returns a
+// result when at least threshold endpoints return exactly the same data.
+// This protects against a single malicious or buggy RPC provider causing the challenger to act on bad claim data.
+// Calls for the latest block are sent to every endpoint for the same block number, fetched with latestBlock, so
+// endpoints at different head blocks still agree. Callers that only act on confirmed state, such as the
+// [confirmedCaller], pass the confirmed block explicitly. Endpoints that have not yet synced to the block fail the
+// call, which is retried on the next attempt to progress the game.
+type quorumCaller struct {
+	logger      log.Logger
+	metrics     QuorumMetricer
+	callers     []bind.ContractCaller
+	threshold   int
+	latestBlock blockNumberFetcher
+}
+
+// newQuorumCaller creates a new [quorumCaller]. A threshold of 0 requires all endpoints to agree.
+func newQuorumCaller(logger log.Logger, m QuorumMetricer, callers []bind.ContractCaller, threshold int, latestBlock blockNumberFetcher) *quorumCaller {
+	if threshold <= 0 || threshold > len(callers) {
+		threshold = len(callers)
+	}
+	return &quorumCaller{
+		logger:      logger,
+		metrics:     m,
+		callers:     callers,
+		threshold:   threshold,
+		latestBlock: latestBlock,
+	}
+}
+
+func (q *quorumCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	blockNumber, err := q.blockNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return q.quorum("code", func(caller bind.ContractCaller) ([]byte, error) {
+		return caller.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (q *quorumCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	blockNumber, err := q.blockNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return q.quorum("call", func(caller bind.ContractCaller) ([]byte, error) {
+		return caller.CallContract(ctx, call, blockNumber)
+	})
+}
+
+// blockNumber returns the block to query every endpoint at, resolving a request for the latest block to its number.
+func (q *quorumCaller) blockNumber(ctx context.Context, requested *big.Int) (*big.Int, error) {
+	if requested != nil {
+		return requested, nil
+	}
+	latest, err := q.latestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the latest L1 block: %w", err)
+	}
+	return new(big.Int).SetUint64(latest), nil
+}
+
+func (q *quorumCaller) quorum(method string, fn func(caller bind.ContractCaller) ([]byte, error)) ([]byte, error) {
+	type group struct {
+		result []byte
+		count  int
+	}
+	var groups []*group
+	var errs []error
+	for i, caller := range q.callers {
+		result, err := fn(caller)
+		if err != nil {
+			q.logger.Warn("L1 endpoint failed", "method", method, "endpoint", i, "err", err)
+			errs = append(errs, fmt.Errorf("endpoint %v: %w", i, err))
+			continue
+		}
+		found := false
+		for _, g := range groups {
+			if bytes.Equal(g.result, result) {
+				g.count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, &group{result: result, count: 1})
+		}
+	}
+	var best *group
+	for _, g := range groups {
+		if best == nil || g.count > best.count {
+			best = g
+		}
+	}
+	if len(groups) > 1 {
+		q.metrics.RecordL1QuorumDisagreement()
+	}
+	if best == nil || best.count < q.threshold {
+		agreed := 0
+		if best != nil {
+			agreed = best.count
+		}
+		q.logger.Error("L1 endpoints disagree, refusing to act", "method", method, "agreed", agreed,
+			"threshold", q.threshold, "results", len(groups), "errors", len(errs))
+		err := fmt.Errorf("%w: %v of %v endpoints agreed, require %v", ErrQuorumNotReached, agreed, len(q.callers), q.threshold)
+		return nil, errors.Join(append([]error{err}, errs...)...)
+	}
+	if len(groups) > 1 {
+		q.logger.Warn("L1 endpoints disagree but quorum reached", "method", method, "agreed", best.count, "threshold", q.threshold)
+	}
+	return best.result, nil
+}
@@ -0,0 +1,190 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorumCaller(t *testing.T) {
+	good := []byte{0x01}
+	bad := []byte{0x02}
+	errFail := errors.New("boom")
+
+	tests := []struct {
+		name          string
+		results       []stubQuorumEndpoint
+		threshold     int
+		expected      []byte
+		disagreements int
+	}{
+		{
+			name:     "AllAgree",
+			results:  []stubQuorumEndpoint{{result: good}, {result: good}, {result: good}},
+			expected: good,
+		},
+		{
+			name:          "DisagreeRequireAll",
+			results:       []stubQuorumEndpoint{{result: good}, {result: bad}, {result: good}},
+			disagreements: 1,
+		},
+		{
+			name:          "DisagreeWithinThreshold",
+			results:       []stubQuorumEndpoint{{result: good}, {result: bad}, {result: good}},
+			threshold:     2,
+			expected:      good,
+			disagreements: 1,
+		},
+		{
+			name:      "ErrorWithinThreshold",
+			results:   []stubQuorumEndpoint{{result: good}, {err: errFail}, {result: good}},
+			threshold: 2,
+			expected:  good,
+		},
+		{
+			name:      "ErrorRequireAll",
+			results:   []stubQuorumEndpoint{{result: good}, {err: errFail}, {result: good}},
+			threshold: 0,
+		},
+		{
+			name:      "AllError",
+			results:   []stubQuorumEndpoint{{err: errFail}, {err: errFail}},
+			threshold: 1,
+		},
+		{
+			name:          "MajorityWrong",
+			results:       []stubQuorumEndpoint{{result: good}, {result: bad}, {result: bad}},
+			threshold:     3,
+			disagreements: 1,
+		},
+		{
+			name:      "ThresholdTooHighRequiresAll",
+			results:   []stubQuorumEndpoint{{result: good}, {result: good}},
+			threshold: 5,
+			expected:  good,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var callers []bind.ContractCaller
+			for i := range test.results {
+				callers = append(callers, &test.results[i])
+			}
+			m := &stubQuorumMetrics{}
+			caller := newQuorumCaller(testlog.Logger(t, log.LvlCrit), m, callers, test.threshold, latestBlock(100))
+
+			result, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+			if test.expected == nil {
+				require.ErrorIs(t, err, ErrQuorumNotReached)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, result)
+			}
+			require.Equal(t, test.disagreements, m.disagreements)
+
+			// CodeAt is cross-checked the same way
+			_, err = caller.CodeAt(context.Background(), common.Address{}, nil)
+			if test.expected == nil {
+				require.ErrorIs(t, err, ErrQuorumNotReached)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, endpoint := range test.results {
+				require.Equal(t, 2, endpoint.calls, "should query every endpoint")
+			}
+		})
+	}
+}
+
+func TestQuorumCallerIncludesEndpointErrors(t *testing.T) {
+	errFail := errors.New("boom")
+	caller := newQuorumCaller(testlog.Logger(t, log.LvlCrit), &stubQuorumMetrics{},
+		[]bind.ContractCaller{&stubQuorumEndpoint{err: errFail}}, 0, latestBlock(100))
+	_, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+	require.ErrorIs(t, err, ErrQuorumNotReached)
+	require.ErrorIs(t, err, errFail)
+}
+
+func TestQuorumCallerQueriesEndpointsAtSameBlock(t *testing.T) {
+	newCaller := func(t *testing.T, latest blockNumberFetcher) (*quorumCaller, []*stubQuorumEndpoint) {
+		endpoints := []*stubQuorumEndpoint{{result: []byte{0x01}}, {result: []byte{0x01}}}
+		callers := []bind.ContractCaller{endpoints[0], endpoints[1]}
+		return newQuorumCaller(testlog.Logger(t, log.LvlCrit), &stubQuorumMetrics{}, callers, 0, latest), endpoints
+	}
+
+	t.Run("ResolveLatestBlock", func(t *testing.T) {
+		caller, endpoints := newCaller(t, latestBlock(100))
+		_, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		_, err = caller.CodeAt(context.Background(), common.Address{}, nil)
+		require.NoError(t, err)
+		for _, endpoint := range endpoints {
+			require.Equal(t, []*big.Int{big.NewInt(100), big.NewInt(100)}, endpoint.blocks)
+		}
+	})
+
+	t.Run("UseRequestedBlock", func(t *testing.T) {
+		caller, endpoints := newCaller(t, func(ctx context.Context) (uint64, error) {
+			return 0, errors.New("should not fetch the latest block")
+		})
+		_, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, big.NewInt(42))
+		require.NoError(t, err)
+		for _, endpoint := range endpoints {
+			require.Equal(t, []*big.Int{big.NewInt(42)}, endpoint.blocks)
+		}
+	})
+
+	t.Run("LatestBlockUnavailable", func(t *testing.T) {
+		errFail := errors.New("boom")
+		caller, endpoints := newCaller(t, func(ctx context.Context) (uint64, error) {
+			return 0, errFail
+		})
+		_, err := caller.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+		require.ErrorIs(t, err, errFail)
+		for _, endpoint := range endpoints {
+			require.Zero(t, endpoint.calls)
+		}
+	})
+}
+
+func latestBlock(num uint64) blockNumberFetcher {
+	return func(ctx context.Context) (uint64, error) {
+		return num, nil
+	}
+}
+
+type stubQuorumEndpoint struct {
+	result []byte
+	err    error
+	calls  int
+	blocks []*big.Int
+}
+
+func (s *stubQuorumEndpoint) CodeAt(_ context.Context, _ common.Address, blockNumber *big.Int) ([]byte, error) {
+	s.calls++
+	s.blocks = append(s.blocks, blockNumber)
+	return s.result, s.err
+}
+
+func (s *stubQuorumEndpoint) CallContract(_ context.Context, _ ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	s.calls++
+	s.blocks = append(s.blocks, blockNumber)
+	return s.result, s.err
+}
+
+type stubQuorumMetrics struct {
+	disagreements int
+}
+
+func (s *stubQuorumMetrics) RecordL1QuorumDisagreement() {
+	s.disagreements++
+}
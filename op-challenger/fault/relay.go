@@ -0,0 +1,38 @@
+package fault
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// TxSender submits signed transactions.
+type TxSender interface {
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// relayBackend is a [txmgr.ETHBackend] that submits transactions through a private relay instead of the L1 node,
+// so they are not visible in the public mempool until included in a block. All other requests, including polling
+// for receipts, are sent to the L1 node.
+type relayBackend struct {
+	txmgr.ETHBackend
+	logger log.Logger
+	relay  TxSender
+}
+
+func newRelayBackend(logger log.Logger, backend txmgr.ETHBackend, relay TxSender) *relayBackend {
+	return &relayBackend{
+		ETHBackend: backend,
+		logger:     logger,
+		relay:      relay,
+	}
+}
+
+// SendTransaction submits the transaction to the relay.
+// Transactions are never sent to the L1 node, even if the relay fails, to avoid exposing them to the public mempool.
+func (r *relayBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	r.logger.Debug("Sending transaction through relay", "tx_hash", tx.Hash())
+	return r.relay.SendTransaction(ctx, tx)
+}
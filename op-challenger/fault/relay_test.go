@@ -0,0 +1,53 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayBackend(t *testing.T) {
+	tx := types.NewTx(&types.DynamicFeeTx{Nonce: 4})
+
+	t.Run("SendsThroughRelay", func(t *testing.T) {
+		l1 := &stubTxSender{}
+		relay := &stubTxSender{}
+		backend := newRelayBackend(testlog.Logger(t, log.LvlInfo), &stubETHBackend{stubTxSender: l1}, relay)
+		require.NoError(t, backend.SendTransaction(context.Background(), tx))
+		require.Equal(t, []*types.Transaction{tx}, relay.sent)
+		require.Empty(t, l1.sent)
+	})
+
+	t.Run("DoesNotFallBackToL1", func(t *testing.T) {
+		l1 := &stubTxSender{}
+		relay := &stubTxSender{err: errors.New("relay unavailable")}
+		backend := newRelayBackend(testlog.Logger(t, log.LvlInfo), &stubETHBackend{stubTxSender: l1}, relay)
+		require.ErrorIs(t, backend.SendTransaction(context.Background(), tx), relay.err)
+		require.Empty(t, l1.sent)
+	})
+}
+
+type stubTxSender struct {
+	err  error
+	sent []*types.Transaction
+}
+
+func (s *stubTxSender) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	s.sent = append(s.sent, tx)
+	return s.err
+}
+
+type stubETHBackend struct {
+	txmgr.ETHBackend
+	*stubTxSender
+}
+
+func (s *stubETHBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return s.stubTxSender.SendTransaction(ctx, tx)
+}
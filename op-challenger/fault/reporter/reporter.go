@@ -0,0 +1,243 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// SignatureHeader is the HTTP header containing the hex encoded HMAC-SHA256 signature of the request body.
+	SignatureHeader = "X-Challenger-Signature"
+
+	// retryInterval is the time between attempts to send reports that previously failed.
+	retryInterval = time.Minute
+	// maxAttempts is the number of times each report is sent before waiting for the next retry interval.
+	maxAttempts = 3
+	// requestTimeout is the maximum time to wait for the endpoint to respond to a single report.
+	requestTimeout = 30 * time.Second
+
+	reportFileExt = ".json"
+	// reportedDir is the directory within the report dir that records the games that have already been reported.
+	reportedDir = "reported"
+)
+
+var errUnexpectedStatus = errors.New("unexpected response status")
+
+// Outcome describes a completed game and the challenger's participation in it.
+type Outcome struct {
+	Game       common.Address   `json:"game"`
+	RootClaim  common.Hash      `json:"rootClaim"`
+	Status     types.GameStatus `json:"status"`
+	Winner     string           `json:"winner"`     // "challenger" or "defender"
+	Won        bool             `json:"won"`        // Whether the game resolved the way this challenger argued for
+	Reporter   common.Address   `json:"reporter"`   // Address the reporting challenger sends transactions from
+	Moves      uint64           `json:"moves"`      // Number of attack and defend moves posted by this challenger
+	Steps      uint64           `json:"steps"`      // Number of steps performed by this challenger
+	GasUsed    uint64           `json:"gasUsed"`    // Total gas used by this challenger's transactions
+	GasCost    *hexutil.Big     `json:"gasCost"`    // Total fees in wei paid by this challenger
+	Bonded     *hexutil.Big     `json:"bonded"`     // Total value in wei bonded by this challenger
+	ResolvedAt uint64           `json:"resolvedAt"` // Unix timestamp of when the challenger observed the game was complete
+
+	CreatedAt uint64 `json:"createdAt"` // Unix timestamp of when the game was created
+}
+
+// Reporter sends the outcome of completed games to an external HTTP endpoint.
+// Outcomes are written to disk before being sent so reports that fail are retried, including after a restart.
+type Reporter struct {
+	logger   log.Logger
+	clock    clock.Clock
+	client   *http.Client
+	endpoint string
+	secret   []byte
+	from     common.Address
+	dir      string
+	strategy retry.Strategy
+	wake     chan struct{}
+	lock     sync.Mutex
+}
+
+// NewReporter creates a new [Reporter] that POSTs outcomes to endpoint, queueing pending reports in dir.
+// If secret is not empty, each request is signed with it in the [SignatureHeader] header.
+func NewReporter(logger log.Logger, cl clock.Clock, endpoint string, secret string, from common.Address, dir string) (*Reporter, error) {
+	if err := os.MkdirAll(filepath.Join(dir, reportedDir), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create outcome report dir: %w", err)
+	}
+	return &Reporter{
+		logger:   logger.New("component", "reporter"),
+		clock:    cl,
+		client:   &http.Client{Timeout: requestTimeout},
+		endpoint: endpoint,
+		secret:   []byte(secret),
+		from:     from,
+		dir:      dir,
+		strategy: retry.Exponential(),
+		wake:     make(chan struct{}, 1),
+	}, nil
+}
+
+// ReportOutcome queues the outcome to be sent to the endpoint.
+// Games that have already been reported are ignored so each game is only reported once, including after a restart.
+// Failures are logged rather than returned so reporting never interferes with playing games.
+func (r *Reporter) ReportOutcome(outcome Outcome) {
+	outcome.Reporter = r.from
+	queued, err := r.queueOnce(outcome)
+	if err != nil {
+		r.logger.Error("Failed to queue outcome report", "game", outcome.Game, "err", err)
+		return
+	}
+	if !queued {
+		r.logger.Debug("Outcome already reported", "game", outcome.Game)
+		return
+	}
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start sends queued reports in the background until ctx is done.
+func (r *Reporter) Start(ctx context.Context) {
+	go r.loop(ctx)
+}
+
+func (r *Reporter) loop(ctx context.Context) {
+	ticker := r.clock.NewTicker(retryInterval)
+	defer ticker.Stop()
+	for {
+		r.sendPending(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Ch():
+		case <-r.wake:
+		}
+	}
+}
+
+// queueOnce queues the outcome unless the game has already been reported, then records that the game was reported.
+// Returns true if the outcome was queued.
+func (r *Reporter) queueOnce(outcome Outcome) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	marker := filepath.Join(r.dir, reportedDir, outcome.Game.Hex())
+	if _, err := os.Stat(marker); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("failed to check for existing report: %w", err)
+	}
+	if err := r.queue(outcome); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(marker, nil, 0644); err != nil {
+		return false, fmt.Errorf("failed to record report: %w", err)
+	}
+	return true, nil
+}
+
+// queue atomically writes the outcome to the report dir, replacing any existing report for the game.
+func (r *Reporter) queue(outcome Outcome) error {
+	data, err := json.Marshal(outcome)
+	if err != nil {
+		return fmt.Errorf("failed to encode outcome: %w", err)
+	}
+	tmp, err := os.CreateTemp(r.dir, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close report file: %w", err)
+	}
+	return os.Rename(tmp.Name(), r.reportPath(outcome.Game))
+}
+
+func (r *Reporter) reportPath(game common.Address) string {
+	return filepath.Join(r.dir, game.Hex()+reportFileExt)
+}
+
+// sendPending attempts to send each queued report, removing reports that are successfully sent.
+func (r *Reporter) sendPending(ctx context.Context) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		r.logger.Error("Failed to list queued outcome reports", "err", err)
+		return
+	}
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), reportFileExt) {
+			continue
+		}
+		path := filepath.Join(r.dir, entry.Name())
+		body, err := os.ReadFile(path)
+		if err != nil {
+			r.logger.Error("Failed to read queued outcome report", "file", path, "err", err)
+			continue
+		}
+		_, err = retry.Do(ctx, maxAttempts, r.strategy, func() (struct{}, error) {
+			return struct{}{}, r.post(ctx, body)
+		})
+		if err != nil {
+			r.logger.Warn("Failed to send outcome report, will retry", "file", path, "err", err)
+			continue
+		}
+		r.logger.Info("Sent outcome report", "file", path)
+		if err := os.Remove(path); err != nil {
+			r.logger.Error("Failed to remove sent outcome report", "file", path, "err", err)
+		}
+	}
+}
+
+func (r *Reporter) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(r.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(r.secret, body))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %v", errUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of body using secret.
+// Receivers verify reports by computing the same signature and comparing it to the [SignatureHeader] header.
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,207 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var from = common.Address{0xff}
+
+func TestReportOutcome(t *testing.T) {
+	server := newStubEndpoint(t)
+	reporter := newTestReporter(t, server.URL, "secret")
+	outcome := testOutcome(common.Address{0xaa})
+
+	reporter.ReportOutcome(outcome)
+	reporter.sendPending(context.Background())
+
+	require.Len(t, server.bodies, 1)
+	var received Outcome
+	require.NoError(t, json.Unmarshal(server.bodies[0], &received))
+	outcome.Reporter = from
+	require.Equal(t, outcome, received)
+	require.Equal(t, Sign([]byte("secret"), server.bodies[0]), server.signatures[0])
+	require.Empty(t, queuedReports(t, reporter), "should remove sent report")
+}
+
+func TestReportOutcome_Unsigned(t *testing.T) {
+	server := newStubEndpoint(t)
+	reporter := newTestReporter(t, server.URL, "")
+	reporter.ReportOutcome(testOutcome(common.Address{0xaa}))
+	reporter.sendPending(context.Background())
+	require.Len(t, server.bodies, 1)
+	require.Empty(t, server.signatures[0])
+}
+
+func TestReportOutcome_RetryFailures(t *testing.T) {
+	server := newStubEndpoint(t)
+	reporter := newTestReporter(t, server.URL, "")
+	server.failures = maxAttempts - 1
+	reporter.ReportOutcome(testOutcome(common.Address{0xaa}))
+	reporter.sendPending(context.Background())
+	require.Len(t, server.bodies, maxAttempts)
+	require.Empty(t, queuedReports(t, reporter))
+}
+
+func TestReportOutcome_KeepFailedReports(t *testing.T) {
+	server := newStubEndpoint(t)
+	reporter := newTestReporter(t, server.URL, "")
+	server.failures = maxAttempts
+	reporter.ReportOutcome(testOutcome(common.Address{0xaa}))
+	reporter.sendPending(context.Background())
+	require.Len(t, server.bodies, maxAttempts)
+	require.Len(t, queuedReports(t, reporter), 1, "should keep report to retry later")
+
+	reporter.sendPending(context.Background())
+	require.Len(t, server.bodies, maxAttempts+1)
+	require.Empty(t, queuedReports(t, reporter))
+}
+
+func TestReportOutcome_SendQueuedReportsAfterRestart(t *testing.T) {
+	server := newStubEndpoint(t)
+	dir := t.TempDir()
+	server.failures = maxAttempts
+	reporter := newTestReporterInDir(t, server.URL, "", dir)
+	reporter.ReportOutcome(testOutcome(common.Address{0xaa}))
+	reporter.sendPending(context.Background())
+	require.Len(t, queuedReports(t, reporter), 1)
+
+	restarted := newTestReporterInDir(t, server.URL, "", dir)
+	restarted.sendPending(context.Background())
+	require.Len(t, server.bodies, maxAttempts+1)
+	require.Empty(t, queuedReports(t, restarted))
+}
+
+func TestReportOutcome_OnlyReportEachGameOnce(t *testing.T) {
+	server := newStubEndpoint(t)
+	dir := t.TempDir()
+	reporter := newTestReporterInDir(t, server.URL, "", dir)
+	outcome := testOutcome(common.Address{0xaa})
+	reporter.ReportOutcome(outcome)
+
+	duplicate := outcome
+	duplicate.Moves = 0
+	reporter.ReportOutcome(duplicate)
+	reporter.sendPending(context.Background())
+	require.Len(t, server.bodies, 1)
+	var received Outcome
+	require.NoError(t, json.Unmarshal(server.bodies[0], &received))
+	require.Equal(t, outcome.Moves, received.Moves, "should keep the first report")
+
+	restarted := newTestReporterInDir(t, server.URL, "", dir)
+	restarted.ReportOutcome(duplicate)
+	require.Empty(t, queuedReports(t, restarted), "should not report again after restart")
+	restarted.sendPending(context.Background())
+	require.Len(t, server.bodies, 1)
+
+	restarted.ReportOutcome(testOutcome(common.Address{0xbb}))
+	require.Len(t, queuedReports(t, restarted), 1, "should report other games")
+}
+
+func TestReportOutcome_SendsInBackground(t *testing.T) {
+	server := newStubEndpoint(t)
+	reporter := newTestReporter(t, server.URL, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter.Start(ctx)
+	reporter.ReportOutcome(testOutcome(common.Address{0xaa}))
+	require.Eventually(t, func() bool {
+		return server.count() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestSign(t *testing.T) {
+	// Expected value from: echo -n 'body' | openssl dgst -sha256 -hmac 'secret'
+	require.Equal(t, "dc46983557fea127b43af721467eb9b3fde2338fe3e14f51952aa8478c13d355", Sign([]byte("secret"), []byte("body")))
+}
+
+func testOutcome(game common.Address) Outcome {
+	return Outcome{
+		Game:       game,
+		RootClaim:  common.Hash{0x01},
+		Status:     types.GameStatusChallengerWon,
+		Winner:     "challenger",
+		Won:        true,
+		Moves:      3,
+		Steps:      1,
+		GasUsed:    500,
+		GasCost:    (*hexutil.Big)(big.NewInt(1000)),
+		Bonded:     (*hexutil.Big)(big.NewInt(2000)),
+		ResolvedAt: 1234,
+	}
+}
+
+func newTestReporter(t *testing.T, endpoint string, secret string) *Reporter {
+	return newTestReporterInDir(t, endpoint, secret, filepath.Join(t.TempDir(), "outcomes"))
+}
+
+func newTestReporterInDir(t *testing.T, endpoint string, secret string, dir string) *Reporter {
+	reporter, err := NewReporter(testlog.Logger(t, log.LvlInfo), clock.NewDeterministicClock(time.Unix(0, 0)), endpoint, secret, from, dir)
+	require.NoError(t, err)
+	reporter.strategy = retry.Fixed(0)
+	return reporter
+}
+
+func queuedReports(t *testing.T, reporter *Reporter) []os.DirEntry {
+	entries, err := os.ReadDir(reporter.dir)
+	require.NoError(t, err)
+	var reports []os.DirEntry
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			reports = append(reports, entry)
+		}
+	}
+	return reports
+}
+
+type stubEndpoint struct {
+	*httptest.Server
+	lock       sync.Mutex
+	failures   int
+	bodies     [][]byte
+	signatures []string
+}
+
+func newStubEndpoint(t *testing.T) *stubEndpoint {
+	endpoint := &stubEndpoint{}
+	endpoint.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		endpoint.lock.Lock()
+		defer endpoint.lock.Unlock()
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		endpoint.bodies = append(endpoint.bodies, body)
+		endpoint.signatures = append(endpoint.signatures, r.Header.Get(SignatureHeader))
+		if endpoint.failures > 0 {
+			endpoint.failures--
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(endpoint.Close)
+	return endpoint
+}
+
+func (s *stubEndpoint) count() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.bodies)
+}
@@ -0,0 +1,48 @@
+package fault
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// AvailableMemory returns the memory in bytes available for starting new processes, as reported by /proc/meminfo.
+func (systemResources) AvailableMemory() (uint64, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return parseMemAvailable(bufio.NewScanner(f))
+}
+
+func parseMemAvailable(scanner *bufio.Scanner) (uint64, error) {
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemAvailable:" {
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid MemAvailable value %q: %w", fields[1], err)
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errMemAvailableMissing
+}
+
+// FreeDisk returns the disk space in bytes available to unprivileged users on the filesystem containing dir.
+func (systemResources) FreeDisk(dir string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
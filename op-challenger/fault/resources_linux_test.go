@@ -0,0 +1,39 @@
+package fault
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemAvailable(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		meminfo := "MemTotal:       16303296 kB\nMemFree:          512000 kB\nMemAvailable:    8000000 kB\n"
+		mem, err := parseMemAvailable(bufio.NewScanner(strings.NewReader(meminfo)))
+		require.NoError(t, err)
+		require.Equal(t, uint64(8000000*1024), mem)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := parseMemAvailable(bufio.NewScanner(strings.NewReader("MemTotal: 16303296 kB\n")))
+		require.ErrorIs(t, err, errMemAvailableMissing)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := parseMemAvailable(bufio.NewScanner(strings.NewReader("MemAvailable: lots kB\n")))
+		require.Error(t, err)
+	})
+}
+
+func TestSystemResources(t *testing.T) {
+	resources := systemResources{}
+	require.Positive(t, resources.NumCPU())
+	mem, err := resources.AvailableMemory()
+	require.NoError(t, err)
+	require.Positive(t, mem)
+	disk, err := resources.FreeDisk(t.TempDir())
+	require.NoError(t, err)
+	require.Positive(t, disk)
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package fault
+
+// AvailableMemory is only supported on linux.
+func (systemResources) AvailableMemory() (uint64, error) {
+	return 0, errResourcesUnsupported
+}
+
+// FreeDisk is only supported on linux.
+func (systemResources) FreeDisk(_ string) (uint64, error) {
+	return 0, errResourcesUnsupported
+}
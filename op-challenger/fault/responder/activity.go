@@ -0,0 +1,124 @@
+package responder
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// activityFile is the file within a game's data directory that records the responder's activity and bonds.
+const activityFile = "activity.json"
+
+// Activity summarises the transactions the responder has sent to its game.
+type Activity struct {
+	Moves   uint64   // Number of successful attack and defend moves
+	Steps   uint64   // Number of successful steps
+	GasUsed uint64   // Total gas used by all included transactions, including reverted ones
+	GasCost *big.Int // Total fees in wei paid for all included transactions
+}
+
+// Totals are the activity and bonds of a responder, persisted by an [ActivityStore].
+type Totals struct {
+	Activity
+	Bonded *big.Int
+}
+
+// ActivityStore persists the totals of a responder so they are included in the game's outcome after the challenger
+// restarts.
+type ActivityStore interface {
+	Load() (Totals, error)
+	Save(totals Totals) error
+}
+
+// fileActivityStore stores the totals in a file in the game's data directory, so it is removed with the rest of the
+// game data once the game is resolved.
+type fileActivityStore struct {
+	path string
+}
+
+// NewFileActivityStore creates an [ActivityStore] that stores the totals in the game data directory dir.
+func NewFileActivityStore(dir string) ActivityStore {
+	return &fileActivityStore{path: filepath.Join(dir, activityFile)}
+}
+
+// Load returns the stored totals. Returns empty totals if none have been saved.
+func (f *fileActivityStore) Load() (Totals, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Totals{}, nil
+	} else if err != nil {
+		return Totals{}, err
+	}
+	var totals Totals
+	if err := json.Unmarshal(data, &totals); err != nil {
+		return Totals{}, err
+	}
+	return totals, nil
+}
+
+// Save replaces the stored totals.
+func (f *fileActivityStore) Save(totals Totals) error {
+	data, err := json.Marshal(totals)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, data, 0644)
+}
+
+// Activity returns the transactions sent by this responder.
+// Transactions sent before the challenger restarted are only included if an [ActivityStore] is configured.
+func (r *faultResponder) Activity() Activity {
+	return Activity{
+		Moves:   r.activity.Moves,
+		Steps:   r.activity.Steps,
+		GasUsed: r.activity.GasUsed,
+		GasCost: new(big.Int).Set(r.activity.GasCost),
+	}
+}
+
+func (r *faultResponder) recordGas(receipt *ethtypes.Receipt) {
+	r.activity.GasUsed += receipt.GasUsed
+	if receipt.EffectiveGasPrice != nil {
+		cost := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+		r.activity.GasCost.Add(r.activity.GasCost, cost)
+	}
+	r.saveTotals()
+}
+
+// loadTotals restores the totals saved before the challenger restarted, if an activity store is configured.
+func (r *faultResponder) loadTotals() {
+	if r.store == nil {
+		return
+	}
+	totals, err := r.store.Load()
+	if err != nil {
+		r.log.Warn("Failed to load responder activity, only including new transactions", "err", err)
+		return
+	}
+	r.activity.Moves = totals.Moves
+	r.activity.Steps = totals.Steps
+	r.activity.GasUsed = totals.GasUsed
+	if totals.GasCost != nil {
+		r.activity.GasCost = totals.GasCost
+	}
+	if totals.Bonded != nil {
+		r.bonded = totals.Bonded
+	}
+}
+
+// saveTotals persists the current totals, if an activity store is configured.
+func (r *faultResponder) saveTotals() {
+	if r.store == nil {
+		return
+	}
+	if err := r.store.Save(Totals{Activity: r.Activity(), Bonded: r.BondedValue()}); err != nil {
+		r.log.Warn("Failed to save responder activity", "err", err)
+	}
+}
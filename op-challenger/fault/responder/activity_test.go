@@ -0,0 +1,68 @@
+package responder
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivity(t *testing.T) {
+	responder, mockTxMgr := newTestFaultResponder(t)
+	require.Equal(t, Activity{GasCost: big.NewInt(0)}, responder.Activity())
+
+	mockTxMgr.gasUsed = 100
+	mockTxMgr.gasPrice = big.NewInt(3)
+	require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+	require.NoError(t, responder.Step(context.Background(), types.StepCallData{}))
+	require.NoError(t, responder.Resolve(context.Background()))
+
+	// Failed sends aren't included in the gas totals
+	mockTxMgr.sendFails = true
+	require.ErrorIs(t, responder.Respond(context.Background(), generateMockResponseClaim()), mockSendError)
+
+	activity := responder.Activity()
+	require.Equal(t, Activity{Moves: 1, Steps: 1, GasUsed: 300, GasCost: big.NewInt(900)}, activity)
+
+	activity.GasCost.SetUint64(1)
+	require.Equal(t, big.NewInt(900), responder.Activity().GasCost, "should return a copy of the gas cost")
+}
+
+func TestActivity_UnknownGasPrice(t *testing.T) {
+	responder, mockTxMgr := newTestFaultResponder(t)
+	mockTxMgr.gasUsed = 100
+	require.NoError(t, responder.Resolve(context.Background()))
+	require.Equal(t, Activity{GasUsed: 100, GasCost: big.NewInt(0)}, responder.Activity())
+}
+
+func TestActivity_RestoredAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	newResponder := func() (*faultResponder, *mockTxManager) {
+		mockTxMgr := &mockTxManager{}
+		responder, err := NewFaultResponder(testlog.Logger(t, log.LvlError), mockTxMgr, mockFdgAddress, metrics.NoopMetrics, ResponderOptions{Activity: NewFileActivityStore(dir)})
+		require.NoError(t, err)
+		return responder, mockTxMgr
+	}
+	responder, mockTxMgr := newResponder()
+	mockTxMgr.gasUsed = 100
+	mockTxMgr.gasPrice = big.NewInt(3)
+	mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], uint256(500), nil)
+	require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+	require.NoError(t, responder.Step(context.Background(), types.StepCallData{}))
+
+	restarted, _ := newResponder()
+	require.Equal(t, Activity{Moves: 1, Steps: 1, GasUsed: 200, GasCost: big.NewInt(600)}, restarted.Activity())
+	require.Equal(t, big.NewInt(500), restarted.BondedValue())
+}
+
+func TestActivity_NoStoredTotals(t *testing.T) {
+	responder, err := NewFaultResponder(testlog.Logger(t, log.LvlError), &mockTxManager{}, mockFdgAddress, metrics.NoopMetrics, ResponderOptions{Activity: NewFileActivityStore(t.TempDir())})
+	require.NoError(t, err)
+	require.Equal(t, Activity{GasCost: big.NewInt(0)}, responder.Activity())
+	require.Equal(t, big.NewInt(0), responder.BondedValue())
+}
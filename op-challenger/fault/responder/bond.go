@@ -0,0 +1,118 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var (
+	ErrBondExceedsMax = errors.New("required bond exceeds configured maximum")
+	// ErrRequiredBondUnsupported indicates the game contract doesn't provide the getRequiredBond method.
+	ErrRequiredBondUnsupported = errors.New("game contract does not report a required bond")
+)
+
+// requiredBondAbiJSON describes the getRequiredBond method of the FaultDisputeGame contract.
+// It is declared separately to the generated bindings as not all deployed versions of the contract provide it.
+const requiredBondAbiJSON = `[{
+	"type": "function",
+	"name": "getRequiredBond",
+	"stateMutability": "view",
+	"inputs": [{"name": "_position", "type": "uint128"}],
+	"outputs": [{"name": "requiredBond_", "type": "uint256"}]
+}]`
+
+const requiredBondMethod = "getRequiredBond"
+
+var requiredBondAbi = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(requiredBondAbiJSON))
+	if err != nil {
+		panic(fmt.Errorf("invalid getRequiredBond ABI: %w", err))
+	}
+	return parsed
+}()
+
+// BondMetricer records the value of bonds posted by the responder.
+type BondMetricer interface {
+	RecordBondPosted(amount *big.Int)
+}
+
+// RequiredBond queries the game contract for the bond required to post a claim at the specified position.
+// Returns [ErrRequiredBondUnsupported] if the contract doesn't provide the getRequiredBond method.
+func (r *faultResponder) RequiredBond(ctx context.Context, pos positions.Position) (*big.Int, error) {
+	txData, err := requiredBondAbi.Pack(requiredBondMethod, pos.ToGIndex())
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.txMgr.Call(ctx, ethereum.CallMsg{
+		To:   &r.fdgAddr,
+		Data: txData,
+	}, nil)
+	if isUnknownMethodRevert(err) {
+		return nil, fmt.Errorf("%w: %v", ErrRequiredBondUnsupported, err)
+	}
+	if err != nil {
+		return nil, r.decoder.Decode(err)
+	}
+	if len(res) == 0 {
+		// Calls to an account without code succeed without returning any data
+		return nil, ErrRequiredBondUnsupported
+	}
+	var bond *big.Int
+	if err := requiredBondAbi.UnpackIntoInterface(&bond, requiredBondMethod, res); err != nil {
+		return nil, fmt.Errorf("failed to unpack required bond: %w", err)
+	}
+	return bond, nil
+}
+
+// bondForMove determines the bond to attach to a move to the specified position.
+// If the game contract doesn't provide the getRequiredBond method, no bond is attached. Any other failure to load the
+// required bond is returned so the move is retried rather than sent without its bond.
+// Returns [ErrBondExceedsMax] if the required bond is greater than the configured maximum.
+func (r *faultResponder) bondForMove(ctx context.Context, pos positions.Position) (*big.Int, error) {
+	bond, err := r.RequiredBond(ctx, pos)
+	if errors.Is(err, ErrRequiredBondUnsupported) {
+		r.log.Debug("Game does not require a bond, sending without bond", "err", err)
+		return big.NewInt(0), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load required bond: %w", err)
+	}
+	if r.maxBond != nil && bond.Cmp(r.maxBond) > 0 {
+		return nil, fmt.Errorf("%w: required %v, max %v", ErrBondExceedsMax, bond, r.maxBond)
+	}
+	return bond, nil
+}
+
+// isUnknownMethodRevert returns true if err is a revert without any revert data, which is how contracts without a
+// fallback function reject calls to methods they don't provide.
+// Reverts from methods the contract does provide include the encoded custom error.
+func isUnknownMethodRevert(err error) bool {
+	if err == nil {
+		return false
+	}
+	if data, ok := revertData(err); ok {
+		return len(data) == 0
+	}
+	return strings.Contains(err.Error(), vm.ErrExecutionReverted.Error())
+}
+
+// BondedValue returns the total value in wei of bonds this responder has posted.
+// Bonds denominated in a token are included as if the token were worth the same as ETH, as is the case for WETH.
+func (r *faultResponder) BondedValue() *big.Int {
+	return new(big.Int).Set(r.bonded)
+}
+
+func (r *faultResponder) recordBond(bond *big.Int) {
+	if bond.Sign() <= 0 {
+		return
+	}
+	r.bonded.Add(r.bonded, bond)
+	r.metrics.RecordBondPosted(bond)
+}
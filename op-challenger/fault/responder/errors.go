@@ -0,0 +1,161 @@
+package responder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// DisputeGameFactory errors
+	ErrNoImplementation  = errors.New("no implementation for game type")
+	ErrGameAlreadyExists = errors.New("game already exists")
+
+	// FaultDisputeGame errors
+	ErrBondTooLow            = errors.New("bond too low")
+	ErrCannotDefendRootClaim = errors.New("cannot defend root claim")
+	ErrClaimAlreadyExists    = errors.New("claim already exists")
+	ErrInvalidClaim          = errors.New("invalid claim")
+	ErrGameNotInProgress     = errors.New("game not in progress")
+	ErrClockTimeExceeded     = errors.New("clock time exceeded")
+	ErrClockNotExpired       = errors.New("clock not expired")
+	ErrGameDepthExceeded     = errors.New("game depth exceeded")
+	ErrInvalidParent         = errors.New("invalid parent")
+	ErrInvalidPrestate       = errors.New("invalid prestate")
+	ErrValidStep             = errors.New("valid step")
+	ErrL1HeadTooOld          = errors.New("l1 head too old")
+	ErrInvalidLocalIdent     = errors.New("invalid local ident")
+)
+
+// knownErrors maps the name of each custom error declared in DisputeErrors.sol to its typed Go error.
+var knownErrors = map[string]error{
+	"NoImplementation":      ErrNoImplementation,
+	"GameAlreadyExists":     ErrGameAlreadyExists,
+	"BondTooLow":            ErrBondTooLow,
+	"CannotDefendRootClaim": ErrCannotDefendRootClaim,
+	"ClaimAlreadyExists":    ErrClaimAlreadyExists,
+	"InvalidClaim":          ErrInvalidClaim,
+	"GameNotInProgress":     ErrGameNotInProgress,
+	"ClockTimeExceeded":     ErrClockTimeExceeded,
+	"ClockNotExpired":       ErrClockNotExpired,
+	"GameDepthExceeded":     ErrGameDepthExceeded,
+	"InvalidParent":         ErrInvalidParent,
+	"InvalidPrestate":       ErrInvalidPrestate,
+	"ValidStep":             ErrValidStep,
+	"L1HeadTooOld":          ErrL1HeadTooOld,
+	"InvalidLocalIdent":     ErrInvalidLocalIdent,
+}
+
+// ContractError is a custom error reverted by a dispute game contract.
+// It unwraps to both the typed Go error for the revert (e.g. [ErrClaimAlreadyExists])
+// and the original error returned by the RPC call.
+type ContractError struct {
+	Name  string
+	Args  []interface{}
+	typed error
+	cause error
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("contract reverted with %v%v: %v", e.Name, e.Args, e.cause)
+}
+
+func (e *ContractError) Unwrap() []error {
+	return []error{e.typed, e.cause}
+}
+
+// RevertDecoder maps custom errors reverted by the FaultDisputeGame and DisputeGameFactory
+// contracts to typed Go errors.
+type RevertDecoder struct {
+	errs []abi.Error
+}
+
+// NewRevertDecoder creates a [RevertDecoder] for the errors declared in the
+// FaultDisputeGame and DisputeGameFactory ABIs.
+func NewRevertDecoder() (*RevertDecoder, error) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	factoryAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var errs []abi.Error
+	for _, contractAbi := range []*abi.ABI{fdgAbi, factoryAbi} {
+		for name, abiErr := range contractAbi.Errors {
+			errs = append(errs, abiErr)
+			seen[name] = true
+		}
+	}
+	// Not every error in DisputeErrors.sol is included in the contract ABIs yet.
+	// Add any that are missing so they can still be recognised.
+	for name := range knownErrors {
+		if !seen[name] {
+			errs = append(errs, abi.NewError(name, abi.Arguments{}))
+		}
+	}
+	return &RevertDecoder{errs: errs}, nil
+}
+
+// Decode returns a [ContractError] wrapping err if err contains revert data for a known custom error.
+// Otherwise err is returned unmodified.
+func (d *RevertDecoder) Decode(err error) error {
+	if err == nil {
+		return nil
+	}
+	data, ok := revertData(err)
+	if !ok || len(data) < 4 {
+		return err
+	}
+	for _, abiErr := range d.errs {
+		if !bytes.Equal(abiErr.ID[:4], data[:4]) {
+			continue
+		}
+		typed, ok := knownErrors[abiErr.Name]
+		if !ok {
+			return err
+		}
+		args, unpackErr := abiErr.Inputs.Unpack(data[4:])
+		if unpackErr != nil {
+			args = nil
+		}
+		return &ContractError{
+			Name:  abiErr.Name,
+			Args:  args,
+			typed: typed,
+			cause: err,
+		}
+	}
+	return err
+}
+
+// revertData extracts the revert data from an RPC error, if present.
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	switch data := dataErr.ErrorData().(type) {
+	case string:
+		if !strings.HasPrefix(data, "0x") {
+			return nil, false
+		}
+		decoded, err := hexutil.Decode(data)
+		if err != nil {
+			return nil, false
+		}
+		return decoded, true
+	case []byte:
+		return data, true
+	default:
+		return nil, false
+	}
+}
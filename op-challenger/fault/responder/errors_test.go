@@ -0,0 +1,83 @@
+package responder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDataError struct {
+	data interface{}
+}
+
+func (e *mockDataError) Error() string {
+	return "execution reverted"
+}
+
+func (e *mockDataError) ErrorData() interface{} {
+	return e.data
+}
+
+func newRevert(sig string, args ...byte) error {
+	data := append(crypto.Keccak256([]byte(sig))[:4], args...)
+	return &mockDataError{data: hexutil.Encode(data)}
+}
+
+func TestRevertDecoder(t *testing.T) {
+	decoder, err := NewRevertDecoder()
+	require.NoError(t, err)
+
+	t.Run("Nil", func(t *testing.T) {
+		require.NoError(t, decoder.Decode(nil))
+	})
+
+	t.Run("NoRevertData", func(t *testing.T) {
+		orig := errors.New("boom")
+		require.Same(t, orig, decoder.Decode(orig))
+	})
+
+	t.Run("UnknownSelector", func(t *testing.T) {
+		orig := newRevert("SomethingElse()")
+		require.Same(t, orig, decoder.Decode(orig))
+	})
+
+	t.Run("InvalidHex", func(t *testing.T) {
+		orig := &mockDataError{data: "not hex"}
+		require.Same(t, orig, decoder.Decode(orig))
+	})
+
+	tests := map[string]error{
+		"ClaimAlreadyExists()":    ErrClaimAlreadyExists,
+		"GameNotInProgress()":     ErrGameNotInProgress,
+		"CannotDefendRootClaim()": ErrCannotDefendRootClaim,
+		"ClockTimeExceeded()":     ErrClockTimeExceeded,
+		"ClockNotExpired()":       ErrClockNotExpired,
+		"BondTooLow()":            ErrBondTooLow,
+	}
+	for sig, expected := range tests {
+		sig := sig
+		expected := expected
+		t.Run(sig, func(t *testing.T) {
+			orig := newRevert(sig)
+			wrapped := fmt.Errorf("failed to estimate gas: %w", orig)
+			err := decoder.Decode(wrapped)
+			require.ErrorIs(t, err, expected)
+			require.ErrorIs(t, err, orig)
+		})
+	}
+
+	t.Run("WithArgs", func(t *testing.T) {
+		uuid := common.Hash{0xaa}
+		err := decoder.Decode(newRevert("GameAlreadyExists(bytes32)", uuid[:]...))
+		require.ErrorIs(t, err, ErrGameAlreadyExists)
+		var contractErr *ContractError
+		require.ErrorAs(t, err, &contractErr)
+		require.Equal(t, "GameAlreadyExists", contractErr.Name)
+		require.Equal(t, []interface{}{[32]byte(uuid)}, contractErr.Args)
+	})
+}
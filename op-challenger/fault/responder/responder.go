@@ -2,9 +2,14 @@ package responder
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"strconv"
+	"sync/atomic"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
@@ -12,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -19,24 +25,75 @@ import (
 type faultResponder struct {
 	log log.Logger
 
-	txMgr txmgr.TxManager
+	txMgr   txmgr.TxManager
+	metrics BondMetricer
 
 	fdgAddr common.Address
 	fdgAbi  *abi.ABI
+	decoder *RevertDecoder
+
+	maxBond *big.Int
+	bonded  *big.Int
+	pending atomic.Int64
+
+	activity Activity
+
+	urgency *UrgencyPolicy
+	stuck   *StuckTxMonitor
+
+	token    *common.Address
+	decimals *uint8
+	tokens   TokenTracker
+
+	audit audit.Recorder
+	store ActivityStore
+}
+
+// ResponderOptions are the optional settings and services of a [faultResponder]. Any may be left unset.
+type ResponderOptions struct {
+	// MaxBond rejects moves requiring a larger bond. If nil, no limit is applied.
+	MaxBond *big.Int
+	// Urgency sets fees based on the urgency of each transaction. If nil, normal fees are used for all transactions.
+	Urgency *UrgencyPolicy
+	// Stuck tracks moves and steps sent with a clock deadline. If nil, stuck transactions are not escalated.
+	Stuck *StuckTxMonitor
+	// Tokens is told the token bonds are denominated in, if any. May be nil.
+	Tokens TokenTracker
+	// AuditLog records each transaction sent. If nil, no audit records are kept.
+	AuditLog audit.Recorder
+	// Activity persists the responder's activity and bonds across restarts. If nil, they are only tracked in memory.
+	Activity ActivityStore
 }
 
 // NewFaultResponder returns a new [faultResponder].
-func NewFaultResponder(logger log.Logger, txManagr txmgr.TxManager, fdgAddr common.Address) (*faultResponder, error) {
+func NewFaultResponder(logger log.Logger, txManagr txmgr.TxManager, fdgAddr common.Address, m BondMetricer, opts ResponderOptions) (*faultResponder, error) {
 	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	return &faultResponder{
+	decoder, err := NewRevertDecoder()
+	if err != nil {
+		return nil, err
+	}
+	r := &faultResponder{
 		log:     logger,
 		txMgr:   txManagr,
+		metrics: m,
 		fdgAddr: fdgAddr,
 		fdgAbi:  fdgAbi,
-	}, nil
+		decoder: decoder,
+		maxBond: opts.MaxBond,
+		bonded:  big.NewInt(0),
+		urgency: opts.Urgency,
+		stuck:   opts.Stuck,
+		tokens:  opts.Tokens,
+		audit:   opts.AuditLog,
+		store:   opts.Activity,
+
+		activity: Activity{GasCost: big.NewInt(0)},
+	}
+	r.loadTotals()
+	return r, nil
 }
 
 // buildFaultDefendData creates the transaction data for the Defend function.
@@ -91,7 +148,7 @@ func (r *faultResponder) CallResolve(ctx context.Context) (types.GameStatus, err
 		Data: txData,
 	}, nil)
 	if err != nil {
-		return types.GameStatusInProgress, err
+		return types.GameStatusInProgress, r.decoder.Decode(err)
 	}
 	var status uint8
 	if err = r.fdgAbi.UnpackIntoInterface(&status, "resolve", res); err != nil {
@@ -107,35 +164,140 @@ func (r *faultResponder) Resolve(ctx context.Context) error {
 		return err
 	}
 
-	return r.sendTxAndWait(ctx, txData)
+	receipt, err := r.sendTxAndWait(ctx, txData, nil, r.urgency.ResolutionUrgency())
+	r.recordAudit(audit.ActionResolve, receipt, err, nil)
+	return err
 }
 
 // Respond takes a [Claim] and executes the response action.
+// The bond required by the game contract is attached to the transaction. If bonds are denominated in a token, the
+// game contract is approved to transfer the bond instead.
+// If an identical claim has already been posted by another party the response is skipped.
 func (r *faultResponder) Respond(ctx context.Context, response types.Claim) error {
 	txData, err := r.BuildTx(ctx, response)
 	if err != nil {
 		return err
 	}
-	return r.sendTxAndWait(ctx, txData)
+	bond, err := r.bondForMove(ctx, response.Position)
+	if err != nil {
+		return err
+	}
+	value := bond
+	bonded := bond
+	if bond.Sign() > 0 {
+		token, err := r.BondToken(ctx)
+		if err != nil {
+			return err
+		}
+		if token != (common.Address{}) {
+			decimals, err := r.tokenDecimals(ctx, token)
+			if err != nil {
+				return err
+			}
+			if err := r.approveBond(ctx, token, bond); err != nil {
+				return fmt.Errorf("failed to approve bond: %w", err)
+			}
+			value = nil
+			bonded = toWei(bond, decimals)
+		}
+	}
+	receipt, err := r.sendTxAndWait(ctx, txData, value, r.urgency.MoveUrgency())
+	details := map[string]string{
+		"parentIndex": strconv.Itoa(response.ParentContractIndex),
+		"claim":       response.Value.Hex(),
+		"position":    response.Position.ToGIndex().String(),
+		"attack":      strconv.FormatBool(!response.DefendsParent()),
+		"bond":        bond.String(),
+	}
+	if errors.Is(err, ErrClaimAlreadyExists) {
+		r.log.Info("Skipping response, claim already exists", "depth", response.Depth(), "index_at_depth", response.IndexAtDepth())
+		r.recordAudit(audit.ActionMoveSkipped, nil, nil, details)
+		return nil
+	}
+	r.recordAudit(audit.ActionMove, receipt, err, details)
+	if err != nil {
+		return err
+	}
+	if receipt.Status == ethtypes.ReceiptStatusSuccessful {
+		r.recordBond(bonded)
+		r.activity.Moves++
+		r.saveTotals()
+	}
+	return nil
 }
 
 // sendTxAndWait sends a transaction through the [txmgr] and waits for a receipt.
 // This sets the tx GasLimit to 0, performing gas estimation online through the [txmgr].
-func (r *faultResponder) sendTxAndWait(ctx context.Context, txData []byte) error {
-	receipt, err := r.txMgr.Send(ctx, txmgr.TxCandidate{
-		To:       &r.fdgAddr,
+// Custom errors reverted by the contract during gas estimation are decoded to typed errors.
+// The value, if not nil, is sent with the transaction. Fees are set based on the urgency of the transaction.
+// If ctx carries a clock deadline, the transaction is tracked until it is included and escalated if it is stuck.
+func (r *faultResponder) sendTxAndWait(ctx context.Context, txData []byte, value *big.Int, urgency Urgency) (*ethtypes.Receipt, error) {
+	return r.sendTo(ctx, r.fdgAddr, txData, value, urgency)
+}
+
+// sendTo sends a transaction to the contract at addr as described by [faultResponder.sendTxAndWait].
+func (r *faultResponder) sendTo(ctx context.Context, addr common.Address, txData []byte, value *big.Int, urgency Urgency) (*ethtypes.Receipt, error) {
+	r.pending.Add(1)
+	defer r.pending.Add(-1)
+	candidate := txmgr.TxCandidate{
+		To:       &addr,
 		TxData:   txData,
 		GasLimit: 0,
-	})
+		Value:    value,
+	}
+	urgency.applyFees(&candidate)
+	if deadline, ok := ClockDeadline(ctx); ok && r.stuck != nil {
+		tracked := r.stuck.Track(r.fdgAddr, deadline)
+		defer tracked.Done()
+		candidate.Escalator = tracked
+	}
+	r.log.Debug("Sending responder tx", "urgency", urgency)
+	receipt, err := r.txMgr.Send(ctx, candidate)
 	if err != nil {
-		return err
+		return nil, r.decoder.Decode(err)
 	}
+	r.recordGas(receipt)
 	if receipt.Status == ethtypes.ReceiptStatusFailed {
 		r.log.Error("Responder tx successfully published but reverted", "tx_hash", receipt.TxHash)
 	} else {
 		r.log.Debug("Responder tx successfully published", "tx_hash", receipt.TxHash)
 	}
-	return nil
+	return receipt, nil
+}
+
+// recordAudit records the outcome of a transaction sent for action in the audit log, if enabled.
+// The receipt is nil if the transaction was not sent, in which case err describes why.
+func (r *faultResponder) recordAudit(action string, receipt *ethtypes.Receipt, err error, details map[string]string) {
+	if r.audit == nil {
+		return
+	}
+	entry := audit.Entry{
+		Action:  action,
+		Game:    r.fdgAddr,
+		Details: details,
+	}
+	switch {
+	case err != nil:
+		entry.Status = audit.StatusFailed
+		if entry.Details == nil {
+			entry.Details = make(map[string]string)
+		}
+		entry.Details["error"] = err.Error()
+	case receipt == nil:
+	case receipt.Status == ethtypes.ReceiptStatusSuccessful:
+		entry.Status = audit.StatusSuccess
+	default:
+		entry.Status = audit.StatusReverted
+	}
+	if receipt != nil {
+		entry.TxHash = &receipt.TxHash
+	}
+	r.audit.Record(entry)
+}
+
+// PendingMoves returns the number of transactions that have been sent but not yet included.
+func (r *faultResponder) PendingMoves() uint64 {
+	return uint64(r.pending.Load())
 }
 
 // buildStepTxData creates the transaction data for the step function.
@@ -155,5 +317,19 @@ func (r *faultResponder) Step(ctx context.Context, stepData types.StepCallData)
 	if err != nil {
 		return err
 	}
-	return r.sendTxAndWait(ctx, txData)
+	receipt, err := r.sendTxAndWait(ctx, txData, nil, r.urgency.MoveUrgency())
+	r.recordAudit(audit.ActionStep, receipt, err, map[string]string{
+		"claimIndex": strconv.FormatUint(stepData.ClaimIndex, 10),
+		"attack":     strconv.FormatBool(stepData.IsAttack),
+		"stateHash":  crypto.Keccak256Hash(stepData.StateData).Hex(),
+		"proofHash":  crypto.Keccak256Hash(stepData.Proof).Hex(),
+	})
+	if err != nil {
+		return err
+	}
+	if receipt.Status == ethtypes.ReceiptStatusSuccessful {
+		r.activity.Steps++
+		r.saveTotals()
+	}
+	return nil
 }
@@ -5,13 +5,20 @@ import (
 	"errors"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -72,6 +79,23 @@ func TestResolve(t *testing.T) {
 	})
 }
 
+// TestPendingMoves tests that transactions are counted as pending until the [txmgr] returns.
+func TestPendingMoves(t *testing.T) {
+	responder, mockTxMgr := newTestFaultResponder(t)
+	require.Zero(t, responder.PendingMoves())
+	var pendingDuringSend uint64
+	mockTxMgr.onSend = func() {
+		pendingDuringSend = responder.PendingMoves()
+	}
+	require.NoError(t, responder.Resolve(context.Background()))
+	require.Equal(t, uint64(1), pendingDuringSend)
+	require.Zero(t, responder.PendingMoves())
+
+	mockTxMgr.sendFails = true
+	require.ErrorIs(t, responder.Resolve(context.Background()), mockSendError)
+	require.Zero(t, responder.PendingMoves())
+}
+
 // TestRespond tests the [Responder.Respond] method.
 func TestRespond(t *testing.T) {
 	t.Run("send fails", func(t *testing.T) {
@@ -88,6 +112,240 @@ func TestRespond(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 1, mockTxMgr.sends)
 	})
+
+	t.Run("attaches required bond", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], uint256(500), nil)
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.NoError(t, err)
+		require.Equal(t, 1, mockTxMgr.sends)
+		require.Equal(t, big.NewInt(500), mockTxMgr.sentValue)
+		require.Equal(t, big.NewInt(500), responder.BondedValue())
+	})
+
+	t.Run("rejects bond above max", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], uint256(1001), nil)
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, ErrBondExceedsMax)
+		require.Equal(t, 0, mockTxMgr.sends)
+		require.Equal(t, big.NewInt(0), responder.BondedValue())
+	})
+
+	t.Run("no bond when method returns no data", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], nil, nil)
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.NoError(t, err)
+		require.Equal(t, 1, mockTxMgr.sends)
+		require.Equal(t, big.NewInt(0), mockTxMgr.sentValue)
+	})
+
+	t.Run("no bond when method not provided", func(t *testing.T) {
+		for _, revert := range []error{errors.New("execution reverted"), &mockDataError{data: "0x"}} {
+			responder, mockTxMgr := newTestFaultResponder(t)
+			mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], nil, revert)
+			err := responder.Respond(context.Background(), generateMockResponseClaim())
+			require.NoError(t, err)
+			require.Equal(t, 1, mockTxMgr.sends)
+			require.Equal(t, big.NewInt(0), mockTxMgr.sentValue)
+		}
+	})
+
+	t.Run("retries when required bond unavailable", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.callFails = true
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, mockCallError)
+		require.Equal(t, 0, mockTxMgr.sends)
+	})
+
+	t.Run("retries when required bond reverts", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], nil, newRevert("GameNotInProgress()"))
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, ErrGameNotInProgress)
+		require.Equal(t, 0, mockTxMgr.sends)
+	})
+
+	t.Run("skips existing claim", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.sendErr = newRevert("ClaimAlreadyExists()")
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.NoError(t, err)
+	})
+
+	t.Run("decodes revert", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.sendErr = newRevert("GameNotInProgress()")
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, ErrGameNotInProgress)
+	})
+}
+
+// TestAuditLog tests that each transaction sent is recorded in the audit log.
+func TestAuditLog(t *testing.T) {
+	setup := func(t *testing.T) (*faultResponder, *mockTxManager, *stubAuditRecorder) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		recorder := &stubAuditRecorder{}
+		responder.audit = recorder
+		return responder, mockTxMgr, recorder
+	}
+
+	t.Run("Move", func(t *testing.T) {
+		responder, mockTxMgr, recorder := setup(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], uint256(500), nil)
+		claim := generateMockResponseClaim()
+		require.NoError(t, responder.Respond(context.Background(), claim))
+		require.Len(t, recorder.entries, 1)
+		entry := recorder.entries[0]
+		require.Equal(t, audit.ActionMove, entry.Action)
+		require.Equal(t, mockFdgAddress, entry.Game)
+		require.Equal(t, audit.StatusSuccess, entry.Status)
+		require.NotNil(t, entry.TxHash)
+		require.Equal(t, map[string]string{
+			"parentIndex": "0",
+			"claim":       claim.Value.Hex(),
+			"position":    "2",
+			"attack":      "true",
+			"bond":        "500",
+		}, entry.Details)
+	})
+
+	t.Run("SkippedMove", func(t *testing.T) {
+		responder, mockTxMgr, recorder := setup(t)
+		mockTxMgr.sendErr = newRevert("ClaimAlreadyExists()")
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Len(t, recorder.entries, 1)
+		require.Equal(t, audit.ActionMoveSkipped, recorder.entries[0].Action)
+		require.Nil(t, recorder.entries[0].TxHash)
+	})
+
+	t.Run("FailedStep", func(t *testing.T) {
+		responder, mockTxMgr, recorder := setup(t)
+		mockTxMgr.sendFails = true
+		err := responder.Step(context.Background(), types.StepCallData{ClaimIndex: 3, IsAttack: true, StateData: []byte{1}, Proof: []byte{2}})
+		require.ErrorIs(t, err, mockSendError)
+		require.Len(t, recorder.entries, 1)
+		entry := recorder.entries[0]
+		require.Equal(t, audit.ActionStep, entry.Action)
+		require.Equal(t, audit.StatusFailed, entry.Status)
+		require.Nil(t, entry.TxHash)
+		require.Equal(t, "3", entry.Details["claimIndex"])
+		require.Equal(t, "true", entry.Details["attack"])
+		require.Equal(t, mockSendError.Error(), entry.Details["error"])
+	})
+
+	t.Run("RevertedResolve", func(t *testing.T) {
+		responder, mockTxMgr, recorder := setup(t)
+		mockTxMgr.reverts = true
+		require.NoError(t, responder.Resolve(context.Background()))
+		require.Len(t, recorder.entries, 1)
+		require.Equal(t, audit.ActionResolve, recorder.entries[0].Action)
+		require.Equal(t, audit.StatusReverted, recorder.entries[0].Status)
+	})
+}
+
+type stubAuditRecorder struct {
+	entries []audit.Entry
+}
+
+func (s *stubAuditRecorder) Record(entry audit.Entry) {
+	s.entries = append(s.entries, entry)
+}
+
+// TestUrgencyFees tests that transactions are sent with the fee settings for their urgency.
+func TestUrgencyFees(t *testing.T) {
+	deadline := time.Unix(10_000, 0)
+	setup := func(t *testing.T, now time.Time) (*faultResponder, *mockTxManager) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		responder.urgency = NewUrgencyPolicy(clock.NewDeterministicClock(now), uint64(deadline.Unix()), time.Hour, 2*time.Hour, nil)
+		return responder, mockTxMgr
+	}
+
+	t.Run("NormalMove", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, deadline.Add(-2*time.Hour))
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Zero(t, mockTxMgr.sent.FeeMultiplier)
+		require.Zero(t, mockTxMgr.sent.FeeLimitMultiplier)
+		require.Zero(t, mockTxMgr.sent.ResubmissionTimeout)
+	})
+
+	t.Run("UrgentMove", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, deadline.Add(-30*time.Minute))
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Equal(t, uint64(200), mockTxMgr.sent.FeeMultiplier)
+		require.Equal(t, uint64(10), mockTxMgr.sent.FeeLimitMultiplier)
+		require.Equal(t, 12*time.Second, mockTxMgr.sent.ResubmissionTimeout)
+	})
+
+	t.Run("UrgentStep", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, deadline.Add(-30*time.Minute))
+		require.NoError(t, responder.Step(context.Background(), types.StepCallData{}))
+		require.Equal(t, uint64(200), mockTxMgr.sent.FeeMultiplier)
+	})
+
+	t.Run("EconomicalResolution", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, deadline.Add(time.Hour))
+		require.NoError(t, responder.Resolve(context.Background()))
+		require.Equal(t, uint64(100), mockTxMgr.sent.FeeMultiplier)
+		require.Equal(t, uint64(2), mockTxMgr.sent.FeeLimitMultiplier)
+		require.Equal(t, 5*time.Minute, mockTxMgr.sent.ResubmissionTimeout)
+	})
+
+	t.Run("DelayedResolution", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, deadline.Add(3*time.Hour))
+		require.NoError(t, responder.Resolve(context.Background()))
+		require.Zero(t, mockTxMgr.sent.FeeMultiplier)
+	})
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		require.NoError(t, responder.Resolve(context.Background()))
+		require.Zero(t, mockTxMgr.sent.FeeMultiplier)
+	})
+}
+
+// TestTrackStuckTxs tests that moves and steps with a clock deadline are tracked until they are included.
+func TestTrackStuckTxs(t *testing.T) {
+	setup := func(t *testing.T) (*faultResponder, *mockTxManager, *StuckTxMonitor) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		responder.stuck = NewStuckTxMonitor(testlog.Logger(t, log.LvlCrit), cl, &stubStuckTxMetrics{}, alert.NoopAlerter, 0.5, nil)
+		mockTxMgr.onSend = func() {
+			require.Len(t, responder.stuck.pending, 1, "should track tx while sending")
+		}
+		return responder, mockTxMgr, responder.stuck
+	}
+
+	t.Run("MoveWithDeadline", func(t *testing.T) {
+		responder, mockTxMgr, monitor := setup(t)
+		ctx := WithClockDeadline(context.Background(), time.Unix(2000, 0))
+		require.NoError(t, responder.Respond(ctx, generateMockResponseClaim()))
+		require.IsType(t, &TrackedTx{}, mockTxMgr.sent.Escalator)
+		require.Empty(t, monitor.pending, "should stop tracking once sent")
+	})
+
+	t.Run("StepWithDeadline", func(t *testing.T) {
+		responder, mockTxMgr, _ := setup(t)
+		ctx := WithClockDeadline(context.Background(), time.Unix(2000, 0))
+		require.NoError(t, responder.Step(ctx, types.StepCallData{}))
+		require.IsType(t, &TrackedTx{}, mockTxMgr.sent.Escalator)
+	})
+
+	t.Run("NoDeadline", func(t *testing.T) {
+		responder, mockTxMgr, _ := setup(t)
+		mockTxMgr.onSend = nil
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Nil(t, mockTxMgr.sent.Escalator)
+	})
+
+	t.Run("NoMonitor", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		ctx := WithClockDeadline(context.Background(), time.Unix(2000, 0))
+		require.NoError(t, responder.Respond(ctx, generateMockResponseClaim()))
+		require.Nil(t, mockTxMgr.sent.Escalator)
+	})
 }
 
 // TestBuildTx tests the [Responder.BuildTx] method.
@@ -115,11 +373,11 @@ func TestBuildTx(t *testing.T) {
 		responseClaim := types.Claim{
 			ClaimData: types.ClaimData{
 				Value:    common.Hash{0x01},
-				Position: types.NewPositionFromGIndex(3),
+				Position: positions.NewPositionFromGIndex(big.NewInt(3)),
 			},
 			Parent: types.ClaimData{
 				Value:    common.Hash{0x02},
-				Position: types.NewPositionFromGIndex(6),
+				Position: positions.NewPositionFromGIndex(big.NewInt(6)),
 			},
 			ContractIndex:       0,
 			ParentContractIndex: 7,
@@ -142,7 +400,7 @@ func TestBuildTx(t *testing.T) {
 func newTestFaultResponder(t *testing.T) (*faultResponder, *mockTxManager) {
 	log := testlog.Logger(t, log.LvlError)
 	mockTxMgr := &mockTxManager{}
-	responder, err := NewFaultResponder(log, mockTxMgr, mockFdgAddress)
+	responder, err := NewFaultResponder(log, mockTxMgr, mockFdgAddress, metrics.NoopMetrics, ResponderOptions{MaxBond: big.NewInt(1000)})
 	require.NoError(t, err)
 	return responder, mockTxMgr
 }
@@ -152,23 +410,69 @@ type mockTxManager struct {
 	sends     int
 	calls     int
 	sendFails bool
+	sendErr   error
+	reverts   bool
 	callFails bool
 	callBytes []byte
+	sentValue *big.Int
+	sent      txmgr.TxCandidate
+	sentTxs   []txmgr.TxCandidate
+	onSend    func()
+	gasUsed   uint64
+	gasPrice  *big.Int
+
+	// methodResults overrides the result of calls to specific methods, keyed by method selector
+	methodResults map[[4]byte]mockCallResult
+}
+
+type mockCallResult struct {
+	res []byte
+	err error
+}
+
+// returns sets the result of calls to method, regardless of the contract called.
+func (m *mockTxManager) returns(method abi.Method, res []byte, err error) {
+	if m.methodResults == nil {
+		m.methodResults = make(map[[4]byte]mockCallResult)
+	}
+	m.methodResults[[4]byte(method.ID)] = mockCallResult{res: res, err: err}
+}
+
+func uint256(v int64) []byte {
+	return common.LeftPadBytes(big.NewInt(v).Bytes(), 32)
 }
 
 func (m *mockTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*ethtypes.Receipt, error) {
 	if m.sendFails {
 		return nil, mockSendError
 	}
+	if m.sendErr != nil {
+		return nil, m.sendErr
+	}
+	if m.onSend != nil {
+		m.onSend()
+	}
 	m.sends++
-	return ethtypes.NewReceipt(
+	m.sentValue = candidate.Value
+	m.sent = candidate
+	m.sentTxs = append(m.sentTxs, candidate)
+	receipt := ethtypes.NewReceipt(
 		[]byte{},
-		false,
+		m.reverts,
 		0,
-	), nil
+	)
+	receipt.GasUsed = m.gasUsed
+	receipt.EffectiveGasPrice = m.gasPrice
+	return receipt, nil
 }
 
-func (m *mockTxManager) Call(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+func (m *mockTxManager) Call(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if len(msg.Data) >= 4 {
+		if result, ok := m.methodResults[[4]byte(msg.Data[:4])]; ok {
+			m.calls++
+			return result.res, result.err
+		}
+	}
 	if m.callFails {
 		return nil, mockCallError
 	}
@@ -193,11 +497,11 @@ func generateMockResponseClaim() types.Claim {
 	return types.Claim{
 		ClaimData: types.ClaimData{
 			Value:    common.Hash{0x01},
-			Position: types.NewPositionFromGIndex(2),
+			Position: positions.NewPositionFromGIndex(big.NewInt(2)),
 		},
 		Parent: types.ClaimData{
 			Value:    common.Hash{0x02},
-			Position: types.NewPositionFromGIndex(1),
+			Position: positions.NewPositionFromGIndex(big.NewInt(1)),
 		},
 		ContractIndex:       0,
 		ParentContractIndex: 0,
@@ -0,0 +1,267 @@
+package responder
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Fee settings used for stuck transactions, well beyond those of urgent transactions.
+	stuckFeeMultiplier       = 300
+	stuckFeeLimitMultiplier  = 20
+	stuckResubmissionTimeout = 12 * time.Second
+
+	// stuckAlertFraction is the fraction of the time between a transaction becoming stuck and the deadline after
+	// which an alert is raised.
+	stuckAlertFraction = 0.5
+	// rebroadcastTimeout is the time allowed for every alternate endpoint to accept a stuck transaction.
+	rebroadcastTimeout = 10 * time.Second
+)
+
+// TxBroadcaster publishes signed transactions through an alternate RPC endpoint.
+type TxBroadcaster interface {
+	SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error
+}
+
+// StuckTxMetricer records the state of stuck transactions.
+type StuckTxMetricer interface {
+	RecordStuckTxs(count int, oldest time.Duration)
+	RecordStuckTxAlert()
+}
+
+type clockDeadlineKey struct{}
+
+// WithClockDeadline returns a copy of ctx carrying the time the clock to counter the claim being responded to expires.
+// Moves and steps sent with the context are tracked until they are included and escalated if they are stuck.
+func WithClockDeadline(ctx context.Context, deadline time.Time) context.Context {
+	return context.WithValue(ctx, clockDeadlineKey{}, deadline)
+}
+
+// ClockDeadline returns the clock deadline carried by ctx, if any.
+func ClockDeadline(ctx context.Context) (time.Time, bool) {
+	deadline, ok := ctx.Value(clockDeadlineKey{}).(time.Time)
+	return deadline, ok
+}
+
+// StuckTxMonitor tracks the moves and steps sent by the responders of all games until they are included.
+// A transaction is stuck once it has been pending for more than stuckFraction of the time that remained on the claim's
+// clock when it was sent. Stuck transactions are escalated by resubmitting them with fees beyond the normal limits and
+// rebroadcasting them through the alternate endpoints. If a stuck transaction is still pending halfway between becoming
+// stuck and the clock deadline, an alert is raised.
+type StuckTxMonitor struct {
+	logger        log.Logger
+	clock         clock.Clock
+	metrics       StuckTxMetricer
+	alerter       alert.Alerter
+	stuckFraction float64
+	broadcasters  []TxBroadcaster
+
+	mu      sync.Mutex
+	pending map[*TrackedTx]struct{}
+
+	// rebroadcasts tracks the rebroadcasts running in the background.
+	rebroadcasts sync.WaitGroup
+}
+
+// NewStuckTxMonitor creates a new [StuckTxMonitor]. broadcasters may be empty, in which case stuck transactions are
+// only escalated through the transaction manager's own endpoint.
+func NewStuckTxMonitor(logger log.Logger, cl clock.Clock, m StuckTxMetricer, alerter alert.Alerter, stuckFraction float64, broadcasters []TxBroadcaster) *StuckTxMonitor {
+	return &StuckTxMonitor{
+		logger:        logger.New("component", "stuck-tx"),
+		clock:         cl,
+		metrics:       m,
+		alerter:       alerter,
+		stuckFraction: stuckFraction,
+		broadcasters:  broadcasters,
+		pending:       make(map[*TrackedTx]struct{}),
+	}
+}
+
+// Track starts tracking a transaction for the game that must be included before deadline.
+// The returned [TrackedTx] must be used as the escalator of the transaction and Done called once sending completes.
+func (s *StuckTxMonitor) Track(game common.Address, deadline time.Time) *TrackedTx {
+	now := s.clock.Now()
+	window := deadline.Sub(now)
+	if window < 0 {
+		window = 0
+	}
+	stuckAfter := time.Duration(float64(window) * s.stuckFraction)
+	tracked := &TrackedTx{
+		monitor:  s,
+		game:     game,
+		sentAt:   now,
+		deadline: deadline,
+		stuckAt:  now.Add(stuckAfter),
+		alertAt:  now.Add(stuckAfter + time.Duration(float64(window-stuckAfter)*stuckAlertFraction)),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[tracked] = struct{}{}
+	return tracked
+}
+
+// recordMetrics records the number of stuck transactions and the age of the oldest. Must be called with mu held.
+func (s *StuckTxMonitor) recordMetrics() {
+	now := s.clock.Now()
+	count := 0
+	var oldest time.Duration
+	for tracked := range s.pending {
+		if !tracked.stuck {
+			continue
+		}
+		count++
+		if age := now.Sub(tracked.sentAt); age > oldest {
+			oldest = age
+		}
+	}
+	s.metrics.RecordStuckTxs(count, oldest)
+}
+
+// rebroadcast publishes the transaction through each of the alternate endpoints in the background, so slow endpoints
+// don't delay the transaction manager's resubmissions. Endpoints are called in parallel and share a single timeout.
+func (s *StuckTxMonitor) rebroadcast(ctx context.Context, game common.Address, tx *ethtypes.Transaction) {
+	if len(s.broadcasters) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, rebroadcastTimeout)
+	var wg sync.WaitGroup
+	for i, broadcaster := range s.broadcasters {
+		wg.Add(1)
+		s.rebroadcasts.Add(1)
+		go func(i int, broadcaster TxBroadcaster) {
+			defer s.rebroadcasts.Done()
+			defer wg.Done()
+			s.rebroadcastTo(ctx, i, broadcaster, game, tx)
+		}(i, broadcaster)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+}
+
+// rebroadcastTo publishes the transaction through the alternate endpoint at index i.
+func (s *StuckTxMonitor) rebroadcastTo(ctx context.Context, i int, broadcaster TxBroadcaster, game common.Address, tx *ethtypes.Transaction) {
+	// Identify endpoints by index rather than URL to avoid logging any credentials in the URL
+	log := s.logger.New("game", game, "tx", tx.Hash(), "endpoint", i)
+	err := broadcaster.SendTransaction(ctx, tx)
+	if err == nil {
+		log.Info("Rebroadcast stuck transaction")
+	} else if strings.Contains(err.Error(), txpool.ErrAlreadyKnown.Error()) {
+		log.Debug("Stuck transaction already known to endpoint")
+	} else {
+		log.Warn("Failed to rebroadcast stuck transaction", "err", err)
+	}
+}
+
+// alertStuck raises an alert that the tracked transaction is still stuck close to its deadline.
+func (s *StuckTxMonitor) alertStuck(t *TrackedTx, latest *ethtypes.Transaction, now time.Time) {
+	details := map[string]string{
+		"game":      t.game.Hex(),
+		"pending":   now.Sub(t.sentAt).String(),
+		"remaining": t.deadline.Sub(now).String(),
+	}
+	key := fmt.Sprintf("%v:%v", t.game, t.sentAt.UnixNano())
+	if latest != nil {
+		details["tx"] = latest.Hash().Hex()
+		details["nonce"] = strconv.FormatUint(latest.Nonce(), 10)
+		key = fmt.Sprintf("%v:%v", t.game, latest.Nonce())
+	}
+	s.alerter.Alert(alert.Alert{
+		Kind:    alert.KindStuckTx,
+		Key:     key,
+		Summary: fmt.Sprintf("Transaction for game %v stuck close to its clock deadline", t.game),
+		Details: details,
+	})
+}
+
+// TrackedTx is a transaction tracked by a [StuckTxMonitor]. It implements [txmgr.Escalator].
+type TrackedTx struct {
+	monitor  *StuckTxMonitor
+	game     common.Address
+	sentAt   time.Time
+	deadline time.Time
+	stuckAt  time.Time
+	alertAt  time.Time
+
+	// Guarded by the monitor's mutex
+	latest  *ethtypes.Transaction
+	stuck   bool
+	alerted bool
+}
+
+var _ txmgr.Escalator = (*TrackedTx)(nil)
+
+// Publishing records the latest version of the transaction, rebroadcasting it if the transaction is stuck.
+func (t *TrackedTx) Publishing(ctx context.Context, tx *ethtypes.Transaction) {
+	t.monitor.mu.Lock()
+	t.latest = tx
+	stuck := t.stuck
+	t.monitor.mu.Unlock()
+	if stuck {
+		t.monitor.rebroadcast(ctx, t.game, tx)
+	}
+}
+
+// Escalate returns the fee settings for stuck transactions once the transaction is stuck and raises an alert if it
+// remains pending too close to the deadline.
+func (t *TrackedTx) Escalate(ctx context.Context) txmgr.FeeOverride {
+	m := t.monitor
+	now := m.clock.Now()
+	if now.Before(t.stuckAt) {
+		return txmgr.FeeOverride{}
+	}
+	m.mu.Lock()
+	newlyStuck := !t.stuck
+	t.stuck = true
+	alert := !t.alerted && !now.Before(t.alertAt)
+	t.alerted = t.alerted || alert
+	latest := t.latest
+	m.recordMetrics()
+	m.mu.Unlock()
+
+	log := m.logger.New("game", t.game, "pending", now.Sub(t.sentAt), "deadline", t.deadline)
+	if latest != nil {
+		log = log.New("tx", latest.Hash(), "nonce", latest.Nonce())
+	}
+	if newlyStuck {
+		log.Warn("Transaction stuck, escalating fees and rebroadcasting")
+		if latest != nil {
+			m.rebroadcast(ctx, t.game, latest)
+		}
+	}
+	if alert {
+		log.Error("Stuck transaction still not included after escalation", "remaining", t.deadline.Sub(now))
+		m.metrics.RecordStuckTxAlert()
+		m.alertStuck(t, latest, now)
+	}
+	return txmgr.FeeOverride{
+		FeeMultiplier:       stuckFeeMultiplier,
+		FeeLimitMultiplier:  stuckFeeLimitMultiplier,
+		ResubmissionTimeout: stuckResubmissionTimeout,
+	}
+}
+
+// Done stops tracking the transaction.
+func (t *TrackedTx) Done() {
+	m := t.monitor
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pending, t)
+	if t.stuck {
+		m.logger.Info("Stopped tracking stuck transaction", "game", t.game, "pending", m.clock.Now().Sub(t.sentAt))
+	}
+	m.recordMetrics()
+}
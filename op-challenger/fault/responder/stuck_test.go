@@ -0,0 +1,211 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockDeadline(t *testing.T) {
+	_, ok := ClockDeadline(context.Background())
+	require.False(t, ok)
+
+	deadline := time.Unix(1000, 0)
+	actual, ok := ClockDeadline(WithClockDeadline(context.Background(), deadline))
+	require.True(t, ok)
+	require.Equal(t, deadline, actual)
+}
+
+func TestStuckTxMonitor(t *testing.T) {
+	game := common.Address{0xaa}
+	start := time.Unix(10_000, 0)
+	// 1000s remaining on the clock, so the tx is stuck after 500s and alerts after 750s
+	deadline := start.Add(1000 * time.Second)
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{Nonce: 4, GasFeeCap: big.NewInt(10)})
+
+	setup := func(t *testing.T, broadcasters ...TxBroadcaster) (*StuckTxMonitor, *clock.DeterministicClock, *stubStuckTxMetrics) {
+		logger := testlog.Logger(t, log.LvlCrit)
+		cl := clock.NewDeterministicClock(start)
+		m := &stubStuckTxMetrics{}
+		return NewStuckTxMonitor(logger, cl, m, alert.NoopAlerter, 0.5, broadcasters), cl, m
+	}
+
+	t.Run("NotStuckBeforeThreshold", func(t *testing.T) {
+		broadcaster := &stubBroadcaster{}
+		monitor, cl, m := setup(t, broadcaster)
+		tracked := monitor.Track(game, deadline)
+		tracked.Publishing(context.Background(), tx)
+		cl.AdvanceTime(499 * time.Second)
+		require.Equal(t, txmgr.FeeOverride{}, tracked.Escalate(context.Background()))
+		monitor.rebroadcasts.Wait()
+		require.Zero(t, broadcaster.Sent())
+		require.Zero(t, m.stuck)
+	})
+
+	t.Run("EscalateOnceStuck", func(t *testing.T) {
+		broadcaster := &stubBroadcaster{}
+		monitor, cl, m := setup(t, broadcaster)
+		tracked := monitor.Track(game, deadline)
+		tracked.Publishing(context.Background(), tx)
+		cl.AdvanceTime(500 * time.Second)
+		override := tracked.Escalate(context.Background())
+		monitor.rebroadcasts.Wait()
+		require.Equal(t, txmgr.FeeOverride{
+			FeeMultiplier:       stuckFeeMultiplier,
+			FeeLimitMultiplier:  stuckFeeLimitMultiplier,
+			ResubmissionTimeout: stuckResubmissionTimeout,
+		}, override)
+		require.Equal(t, 1, broadcaster.Sent(), "should rebroadcast latest tx once stuck")
+		require.Equal(t, 1, m.stuck)
+		require.Equal(t, 500*time.Second, m.oldest)
+		require.Zero(t, m.alerts)
+
+		// Only rebroadcast when first stuck, after that each newly published version is rebroadcast
+		tracked.Escalate(context.Background())
+		monitor.rebroadcasts.Wait()
+		require.Equal(t, 1, broadcaster.Sent())
+		tracked.Publishing(context.Background(), tx)
+		monitor.rebroadcasts.Wait()
+		require.Equal(t, 2, broadcaster.Sent())
+	})
+
+	t.Run("AlertOnce", func(t *testing.T) {
+		monitor, cl, m := setup(t)
+		alerter := &stubAlerter{}
+		monitor.alerter = alerter
+		tracked := monitor.Track(game, deadline)
+		tracked.Publishing(context.Background(), tx)
+		cl.AdvanceTime(749 * time.Second)
+		tracked.Escalate(context.Background())
+		require.Zero(t, m.alerts)
+		require.Empty(t, alerter.alerts)
+
+		cl.AdvanceTime(time.Second)
+		tracked.Escalate(context.Background())
+		require.Equal(t, 1, m.alerts)
+		require.Len(t, alerter.alerts, 1)
+		require.Equal(t, alert.KindStuckTx, alerter.alerts[0].Kind)
+		require.Equal(t, game.String()+":4", alerter.alerts[0].Key)
+		require.Equal(t, tx.Hash().Hex(), alerter.alerts[0].Details["tx"])
+
+		cl.AdvanceTime(time.Second)
+		tracked.Escalate(context.Background())
+		require.Equal(t, 1, m.alerts)
+		require.Len(t, alerter.alerts, 1)
+	})
+
+	t.Run("IgnoreRebroadcastErrors", func(t *testing.T) {
+		known := &stubBroadcaster{err: txpool.ErrAlreadyKnown}
+		failing := &stubBroadcaster{err: errors.New("boom")}
+		ok := &stubBroadcaster{}
+		monitor, cl, _ := setup(t, known, failing, ok)
+		tracked := monitor.Track(game, deadline)
+		tracked.Publishing(context.Background(), tx)
+		cl.AdvanceTime(500 * time.Second)
+		tracked.Escalate(context.Background())
+		monitor.rebroadcasts.Wait()
+		require.Equal(t, 1, known.Sent())
+		require.Equal(t, 1, failing.Sent())
+		require.Equal(t, 1, ok.Sent())
+	})
+
+	t.Run("DoNotWaitForRebroadcasts", func(t *testing.T) {
+		slow := &stubBroadcaster{block: true}
+		ok := &stubBroadcaster{}
+		monitor, cl, _ := setup(t, slow, ok)
+		tracked := monitor.Track(game, deadline)
+		tracked.Publishing(context.Background(), tx)
+		cl.AdvanceTime(500 * time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		// Returns while the slow endpoint is still sending
+		tracked.Escalate(ctx)
+		cancel()
+		monitor.rebroadcasts.Wait()
+		require.Equal(t, 1, slow.Sent())
+		require.Equal(t, 1, ok.Sent())
+	})
+
+	t.Run("DeadlinePassed", func(t *testing.T) {
+		monitor, _, m := setup(t)
+		tracked := monitor.Track(game, start.Add(-time.Second))
+		tracked.Escalate(context.Background())
+		require.Equal(t, 1, m.stuck)
+		require.Equal(t, 1, m.alerts)
+	})
+
+	t.Run("Done", func(t *testing.T) {
+		monitor, cl, m := setup(t)
+		tracked := monitor.Track(game, deadline)
+		other := monitor.Track(game, deadline.Add(time.Hour))
+		cl.AdvanceTime(600 * time.Second)
+		tracked.Escalate(context.Background())
+		require.Equal(t, 1, m.stuck)
+
+		tracked.Done()
+		require.Zero(t, m.stuck)
+		require.Zero(t, m.oldest)
+
+		other.Done()
+		require.Empty(t, monitor.pending)
+	})
+}
+
+type stubBroadcaster struct {
+	mu    sync.Mutex
+	sent  int
+	err   error
+	block bool
+}
+
+func (s *stubBroadcaster) SendTransaction(ctx context.Context, _ *ethtypes.Transaction) error {
+	s.mu.Lock()
+	s.sent++
+	s.mu.Unlock()
+	if s.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return s.err
+}
+
+func (s *stubBroadcaster) Sent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sent
+}
+
+type stubStuckTxMetrics struct {
+	stuck  int
+	oldest time.Duration
+	alerts int
+}
+
+func (s *stubStuckTxMetrics) RecordStuckTxs(count int, oldest time.Duration) {
+	s.stuck = count
+	s.oldest = oldest
+}
+
+func (s *stubStuckTxMetrics) RecordStuckTxAlert() {
+	s.alerts++
+}
+
+type stubAlerter struct {
+	alerts []alert.Alert
+}
+
+func (s *stubAlerter) Alert(a alert.Alert) {
+	s.alerts = append(s.alerts, a)
+}
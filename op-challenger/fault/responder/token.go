@@ -0,0 +1,230 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	ErrApprovalReverted    = errors.New("bond approval reverted")
+	ErrClaimCreditReverted = errors.New("claim credit reverted")
+)
+
+// bondTokenAbiJSON describes the methods of FaultDisputeGame contracts with bonds denominated in an ERC-20 token,
+// such as a DelayedWETH deployment. Bonds are transferred from the claimant when a move is made and paid out as
+// credit that must be claimed once the game is resolved.
+// It is declared separately to the generated bindings as games with bonds in ETH don't provide these methods.
+const bondTokenAbiJSON = `[{
+	"type": "function",
+	"name": "bondToken",
+	"stateMutability": "view",
+	"inputs": [],
+	"outputs": [{"name": "bondToken_", "type": "address"}]
+}, {
+	"type": "function",
+	"name": "credit",
+	"stateMutability": "view",
+	"inputs": [{"name": "_recipient", "type": "address"}],
+	"outputs": [{"name": "credit_", "type": "uint256"}]
+}, {
+	"type": "function",
+	"name": "claimCredit",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "_recipient", "type": "address"}],
+	"outputs": []
+}]`
+
+const (
+	bondTokenMethod   = "bondToken"
+	creditMethod      = "credit"
+	claimCreditMethod = "claimCredit"
+
+	etherDecimals = 18
+)
+
+var bondTokenAbi = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(bondTokenAbiJSON))
+	if err != nil {
+		panic(fmt.Errorf("invalid bond token ABI: %w", err))
+	}
+	return parsed
+}()
+
+var erc20Abi = func() *abi.ABI {
+	parsed, err := bindings.ERC20MetaData.GetAbi()
+	if err != nil {
+		panic(fmt.Errorf("invalid ERC20 ABI: %w", err))
+	}
+	return parsed
+}()
+
+// TokenTracker is notified of each token that bonds are denominated in.
+type TokenTracker interface {
+	TrackToken(token common.Address)
+}
+
+// BondToken returns the ERC-20 token the game's bonds are denominated in, or the zero address if bonds are in ETH.
+// Games that don't report a bond token use ETH. The result is cached once the game contract has been queried.
+func (r *faultResponder) BondToken(ctx context.Context) (common.Address, error) {
+	if r.token != nil {
+		return *r.token, nil
+	}
+	var token common.Address
+	res, err := r.call(ctx, r.fdgAddr, &bondTokenAbi, bondTokenMethod)
+	if err == nil {
+		if err := bondTokenAbi.UnpackIntoInterface(&token, bondTokenMethod, res); err != nil {
+			// The contract doesn't implement the method but has a fallback function
+			token = common.Address{}
+		}
+	} else if !isRevert(err) {
+		return common.Address{}, fmt.Errorf("failed to load bond token: %w", err)
+	}
+	r.token = &token
+	if token != (common.Address{}) {
+		r.log.Info("Bonds are denominated in token", "token", token)
+		if r.tokens != nil {
+			r.tokens.TrackToken(token)
+		}
+	}
+	return token, nil
+}
+
+// tokenDecimals returns the number of decimals used by the bond token. The result is cached once loaded.
+func (r *faultResponder) tokenDecimals(ctx context.Context, token common.Address) (uint8, error) {
+	if r.decimals != nil {
+		return *r.decimals, nil
+	}
+	res, err := r.call(ctx, token, erc20Abi, "decimals")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load token decimals: %w", err)
+	}
+	var decimals uint8
+	if err := erc20Abi.UnpackIntoInterface(&decimals, "decimals", res); err != nil {
+		return 0, fmt.Errorf("failed to unpack token decimals: %w", err)
+	}
+	r.decimals = &decimals
+	return decimals, nil
+}
+
+// toWei scales an amount of a token with the given decimals to the 18 decimals used by ETH.
+func toWei(amount *big.Int, decimals uint8) *big.Int {
+	if decimals == etherDecimals {
+		return new(big.Int).Set(amount)
+	}
+	if decimals < etherDecimals {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(etherDecimals-decimals)), nil)
+		return new(big.Int).Mul(amount, scale)
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-etherDecimals)), nil)
+	return new(big.Int).Quo(amount, scale)
+}
+
+// approveBond ensures the game contract is allowed to transfer the bond from the challenger's account.
+// Only the amount of the bond is approved, so each move with a bond requires a separate approval.
+func (r *faultResponder) approveBond(ctx context.Context, token common.Address, bond *big.Int) error {
+	res, err := r.call(ctx, token, erc20Abi, "allowance", r.txMgr.From(), r.fdgAddr)
+	if err != nil {
+		return fmt.Errorf("failed to load allowance: %w", err)
+	}
+	var allowance *big.Int
+	if err := erc20Abi.UnpackIntoInterface(&allowance, "allowance", res); err != nil {
+		return fmt.Errorf("failed to unpack allowance: %w", err)
+	}
+	if allowance.Cmp(bond) >= 0 {
+		return nil
+	}
+	txData, err := erc20Abi.Pack("approve", r.fdgAddr, bond)
+	if err != nil {
+		return err
+	}
+	r.log.Debug("Approving bond", "token", token, "amount", bond)
+	receipt, err := r.sendTo(ctx, token, txData, nil, r.urgency.MoveUrgency())
+	r.recordAudit(audit.ActionApprove, receipt, err, map[string]string{
+		"token":  token.Hex(),
+		"amount": bond.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("%w: %v", ErrApprovalReverted, receipt.TxHash)
+	}
+	return nil
+}
+
+// ClaimCredit claims the bonds paid out to the challenger by a resolved game with bonds in an ERC-20 token.
+// Games with bonds in ETH pay out bonds when resolved so there is nothing to claim.
+func (r *faultResponder) ClaimCredit(ctx context.Context) error {
+	token, err := r.BondToken(ctx)
+	if err != nil {
+		return err
+	}
+	if token == (common.Address{}) {
+		return nil
+	}
+	from := r.txMgr.From()
+	res, err := r.call(ctx, r.fdgAddr, &bondTokenAbi, creditMethod, from)
+	if err != nil {
+		return fmt.Errorf("failed to load credit: %w", err)
+	}
+	var credit *big.Int
+	if err := bondTokenAbi.UnpackIntoInterface(&credit, creditMethod, res); err != nil {
+		return fmt.Errorf("failed to unpack credit: %w", err)
+	}
+	if credit.Sign() == 0 {
+		return nil
+	}
+	txData, err := bondTokenAbi.Pack(claimCreditMethod, from)
+	if err != nil {
+		return err
+	}
+	receipt, err := r.sendTxAndWait(ctx, txData, nil, r.urgency.ResolutionUrgency())
+	r.recordAudit(audit.ActionClaimCredit, receipt, err, map[string]string{
+		"token":  token.Hex(),
+		"amount": credit.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("%w: %v", ErrClaimCreditReverted, receipt.TxHash)
+	}
+	r.log.Info("Claimed bond credit", "token", token, "amount", credit)
+	return nil
+}
+
+// call packs and sends a call to the method of the contract at addr, decoding any revert.
+func (r *faultResponder) call(ctx context.Context, addr common.Address, contractAbi *abi.ABI, method string, args ...interface{}) ([]byte, error) {
+	txData, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.txMgr.Call(ctx, ethereum.CallMsg{
+		To:   &addr,
+		Data: txData,
+	}, nil)
+	if err != nil {
+		return nil, r.decoder.Decode(err)
+	}
+	return res, nil
+}
+
+// isRevert returns true if err reports that a call reverted rather than that the contract couldn't be reached.
+func isRevert(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == 3 {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
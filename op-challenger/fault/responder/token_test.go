@@ -0,0 +1,173 @@
+package responder
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var mockTokenAddress = common.HexToAddress("0x5678")
+
+func TestBondToken(t *testing.T) {
+	t.Run("ETH", func(t *testing.T) {
+		responder, _ := newTestFaultResponder(t)
+		tracker := &stubTokenTracker{}
+		responder.tokens = tracker
+		token, err := responder.BondToken(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, common.Address{}, token)
+		require.Empty(t, tracker.tokens)
+	})
+
+	t.Run("Token", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		tracker := &stubTokenTracker{}
+		responder.tokens = tracker
+		mockTxMgr.returns(bondTokenAbi.Methods[bondTokenMethod], common.LeftPadBytes(mockTokenAddress.Bytes(), 32), nil)
+		token, err := responder.BondToken(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, mockTokenAddress, token)
+		require.Equal(t, []common.Address{mockTokenAddress}, tracker.tokens)
+
+		// Result is cached
+		token, err = responder.BondToken(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, mockTokenAddress, token)
+		require.Equal(t, 1, mockTxMgr.calls)
+		require.Len(t, tracker.tokens, 1)
+	})
+
+	t.Run("NotSupported", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(bondTokenAbi.Methods[bondTokenMethod], nil, newRevert("Unknown()"))
+		token, err := responder.BondToken(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, common.Address{}, token)
+
+		_, err = responder.BondToken(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, mockTxMgr.calls, "should cache unsupported result")
+	})
+
+	t.Run("CallFails", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(bondTokenAbi.Methods[bondTokenMethod], nil, mockCallError)
+		_, err := responder.BondToken(context.Background())
+		require.ErrorIs(t, err, mockCallError)
+
+		_, err = responder.BondToken(context.Background())
+		require.ErrorIs(t, err, mockCallError)
+		require.Equal(t, 2, mockTxMgr.calls, "should retry after failure")
+	})
+}
+
+func TestRespondWithTokenBond(t *testing.T) {
+	setup := func(t *testing.T, allowance int64) (*faultResponder, *mockTxManager) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.returns(requiredBondAbi.Methods[requiredBondMethod], uint256(500), nil)
+		mockTxMgr.returns(bondTokenAbi.Methods[bondTokenMethod], common.LeftPadBytes(mockTokenAddress.Bytes(), 32), nil)
+		mockTxMgr.returns(erc20Abi.Methods["allowance"], uint256(allowance), nil)
+		mockTxMgr.returns(erc20Abi.Methods["decimals"], uint256(18), nil)
+		return responder, mockTxMgr
+	}
+
+	t.Run("AlreadyApproved", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 500)
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Len(t, mockTxMgr.sentTxs, 1)
+		require.Equal(t, mockFdgAddress, *mockTxMgr.sent.To)
+		require.Nil(t, mockTxMgr.sentValue, "should not send ETH with token bond")
+		require.Equal(t, big.NewInt(500), responder.BondedValue(), "should include token bonds in bonded value")
+	})
+
+	t.Run("ScaleBondedValueToTokenDecimals", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 500)
+		mockTxMgr.returns(erc20Abi.Methods["decimals"], uint256(6), nil)
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Equal(t, big.NewInt(500_000_000_000_000), responder.BondedValue())
+	})
+
+	t.Run("DecimalsFail", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 500)
+		mockTxMgr.returns(erc20Abi.Methods["decimals"], nil, mockCallError)
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, mockCallError)
+		require.Empty(t, mockTxMgr.sentTxs)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 499)
+		require.NoError(t, responder.Respond(context.Background(), generateMockResponseClaim()))
+		require.Len(t, mockTxMgr.sentTxs, 2)
+		approval := mockTxMgr.sentTxs[0]
+		require.Equal(t, mockTokenAddress, *approval.To)
+		expected, err := erc20Abi.Pack("approve", mockFdgAddress, big.NewInt(500))
+		require.NoError(t, err)
+		require.Equal(t, expected, approval.TxData)
+		require.Equal(t, mockFdgAddress, *mockTxMgr.sentTxs[1].To)
+	})
+
+	t.Run("ApprovalReverted", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 0)
+		mockTxMgr.reverts = true
+		err := responder.Respond(context.Background(), generateMockResponseClaim())
+		require.ErrorIs(t, err, ErrApprovalReverted)
+		require.Len(t, mockTxMgr.sentTxs, 1, "should not send move")
+	})
+}
+
+func TestToWei(t *testing.T) {
+	require.Equal(t, big.NewInt(1_000_000_000_000_000_000), toWei(big.NewInt(1_000_000), 6))
+	require.Equal(t, big.NewInt(1_000), toWei(big.NewInt(1_000), 18))
+	require.Equal(t, big.NewInt(1), toWei(big.NewInt(1_000), 21))
+}
+
+func TestClaimCredit(t *testing.T) {
+	from := common.Address{0xaa}
+	setup := func(t *testing.T, credit int64) (*faultResponder, *mockTxManager) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		mockTxMgr.from = from
+		mockTxMgr.returns(bondTokenAbi.Methods[bondTokenMethod], common.LeftPadBytes(mockTokenAddress.Bytes(), 32), nil)
+		mockTxMgr.returns(bondTokenAbi.Methods[creditMethod], uint256(credit), nil)
+		return responder, mockTxMgr
+	}
+
+	t.Run("ETH", func(t *testing.T) {
+		responder, mockTxMgr := newTestFaultResponder(t)
+		require.NoError(t, responder.ClaimCredit(context.Background()))
+		require.Zero(t, mockTxMgr.sends)
+	})
+
+	t.Run("NoCredit", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 0)
+		require.NoError(t, responder.ClaimCredit(context.Background()))
+		require.Zero(t, mockTxMgr.sends)
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 1000)
+		require.NoError(t, responder.ClaimCredit(context.Background()))
+		require.Equal(t, 1, mockTxMgr.sends)
+		require.Equal(t, mockFdgAddress, *mockTxMgr.sent.To)
+		expected, err := bondTokenAbi.Pack(claimCreditMethod, from)
+		require.NoError(t, err)
+		require.Equal(t, expected, mockTxMgr.sent.TxData)
+	})
+
+	t.Run("Reverted", func(t *testing.T) {
+		responder, mockTxMgr := setup(t, 1000)
+		mockTxMgr.reverts = true
+		require.ErrorIs(t, responder.ClaimCredit(context.Background()), ErrClaimCreditReverted)
+	})
+}
+
+type stubTokenTracker struct {
+	tokens []common.Address
+}
+
+func (s *stubTokenTracker) TrackToken(token common.Address) {
+	s.tokens = append(s.tokens, token)
+}
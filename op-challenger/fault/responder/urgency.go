@@ -0,0 +1,88 @@
+package responder
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// Urgency determines the fee settings used to send a transaction.
+type Urgency string
+
+const (
+	// UrgencyEconomical transactions start at the suggested fees and are bumped slowly with a low fee limit.
+	UrgencyEconomical Urgency = "economical"
+	// UrgencyNormal transactions use the default txmgr fee settings.
+	UrgencyNormal Urgency = "normal"
+	// UrgencyUrgent transactions start above the suggested fees and are bumped every block with a high fee limit.
+	UrgencyUrgent Urgency = "urgent"
+)
+
+// applyFees sets the fee settings for the urgency on the candidate.
+func (u Urgency) applyFees(candidate *txmgr.TxCandidate) {
+	switch u {
+	case UrgencyEconomical:
+		candidate.FeeMultiplier = 100
+		candidate.FeeLimitMultiplier = 2
+		candidate.ResubmissionTimeout = 5 * time.Minute
+	case UrgencyUrgent:
+		candidate.FeeMultiplier = 200
+		candidate.FeeLimitMultiplier = 10
+		candidate.ResubmissionTimeout = 12 * time.Second
+	}
+}
+
+// AttackState reports whether the challenger is defending against a coordinated attack.
+type AttackState interface {
+	Active() bool
+}
+
+// UrgencyPolicy determines the urgency of transactions for a game based on the game's deadline.
+type UrgencyPolicy struct {
+	clock                      clock.Clock
+	deadline                   time.Time
+	urgentMoveWindow           time.Duration
+	economicalResolutionWindow time.Duration
+	attack                     AttackState
+}
+
+// NewUrgencyPolicy creates a new [UrgencyPolicy] for a game with the specified deadline as a unix timestamp.
+// Moves are urgent once the deadline is within urgentMoveWindow.
+// Resolutions are economical until economicalResolutionWindow after the deadline, then use normal fees.
+// A zero window disables urgent moves or economical resolutions respectively.
+// All moves are urgent while the attack state is active. The attack state may be nil.
+func NewUrgencyPolicy(cl clock.Clock, deadline uint64, urgentMoveWindow time.Duration, economicalResolutionWindow time.Duration, attack AttackState) *UrgencyPolicy {
+	return &UrgencyPolicy{
+		clock:                      cl,
+		deadline:                   time.Unix(int64(deadline), 0),
+		urgentMoveWindow:           urgentMoveWindow,
+		economicalResolutionWindow: economicalResolutionWindow,
+		attack:                     attack,
+	}
+}
+
+// MoveUrgency returns the urgency of moves and steps in the game.
+func (p *UrgencyPolicy) MoveUrgency() Urgency {
+	if p == nil {
+		return UrgencyNormal
+	}
+	if p.attack != nil && p.attack.Active() {
+		return UrgencyUrgent
+	}
+	if p.urgentMoveWindow > 0 && p.deadline.Sub(p.clock.Now()) < p.urgentMoveWindow {
+		return UrgencyUrgent
+	}
+	return UrgencyNormal
+}
+
+// ResolutionUrgency returns the urgency of resolving the game.
+func (p *UrgencyPolicy) ResolutionUrgency() Urgency {
+	if p == nil {
+		return UrgencyNormal
+	}
+	if p.economicalResolutionWindow > 0 && p.clock.Now().Sub(p.deadline) < p.economicalResolutionWindow {
+		return UrgencyEconomical
+	}
+	return UrgencyNormal
+}
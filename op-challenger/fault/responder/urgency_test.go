@@ -0,0 +1,59 @@
+package responder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrgencyPolicy(t *testing.T) {
+	deadline := time.Unix(10_000, 0)
+	cl := clock.NewDeterministicClock(deadline.Add(-2 * time.Hour))
+	policy := NewUrgencyPolicy(cl, uint64(deadline.Unix()), time.Hour, 30*time.Minute, nil)
+
+	require.Equal(t, UrgencyNormal, policy.MoveUrgency())
+	require.Equal(t, UrgencyEconomical, policy.ResolutionUrgency())
+
+	cl.AdvanceTime(time.Hour + time.Second)
+	require.Equal(t, UrgencyUrgent, policy.MoveUrgency(), "should be urgent within window of deadline")
+
+	cl.AdvanceTime(time.Hour + 30*time.Minute)
+	require.Equal(t, UrgencyUrgent, policy.MoveUrgency(), "should remain urgent after deadline")
+	require.Equal(t, UrgencyNormal, policy.ResolutionUrgency(), "should use normal fees once economical window has passed")
+}
+
+func TestUrgencyPolicyDisabled(t *testing.T) {
+	deadline := time.Unix(10_000, 0)
+	cl := clock.NewDeterministicClock(deadline.Add(-time.Minute))
+	policy := NewUrgencyPolicy(cl, uint64(deadline.Unix()), 0, 0, nil)
+	require.Equal(t, UrgencyNormal, policy.MoveUrgency())
+	require.Equal(t, UrgencyNormal, policy.ResolutionUrgency())
+}
+
+func TestUrgencyPolicyUnderAttack(t *testing.T) {
+	deadline := time.Unix(10_000, 0)
+	cl := clock.NewDeterministicClock(deadline.Add(-2 * time.Hour))
+	attack := &stubAttackState{}
+	policy := NewUrgencyPolicy(cl, uint64(deadline.Unix()), time.Hour, 30*time.Minute, attack)
+	require.Equal(t, UrgencyNormal, policy.MoveUrgency())
+
+	attack.active = true
+	require.Equal(t, UrgencyUrgent, policy.MoveUrgency(), "should be urgent while under attack")
+	require.Equal(t, UrgencyEconomical, policy.ResolutionUrgency(), "should not change resolution urgency")
+}
+
+type stubAttackState struct {
+	active bool
+}
+
+func (s *stubAttackState) Active() bool {
+	return s.active
+}
+
+func TestNilUrgencyPolicy(t *testing.T) {
+	var policy *UrgencyPolicy
+	require.Equal(t, UrgencyNormal, policy.MoveUrgency())
+	require.Equal(t, UrgencyNormal, policy.ResolutionUrgency())
+}
@@ -0,0 +1,121 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// RuntimeMode controls which actions the challenger may take.
+type RuntimeMode uint8
+
+const (
+	// RuntimeModeNormal allows all challenger actions.
+	RuntimeModeNormal RuntimeMode = iota
+	// RuntimeModeResolveOnly restricts the challenger to resolving games. No moves or steps are made.
+	RuntimeModeResolveOnly
+	// RuntimeModePaused stops the challenger from acting on any game.
+	RuntimeModePaused
+)
+
+func (m RuntimeMode) String() string {
+	switch m {
+	case RuntimeModeNormal:
+		return "normal"
+	case RuntimeModeResolveOnly:
+		return "resolve-only"
+	case RuntimeModePaused:
+		return "paused"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(m))
+	}
+}
+
+// runtimeConfigAbiJSON describes the runtime configuration contract read by the challenger each cycle.
+// mode returns a [RuntimeMode]. Unknown values are treated as [RuntimeModePaused].
+const runtimeConfigAbiJSON = `[{
+	"type": "function",
+	"name": "mode",
+	"stateMutability": "view",
+	"inputs": [],
+	"outputs": [{"name": "", "type": "uint8"}]
+}]`
+
+var runtimeConfigAbi = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(runtimeConfigAbiJSON))
+	if err != nil {
+		panic(fmt.Errorf("invalid runtime config ABI: %w", err))
+	}
+	return parsed
+}()
+
+type RuntimeModeMetricer interface {
+	RecordRuntimeMode(mode uint8)
+}
+
+// runtimeConfig reads the challenger's runtime mode from an on-chain contract, allowing operators to
+// pause a fleet of challengers or restrict them to resolution only.
+// If no contract address is configured the mode is always [RuntimeModeNormal].
+type runtimeConfig struct {
+	logger   log.Logger
+	metrics  RuntimeModeMetricer
+	contract *bind.BoundContract
+	mode     atomic.Uint32
+}
+
+func newRuntimeConfig(logger log.Logger, m RuntimeModeMetricer, addr common.Address, caller bind.ContractCaller) *runtimeConfig {
+	cfg := &runtimeConfig{
+		logger:  logger,
+		metrics: m,
+	}
+	if addr != (common.Address{}) {
+		cfg.contract = bind.NewBoundContract(addr, runtimeConfigAbi, caller, nil, nil)
+	}
+	return cfg
+}
+
+// Refresh loads the current mode from the runtime config contract at the specified block.
+// If the mode can't be loaded the previous mode is retained.
+func (c *runtimeConfig) Refresh(ctx context.Context, blockNum uint64) {
+	if c.contract == nil {
+		return
+	}
+	var out []interface{}
+	err := c.contract.Call(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNum)}, &out, "mode")
+	if err != nil {
+		c.logger.Error("Failed to load runtime mode, retaining previous mode", "mode", c.Mode(), "err", err)
+		return
+	}
+	mode := RuntimeMode(*abi.ConvertType(out[0], new(uint8)).(*uint8))
+	if mode > RuntimeModePaused {
+		c.logger.Warn("Unknown runtime mode, pausing", "mode", mode)
+		mode = RuntimeModePaused
+	}
+	if prev := RuntimeMode(c.mode.Swap(uint32(mode))); prev != mode {
+		c.logger.Warn("Runtime mode changed", "prev", prev, "mode", mode)
+	}
+	c.metrics.RecordRuntimeMode(uint8(mode))
+}
+
+// Mode returns the current runtime mode.
+func (c *runtimeConfig) Mode() RuntimeMode {
+	return RuntimeMode(c.mode.Load())
+}
+
+// Paused returns true if the challenger should not act on any game.
+func (c *runtimeConfig) Paused() bool {
+	return c.Mode() == RuntimeModePaused
+}
+
+// DeferClaim returns true unless the challenger is in [RuntimeModeNormal], preventing moves and steps.
+func (c *runtimeConfig) DeferClaim(_ types.Claim) bool {
+	return c.Mode() != RuntimeModeNormal
+}
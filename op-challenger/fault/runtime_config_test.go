@@ -0,0 +1,106 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var runtimeConfigAddr = common.Address{0xcc}
+
+func TestRuntimeConfig_NoAddress(t *testing.T) {
+	cfg, caller, m := setupRuntimeConfigTest(t, common.Address{})
+	caller.mode = uint8(RuntimeModePaused)
+	cfg.Refresh(context.Background(), 10)
+	require.Equal(t, RuntimeModeNormal, cfg.Mode())
+	require.False(t, cfg.Paused())
+	require.False(t, cfg.DeferClaim(types.Claim{}))
+	require.Zero(t, caller.calls)
+	require.Nil(t, m.modes)
+}
+
+func TestRuntimeConfig_Modes(t *testing.T) {
+	tests := []struct {
+		mode       uint8
+		expected   RuntimeMode
+		paused     bool
+		deferClaim bool
+	}{
+		{mode: 0, expected: RuntimeModeNormal, paused: false, deferClaim: false},
+		{mode: 1, expected: RuntimeModeResolveOnly, paused: false, deferClaim: true},
+		{mode: 2, expected: RuntimeModePaused, paused: true, deferClaim: true},
+		{mode: 3, expected: RuntimeModePaused, paused: true, deferClaim: true},
+		{mode: 255, expected: RuntimeModePaused, paused: true, deferClaim: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.expected.String(), func(t *testing.T) {
+			cfg, caller, m := setupRuntimeConfigTest(t, runtimeConfigAddr)
+			caller.mode = test.mode
+			cfg.Refresh(context.Background(), 10)
+			require.Equal(t, test.expected, cfg.Mode())
+			require.Equal(t, test.paused, cfg.Paused())
+			require.Equal(t, test.deferClaim, cfg.DeferClaim(types.Claim{}))
+			require.Equal(t, []uint8{uint8(test.expected)}, m.modes)
+			require.Equal(t, big.NewInt(10), caller.blockNum)
+			require.Equal(t, runtimeConfigAddr, caller.to)
+		})
+	}
+}
+
+func TestRuntimeConfig_RetainModeOnError(t *testing.T) {
+	cfg, caller, m := setupRuntimeConfigTest(t, runtimeConfigAddr)
+	caller.mode = uint8(RuntimeModeResolveOnly)
+	cfg.Refresh(context.Background(), 10)
+	require.Equal(t, RuntimeModeResolveOnly, cfg.Mode())
+
+	caller.err = errors.New("boom")
+	cfg.Refresh(context.Background(), 11)
+	require.Equal(t, RuntimeModeResolveOnly, cfg.Mode())
+	require.Equal(t, []uint8{uint8(RuntimeModeResolveOnly)}, m.modes)
+}
+
+func setupRuntimeConfigTest(t *testing.T, addr common.Address) (*runtimeConfig, *stubRuntimeConfigCaller, *stubRuntimeModeMetrics) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	caller := &stubRuntimeConfigCaller{}
+	m := &stubRuntimeModeMetrics{}
+	return newRuntimeConfig(logger, m, addr, caller), caller, m
+}
+
+type stubRuntimeConfigCaller struct {
+	mode     uint8
+	err      error
+	calls    int
+	to       common.Address
+	blockNum *big.Int
+}
+
+func (s *stubRuntimeConfigCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (s *stubRuntimeConfigCaller) CallContract(_ context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.to = *call.To
+	s.blockNum = blockNumber
+	return runtimeConfigAbi.Methods["mode"].Outputs.Pack(s.mode)
+}
+
+type stubRuntimeModeMetrics struct {
+	modes []uint8
+}
+
+func (s *stubRuntimeModeMetrics) RecordRuntimeMode(mode uint8) {
+	s.modes = append(s.modes, mode)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -14,10 +17,15 @@ var errUnknownGame = errors.New("unknown game")
 
 type PlayerCreator func(address common.Address, dir string) (GamePlayer, error)
 
+// WatchCreator creates a [WatchPlayer] for the game, or returns nil if the game requires a full player.
+type WatchCreator func(address common.Address) (WatchPlayer, error)
+
 type gameState struct {
 	player   GamePlayer
 	inflight bool
 	resolved bool
+	// lastScheduled is the update in which a job for this game was last scheduled.
+	lastScheduled uint64
 }
 
 // coordinator manages the set of current games, queues games to be played (on separate worker threads) and
@@ -31,9 +39,17 @@ type coordinator struct {
 	resultQueue <-chan job
 
 	logger       log.Logger
+	metrics      SchedulerMetricer
 	createPlayer PlayerCreator
+	createWatch  WatchCreator
 	states       map[common.Address]*gameState
 	disk         DiskManager
+
+	// maxGames is the maximum number of games to schedule in a single update. 0 means no limit.
+	maxGames int
+	// update counts the calls to schedule, used to prioritise games that haven't been progressed recently.
+	update     uint64
+	overloaded atomic.Bool
 }
 
 // schedule takes the current list of games to attempt to progress, filters out games that have previous
@@ -45,16 +61,26 @@ func (c *coordinator) schedule(ctx context.Context, games []common.Address) erro
 	// First remove any game states we no longer require
 	for addr, state := range c.states {
 		if !state.inflight && !slices.Contains(games, addr) {
+			c.closePlayer(addr, state)
 			delete(c.states, addr)
 		}
 	}
 
-	var errs []error
-	// Next collect all the jobs to schedule and ensure all games are recorded in the states map.
+	// Next ensure all games are recorded in the states map, including any that are shed.
 	// Otherwise, results may start being processed before all games are recorded, resulting in existing
 	// data directories potentially being deleted for games that are required.
-	var jobs []job
 	for _, addr := range games {
+		if _, ok := c.states[addr]; !ok {
+			c.states[addr] = &gameState{}
+		}
+	}
+	c.update++
+	toSchedule := c.selectGames(games)
+
+	var errs []error
+	// Then collect all the jobs to schedule
+	var jobs []job
+	for _, addr := range toSchedule {
 		if j, err := c.createJob(addr); err != nil {
 			errs = append(errs, err)
 		} else if j != nil {
@@ -69,6 +95,44 @@ func (c *coordinator) schedule(ctx context.Context, games []common.Address) erro
 	return errors.Join(errs...)
 }
 
+// selectGames returns the games to schedule in this update.
+// If more games require progressing than the configured maximum, the excess games are shed for this update.
+// Games that have already resolved are shed first, followed by the games that were most recently progressed so that
+// every game is eventually progressed while overloaded.
+func (c *coordinator) selectGames(games []common.Address) []common.Address {
+	if c.maxGames == 0 {
+		return games
+	}
+	var candidates []common.Address
+	for _, addr := range games {
+		if !c.states[addr].inflight {
+			candidates = append(candidates, addr)
+		}
+	}
+	shed := len(candidates) - c.maxGames
+	c.overloaded.Store(shed > 0)
+	c.metrics.RecordSchedulerOverloaded(shed > 0)
+	if shed <= 0 {
+		return candidates
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a := c.states[candidates[i]]
+		b := c.states[candidates[j]]
+		if a.resolved != b.resolved {
+			return !a.resolved
+		}
+		return a.lastScheduled < b.lastScheduled
+	})
+	c.logger.Warn("Scheduler overloaded, shedding games", "games", len(games), "scheduled", c.maxGames, "shed", shed)
+	c.metrics.RecordGamesShed(shed)
+	return candidates[:c.maxGames]
+}
+
+// Overloaded returns true if games were shed in the most recent update.
+func (c *coordinator) Overloaded() bool {
+	return c.overloaded.Load()
+}
+
 // createJob updates the state for the specified game and returns the job to enqueue for it, if any
 // Returns (nil, nil) when there is no error and no job to enqueue
 func (c *coordinator) createJob(game common.Address) (*job, error) {
@@ -81,8 +145,22 @@ func (c *coordinator) createJob(game common.Address) (*job, error) {
 		c.logger.Debug("Not rescheduling already in-flight game", "game", game)
 		return nil, nil
 	}
+	// Only create a full player if the game can't be watched, avoiding reserving disk space for it.
+	if state.player == nil && c.createWatch != nil {
+		watch, err := c.createWatch(game)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create watch player: %w", err)
+		}
+		if watch != nil {
+			state.player = watch
+		}
+	}
 	// Create the player separately to the state so we retry creating it if it fails on the first attempt.
 	if state.player == nil {
+		// Fail early rather than have trace generation run out of disk space part way through the game.
+		if err := c.disk.Reserve(game); err != nil {
+			return nil, fmt.Errorf("failed to reserve disk space: %w", err)
+		}
 		player, err := c.createPlayer(game, c.disk.DirForGame(game))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create game player: %w", err)
@@ -90,6 +168,7 @@ func (c *coordinator) createJob(game common.Address) (*job, error) {
 		state.player = player
 	}
 	state.inflight = true
+	state.lastScheduled = c.update
 	return &job{addr: game, player: state.player}, nil
 }
 
@@ -115,10 +194,27 @@ func (c *coordinator) processResult(j job) error {
 	}
 	state.inflight = false
 	state.resolved = j.resolved
+	if watch, ok := state.player.(WatchPlayer); ok && !j.resolved && watch.RequiresFullPlayer() {
+		c.logger.Info("Game requires a full player, replacing watch player", "game", j.addr)
+		c.closePlayer(j.addr, state)
+		// The full player is created when the game is next scheduled
+		state.player = nil
+	}
 	c.deleteResolvedGameFiles()
 	return nil
 }
 
+// closePlayer releases any resources held by the game's player, if it has been created.
+func (c *coordinator) closePlayer(addr common.Address, state *gameState) {
+	closer, ok := state.player.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		c.logger.Warn("Failed to close game player", "game", addr, "err", err)
+	}
+}
+
 func (c *coordinator) deleteResolvedGameFiles() {
 	var keepGames []common.Address
 	for addr, state := range c.states {
@@ -131,12 +227,15 @@ func (c *coordinator) deleteResolvedGameFiles() {
 	}
 }
 
-func newCoordinator(logger log.Logger, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager) *coordinator {
+func newCoordinator(logger log.Logger, m SchedulerMetricer, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, createWatch WatchCreator, disk DiskManager, maxGames int) *coordinator {
 	return &coordinator{
 		logger:       logger,
+		metrics:      m,
+		maxGames:     maxGames,
 		jobQueue:     jobQueue,
 		resultQueue:  resultQueue,
 		createPlayer: createPlayer,
+		createWatch:  createWatch,
 		disk:         disk,
 		states:       make(map[common.Address]*gameState),
 	}
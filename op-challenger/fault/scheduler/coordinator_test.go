@@ -196,7 +196,7 @@ func TestDoNotDeleteDataForGameThatFailedToCreatePlayer(t *testing.T) {
 }
 
 func TestDropOldGameStates(t *testing.T) {
-	c, workQueue, _, _, _ := setupCoordinatorTest(t, 10)
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
 	gameAddr1 := common.Address{0xaa}
 	gameAddr2 := common.Address{0xbb}
 	gameAddr3 := common.Address{0xcc}
@@ -218,6 +218,153 @@ func TestDropOldGameStates(t *testing.T) {
 	require.Contains(t, c.states, gameAddr2, "should keep state for game 2 (still active)")
 	require.Contains(t, c.states, gameAddr3, "should keep state for game 3 (inflight)")
 	require.Contains(t, c.states, gameAddr4, "should create state for game 4")
+
+	require.True(t, games.created[gameAddr1].closed, "should close player for dropped game 1")
+	require.False(t, games.created[gameAddr2].closed, "should not close player for game 2")
+	require.False(t, games.created[gameAddr3].closed, "should not close player for game 3")
+}
+
+func TestShedGamesWhenOverloaded(t *testing.T) {
+	c, workQueue, _, games, disk := setupCoordinatorTest(t, 10)
+	c.maxGames = 2
+	m := c.metrics.(*stubSchedulerMetrics)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	gameAddr3 := common.Address{0xcc}
+	ctx := context.Background()
+
+	// Create pre-existing data for the game that will be shed
+	disk.DirForGame(gameAddr3)
+
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, gameAddr2, gameAddr3}))
+	require.Len(t, workQueue, 2, "should only schedule up to the maximum games")
+	require.True(t, c.Overloaded())
+	require.True(t, m.overloaded)
+	require.Equal(t, 1, m.shed)
+	require.NotContains(t, games.created, gameAddr3, "should not create player for shed game")
+	require.Contains(t, c.states, gameAddr3, "should track state for shed game")
+
+	require.NoError(t, c.processResult(<-workQueue))
+	require.NoError(t, c.processResult(<-workQueue))
+	require.True(t, disk.gameDirExists[gameAddr3], "should not delete data for shed game")
+
+	// Shed game should be progressed first in the next update
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, gameAddr2, gameAddr3}))
+	require.Len(t, workQueue, 2)
+	require.Equal(t, gameAddr3, (<-workQueue).addr, "should schedule previously shed game first")
+	require.Equal(t, 2, m.shed)
+}
+
+func TestShedResolvedGamesFirst(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	gameAddr3 := common.Address{0xcc}
+	games.createCompleted = gameAddr1
+	ctx := context.Background()
+
+	// Progress game 1 so it is known to be resolved
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1}))
+	j := <-workQueue
+	j.resolved = true
+	require.NoError(t, c.processResult(j))
+
+	c.maxGames = 2
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, gameAddr2, gameAddr3}))
+	require.Len(t, workQueue, 2)
+	scheduled := []common.Address{(<-workQueue).addr, (<-workQueue).addr}
+	require.ElementsMatch(t, []common.Address{gameAddr2, gameAddr3}, scheduled, "should shed resolved game")
+}
+
+func TestNotOverloadedWhenInflightGamesExceedLimit(t *testing.T) {
+	c, workQueue, _, _, _ := setupCoordinatorTest(t, 10)
+	c.maxGames = 1
+	m := c.metrics.(*stubSchedulerMetrics)
+	gameAddr1 := common.Address{0xaa}
+	ctx := context.Background()
+
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1}))
+	require.Len(t, workQueue, 1)
+	require.False(t, c.Overloaded())
+
+	// Game 1 is still in flight so doesn't count towards the limit
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, {0xbb}}))
+	require.Len(t, workQueue, 2)
+	require.False(t, c.Overloaded())
+	require.False(t, m.overloaded)
+	require.Zero(t, m.shed)
+}
+
+func TestReserveDiskBeforeCreatingPlayer(t *testing.T) {
+	c, workQueue, _, games, disk := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	disk.reserveFails = gameAddr2
+	ctx := context.Background()
+
+	err := c.schedule(ctx, []common.Address{gameAddr1, gameAddr2})
+	require.ErrorContains(t, err, "failed to reserve disk space")
+	require.Len(t, workQueue, 1, "should only schedule game with reserved space")
+	require.Contains(t, games.created, gameAddr1)
+	require.NotContains(t, games.created, gameAddr2, "should not create player without reserved space")
+	require.Equal(t, []common.Address{gameAddr1}, disk.reserved)
+
+	// Retries reserving space on the next update
+	disk.reserveFails = common.Address{}
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, gameAddr2}))
+	require.Contains(t, games.created, gameAddr2)
+	require.Equal(t, []common.Address{gameAddr1, gameAddr2}, disk.reserved)
+}
+
+func TestWatchGamesWithoutFullPlayer(t *testing.T) {
+	c, workQueue, _, games, disk := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	watches := &createdWatches{watch: []common.Address{gameAddr1}, created: make(map[common.Address]*stubWatch)}
+	c.createWatch = watches.CreateWatch
+	ctx := context.Background()
+
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1, gameAddr2}))
+	require.Len(t, workQueue, 2)
+	require.Contains(t, watches.created, gameAddr1)
+	require.NotContains(t, games.created, gameAddr1, "should not create full player for watched game")
+	require.Contains(t, games.created, gameAddr2)
+	require.Equal(t, []common.Address{gameAddr2}, disk.reserved, "should not reserve disk for watched game")
+
+	// Keep watching until the game requires a full player
+	j := <-workQueue
+	require.Equal(t, gameAddr1, j.addr)
+	require.NoError(t, c.processResult(j))
+	<-workQueue
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1}))
+	require.Same(t, watches.created[gameAddr1], (<-workQueue).player)
+
+	watches.created[gameAddr1].requiresFull = true
+	require.NoError(t, c.processResult(j))
+	require.True(t, watches.created[gameAddr1].closed, "should close watch player")
+	watches.watch = nil
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1}))
+	require.Contains(t, games.created, gameAddr1, "should create full player once required")
+	require.Equal(t, []common.Address{gameAddr2, gameAddr1}, disk.reserved)
+	require.Same(t, games.created[gameAddr1], (<-workQueue).player)
+}
+
+func TestRetryWatchCreation(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	watches := &createdWatches{creationFails: gameAddr1, created: make(map[common.Address]*stubWatch)}
+	c.createWatch = watches.CreateWatch
+	ctx := context.Background()
+
+	err := c.schedule(ctx, []common.Address{gameAddr1})
+	require.ErrorContains(t, err, "failed to create watch player")
+	require.Empty(t, workQueue)
+	require.Empty(t, games.created, "should not fall back to a full player")
+
+	watches.creationFails = common.Address{}
+	require.NoError(t, c.schedule(ctx, []common.Address{gameAddr1}))
+	require.Len(t, workQueue, 1)
+	require.Contains(t, games.created, gameAddr1)
 }
 
 func setupCoordinatorTest(t *testing.T, bufferSize int) (*coordinator, <-chan job, chan job, *createdGames, *stubDiskManager) {
@@ -229,7 +376,7 @@ func setupCoordinatorTest(t *testing.T, bufferSize int) (*coordinator, <-chan jo
 		created: make(map[common.Address]*stubGame),
 	}
 	disk := &stubDiskManager{gameDirExists: make(map[common.Address]bool)}
-	c := newCoordinator(logger, workQueue, resultQueue, games.CreateGame, disk)
+	c := newCoordinator(logger, &stubSchedulerMetrics{}, workQueue, resultQueue, games.CreateGame, nil, disk, 0)
 	return c, workQueue, resultQueue, games, disk
 }
 
@@ -238,6 +385,7 @@ type stubGame struct {
 	progressCount int
 	done          bool
 	dir           string
+	closed        bool
 }
 
 func (g *stubGame) ProgressGame(_ context.Context) bool {
@@ -245,6 +393,11 @@ func (g *stubGame) ProgressGame(_ context.Context) bool {
 	return g.done
 }
 
+func (g *stubGame) Close() error {
+	g.closed = true
+	return nil
+}
+
 type createdGames struct {
 	t               *testing.T
 	createCompleted common.Address
@@ -268,9 +421,46 @@ func (c *createdGames) CreateGame(addr common.Address, dir string) (GamePlayer,
 	return game, nil
 }
 
+type stubWatch struct {
+	stubGame
+	requiresFull bool
+}
+
+func (w *stubWatch) RequiresFullPlayer() bool {
+	return w.requiresFull
+}
+
+type createdWatches struct {
+	watch         []common.Address
+	creationFails common.Address
+	created       map[common.Address]*stubWatch
+}
+
+func (c *createdWatches) CreateWatch(addr common.Address) (WatchPlayer, error) {
+	if c.creationFails == addr {
+		return nil, fmt.Errorf("refusing to create watch for game: %v", addr)
+	}
+	if !slices.Contains(c.watch, addr) {
+		return nil, nil
+	}
+	watch := &stubWatch{stubGame: stubGame{addr: addr}}
+	c.created[addr] = watch
+	return watch, nil
+}
+
 type stubDiskManager struct {
 	gameDirExists map[common.Address]bool
 	deletedDirs   []common.Address
+	reserveFails  common.Address
+	reserved      []common.Address
+}
+
+func (s *stubDiskManager) Reserve(addr common.Address) error {
+	if s.reserveFails == addr {
+		return fmt.Errorf("no space for game: %v", addr)
+	}
+	s.reserved = append(s.reserved, addr)
+	return nil
 }
 
 func (s *stubDiskManager) DirForGame(addr common.Address) string {
@@ -288,3 +478,16 @@ func (s *stubDiskManager) RemoveAllExcept(addrs []common.Address) error {
 	}
 	return nil
 }
+
+type stubSchedulerMetrics struct {
+	shed       int
+	overloaded bool
+}
+
+func (s *stubSchedulerMetrics) RecordGamesShed(count int) {
+	s.shed += count
+}
+
+func (s *stubSchedulerMetrics) RecordSchedulerOverloaded(overloaded bool) {
+	s.overloaded = overloaded
+}
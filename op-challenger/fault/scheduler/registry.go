@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GameTypeFetcher loads the type of the game at address.
+type GameTypeFetcher func(address common.Address) (uint8, error)
+
+type playerCreators struct {
+	createPlayer PlayerCreator
+	createWatch  WatchCreator
+}
+
+// PlayerRegistry selects the player to create for each game by its game type, so games of different dispute
+// protocols can be progressed with the same monitor, scheduler and disk management.
+// Games of types that haven't been registered use the default creators.
+type PlayerRegistry struct {
+	fetchGameType GameTypeFetcher
+	defaults      playerCreators
+	gameTypes     map[uint8]playerCreators
+}
+
+// NewPlayerRegistry creates a new PlayerRegistry that creates players with createPlayer and createWatch for games of
+// any type that isn't registered. createWatch may be nil.
+func NewPlayerRegistry(fetchGameType GameTypeFetcher, createPlayer PlayerCreator, createWatch WatchCreator) *PlayerRegistry {
+	return &PlayerRegistry{
+		fetchGameType: fetchGameType,
+		defaults:      playerCreators{createPlayer: createPlayer, createWatch: createWatch},
+		gameTypes:     make(map[uint8]playerCreators),
+	}
+}
+
+// RegisterGameType sets the creators used for games of gameType, replacing any previously registered.
+// createWatch may be nil if games of this type always require a full player.
+// Must be called before the scheduler is started.
+func (r *PlayerRegistry) RegisterGameType(gameType uint8, createPlayer PlayerCreator, createWatch WatchCreator) {
+	r.gameTypes[gameType] = playerCreators{createPlayer: createPlayer, createWatch: createWatch}
+}
+
+// CreatePlayer is a [PlayerCreator] that creates the player registered for the game's type.
+func (r *PlayerRegistry) CreatePlayer(address common.Address, dir string) (GamePlayer, error) {
+	creators, err := r.creatorsFor(address)
+	if err != nil {
+		return nil, err
+	}
+	return creators.createPlayer(address, dir)
+}
+
+// CreateWatch is a [WatchCreator] that creates the watch player registered for the game's type, if any.
+func (r *PlayerRegistry) CreateWatch(address common.Address) (WatchPlayer, error) {
+	creators, err := r.creatorsFor(address)
+	if err != nil {
+		return nil, err
+	}
+	if creators.createWatch == nil {
+		return nil, nil
+	}
+	return creators.createWatch(address)
+}
+
+func (r *PlayerRegistry) creatorsFor(address common.Address) (playerCreators, error) {
+	// Avoid loading the game type when every game uses the default creators
+	if len(r.gameTypes) == 0 {
+		return r.defaults, nil
+	}
+	gameType, err := r.fetchGameType(address)
+	if err != nil {
+		return playerCreators{}, fmt.Errorf("failed to load game type: %w", err)
+	}
+	if creators, ok := r.gameTypes[gameType]; ok {
+		return creators, nil
+	}
+	return r.defaults, nil
+}
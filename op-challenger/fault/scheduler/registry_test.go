@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerRegistry(t *testing.T) {
+	faultGame := common.Address{0xaa}
+	otherGame := common.Address{0xbb}
+	gameTypes := map[common.Address]uint8{faultGame: 0, otherGame: 5}
+
+	type created struct {
+		creator string
+		addr    common.Address
+		dir     string
+	}
+	setup := func() (*PlayerRegistry, *[]created, *int) {
+		var players []created
+		fetches := 0
+		fetchGameType := func(addr common.Address) (uint8, error) {
+			fetches++
+			gameType, ok := gameTypes[addr]
+			if !ok {
+				return 0, errors.New("unknown game")
+			}
+			return gameType, nil
+		}
+		creator := func(name string) PlayerCreator {
+			return func(addr common.Address, dir string) (GamePlayer, error) {
+				players = append(players, created{creator: name, addr: addr, dir: dir})
+				return &stubRegistryPlayer{}, nil
+			}
+		}
+		watch := func(addr common.Address) (WatchPlayer, error) {
+			players = append(players, created{creator: "watch", addr: addr})
+			return &stubRegistryPlayer{}, nil
+		}
+		registry := NewPlayerRegistry(fetchGameType, creator("default"), watch)
+		registry.RegisterGameType(5, creator("other"), nil)
+		return registry, &players, &fetches
+	}
+
+	t.Run("DefaultCreators", func(t *testing.T) {
+		registry, players, _ := setup()
+		_, err := registry.CreatePlayer(faultGame, "dir")
+		require.NoError(t, err)
+		watch, err := registry.CreateWatch(faultGame)
+		require.NoError(t, err)
+		require.NotNil(t, watch)
+		require.Equal(t, []created{{"default", faultGame, "dir"}, {"watch", faultGame, ""}}, *players)
+	})
+
+	t.Run("RegisteredCreators", func(t *testing.T) {
+		registry, players, _ := setup()
+		_, err := registry.CreatePlayer(otherGame, "dir")
+		require.NoError(t, err)
+		watch, err := registry.CreateWatch(otherGame)
+		require.NoError(t, err)
+		require.Nil(t, watch, "should not watch games without a registered watch creator")
+		require.Equal(t, []created{{"other", otherGame, "dir"}}, *players)
+	})
+
+	t.Run("GameTypeUnavailable", func(t *testing.T) {
+		registry, players, _ := setup()
+		_, err := registry.CreatePlayer(common.Address{0xcc}, "dir")
+		require.ErrorContains(t, err, "failed to load game type")
+		_, err = registry.CreateWatch(common.Address{0xcc})
+		require.ErrorContains(t, err, "failed to load game type")
+		require.Empty(t, *players)
+	})
+
+	t.Run("NoGameTypeLookupWithoutRegistrations", func(t *testing.T) {
+		_, players, fetches := setup()
+		registry := NewPlayerRegistry(func(addr common.Address) (uint8, error) {
+			*fetches++
+			return 0, nil
+		}, func(addr common.Address, dir string) (GamePlayer, error) {
+			*players = append(*players, created{creator: "default", addr: addr, dir: dir})
+			return &stubRegistryPlayer{}, nil
+		}, nil)
+		_, err := registry.CreatePlayer(otherGame, "dir")
+		require.NoError(t, err)
+		watch, err := registry.CreateWatch(otherGame)
+		require.NoError(t, err)
+		require.Nil(t, watch)
+		require.Zero(t, *fetches)
+		require.Equal(t, []created{{"default", otherGame, "dir"}}, *players)
+	})
+}
+
+type stubRegistryPlayer struct {
+	stubGame
+}
+
+func (s *stubRegistryPlayer) RequiresFullPlayer() bool {
+	return false
+}
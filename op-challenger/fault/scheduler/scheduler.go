@@ -12,17 +12,24 @@ import (
 var ErrBusy = errors.New("busy scheduling previous update")
 
 type Scheduler struct {
-	logger         log.Logger
-	coordinator    *coordinator
+	logger        log.Logger
+	coordinator   *coordinator
+	scheduleQueue chan []common.Address
+	jobQueue      chan job
+	resultQueue   chan job
+	wg            sync.WaitGroup
+	cancel        func()
+
+	workerLock     sync.Mutex
+	ctx            context.Context
 	maxConcurrency uint
-	scheduleQueue  chan []common.Address
-	jobQueue       chan job
-	resultQueue    chan job
-	wg             sync.WaitGroup
-	cancel         func()
+	workers        []chan struct{}
 }
 
-func NewScheduler(logger log.Logger, disk DiskManager, maxConcurrency uint, createPlayer PlayerCreator) *Scheduler {
+// NewScheduler creates a new [Scheduler]. If maxGames is non-zero, at most maxGames games are progressed in each
+// update and any excess games are shed until a later update.
+// createWatch may be nil, in which case a full player is created for every game.
+func NewScheduler(logger log.Logger, m SchedulerMetricer, disk DiskManager, maxConcurrency uint, maxGames uint, createPlayer PlayerCreator, createWatch WatchCreator) *Scheduler {
 	// Size job and results queues to be fairly small so backpressure is applied early
 	// but with enough capacity to keep the workers busy
 	jobQueue := make(chan job, maxConcurrency*2)
@@ -34,7 +41,7 @@ func NewScheduler(logger log.Logger, disk DiskManager, maxConcurrency uint, crea
 
 	return &Scheduler{
 		logger:         logger,
-		coordinator:    newCoordinator(logger, jobQueue, resultQueue, createPlayer, disk),
+		coordinator:    newCoordinator(logger, m, jobQueue, resultQueue, createPlayer, createWatch, disk, int(maxGames)),
 		maxConcurrency: maxConcurrency,
 		scheduleQueue:  scheduleQueue,
 		jobQueue:       jobQueue,
@@ -46,21 +53,59 @@ func (s *Scheduler) Start(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
 	s.cancel = cancel
 
-	for i := uint(0); i < s.maxConcurrency; i++ {
-		s.wg.Add(1)
-		go progressGames(ctx, s.jobQueue, s.resultQueue, &s.wg)
-	}
+	s.workerLock.Lock()
+	s.ctx = ctx
+	s.updateWorkers()
+	s.workerLock.Unlock()
 
 	s.wg.Add(1)
 	go s.loop(ctx)
 }
 
+// SetMaxConcurrency changes the number of games progressed concurrently.
+// When reducing the concurrency, excess workers finish the game they are progressing before stopping.
+func (s *Scheduler) SetMaxConcurrency(maxConcurrency uint) {
+	s.workerLock.Lock()
+	defer s.workerLock.Unlock()
+	s.maxConcurrency = maxConcurrency
+	if s.ctx != nil {
+		s.updateWorkers()
+	}
+}
+
+// MaxConcurrency returns the number of games progressed concurrently.
+func (s *Scheduler) MaxConcurrency() uint {
+	s.workerLock.Lock()
+	defer s.workerLock.Unlock()
+	return s.maxConcurrency
+}
+
+// updateWorkers starts or stops workers to match maxConcurrency. The workerLock must be held.
+func (s *Scheduler) updateWorkers() {
+	for uint(len(s.workers)) < s.maxConcurrency {
+		stop := make(chan struct{})
+		s.workers = append(s.workers, stop)
+		s.wg.Add(1)
+		go progressGames(s.ctx, stop, s.jobQueue, s.resultQueue, &s.wg)
+	}
+	for uint(len(s.workers)) > s.maxConcurrency {
+		last := len(s.workers) - 1
+		close(s.workers[last])
+		s.workers = s.workers[:last]
+	}
+}
+
 func (s *Scheduler) Close() error {
 	s.cancel()
 	s.wg.Wait()
 	return nil
 }
 
+// Overloaded returns true if games were shed in the most recent update because there were too many to progress.
+func (s *Scheduler) Overloaded() bool {
+	return s.coordinator.Overloaded()
+}
+
 func (s *Scheduler) Schedule(games []common.Address) error {
 	select {
 	case s.scheduleQueue <- games:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -18,7 +19,7 @@ func TestSchedulerProcessesGames(t *testing.T) {
 	}
 	removeExceptCalls := make(chan []common.Address)
 	disk := &trackingDiskManager{removeExceptCalls: removeExceptCalls}
-	s := NewScheduler(logger, disk, 2, createPlayer)
+	s := NewScheduler(logger, metrics.NoopMetrics, disk, 2, 0, createPlayer, nil)
 	s.Start(ctx)
 
 	gameAddr1 := common.Address{0xaa}
@@ -39,6 +40,35 @@ func TestSchedulerProcessesGames(t *testing.T) {
 	require.NoError(t, s.Close())
 }
 
+func TestSchedulerSetMaxConcurrency(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	createPlayer := func(addr common.Address, dir string) (GamePlayer, error) {
+		return &stubPlayer{}, nil
+	}
+	removeExceptCalls := make(chan []common.Address)
+	disk := &trackingDiskManager{removeExceptCalls: removeExceptCalls}
+	s := NewScheduler(logger, metrics.NoopMetrics, disk, 2, 0, createPlayer, nil)
+
+	// Changes before starting apply when the scheduler starts
+	s.SetMaxConcurrency(3)
+	require.Equal(t, uint(3), s.MaxConcurrency())
+	s.Start(context.Background())
+	require.Len(t, s.workers, 3)
+
+	s.SetMaxConcurrency(5)
+	require.Equal(t, uint(5), s.MaxConcurrency())
+	require.Len(t, s.workers, 5)
+
+	s.SetMaxConcurrency(1)
+	require.Equal(t, uint(1), s.MaxConcurrency())
+	require.Len(t, s.workers, 1)
+
+	// Games are still progressed by the remaining worker
+	require.NoError(t, s.Schedule([]common.Address{{0xaa}}))
+	<-removeExceptCalls
+	require.NoError(t, s.Close())
+}
+
 func TestReturnBusyWhenScheduleQueueFull(t *testing.T) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	createPlayer := func(addr common.Address, dir string) (GamePlayer, error) {
@@ -46,7 +76,7 @@ func TestReturnBusyWhenScheduleQueueFull(t *testing.T) {
 	}
 	removeExceptCalls := make(chan []common.Address)
 	disk := &trackingDiskManager{removeExceptCalls: removeExceptCalls}
-	s := NewScheduler(logger, disk, 2, createPlayer)
+	s := NewScheduler(logger, metrics.NoopMetrics, disk, 2, 0, createPlayer, nil)
 
 	// Scheduler not started - first call fills the queue
 	require.NoError(t, s.Schedule([]common.Address{{0xaa}}))
@@ -64,6 +94,10 @@ func (t *trackingDiskManager) DirForGame(addr common.Address) string {
 	return addr.Hex()
 }
 
+func (t *trackingDiskManager) Reserve(_ common.Address) error {
+	return nil
+}
+
 func (t *trackingDiskManager) RemoveAllExcept(addrs []common.Address) error {
 	t.removeExceptCalls <- addrs
 	return nil
@@ -10,11 +10,25 @@ type GamePlayer interface {
 	ProgressGame(ctx context.Context) bool
 }
 
+// WatchPlayer is a lightweight player that watches a game without requiring disk space or trace generation.
+// Once RequiresFullPlayer returns true the watch player is closed and replaced by a full player.
+type WatchPlayer interface {
+	GamePlayer
+	RequiresFullPlayer() bool
+}
+
 type DiskManager interface {
 	DirForGame(addr common.Address) string
+	// Reserve reserves the disk space expected to be required by the game, returning an error if there isn't enough.
+	Reserve(addr common.Address) error
 	RemoveAllExcept(addrs []common.Address) error
 }
 
+type SchedulerMetricer interface {
+	RecordGamesShed(count int)
+	RecordSchedulerOverloaded(overloaded bool)
+}
+
 type job struct {
 	addr     common.Address
 	player   GamePlayer
@@ -7,13 +7,16 @@ import (
 
 // progressGames accepts jobs from in channel, calls ProgressGame on the job.player and returns the job
 // with updated job.resolved via the out channel.
-// The loop exits when the ctx is done.  wg.Done() is called when the function returns.
-func progressGames(ctx context.Context, in <-chan job, out chan<- job, wg *sync.WaitGroup) {
+// The loop exits when the ctx is done or stop is closed. A worker that is stopped finishes its current job before
+// exiting. wg.Done() is called when the function returns.
+func progressGames(ctx context.Context, stop <-chan struct{}, in <-chan job, out chan<- job, wg *sync.WaitGroup) {
 	defer wg.Done()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-stop:
+			return
 		case j := <-in:
 			j.resolved = j.player.ProgressGame(ctx)
 			out <- j
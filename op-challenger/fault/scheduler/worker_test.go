@@ -17,7 +17,7 @@ func TestWorkerShouldProcessJobsUntilContextDone(t *testing.T) {
 	defer cancel()
 	var wg sync.WaitGroup
 	wg.Add(1)
-	go progressGames(ctx, in, out, &wg)
+	go progressGames(ctx, nil, in, out, &wg)
 
 	in <- job{
 		player: &stubPlayer{done: false},
@@ -37,6 +37,23 @@ func TestWorkerShouldProcessJobsUntilContextDone(t *testing.T) {
 	wg.Wait()
 }
 
+func TestWorkerShouldExitWhenStopped(t *testing.T) {
+	in := make(chan job, 2)
+	out := make(chan job, 2)
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go progressGames(context.Background(), stop, in, out, &wg)
+
+	in <- job{player: &stubPlayer{done: true}}
+	result := readWithTimeout(t, out)
+	require.True(t, result.resolved)
+
+	close(stop)
+	wg.Wait()
+}
+
 type stubPlayer struct {
 	done bool
 }
@@ -3,48 +3,162 @@ package fault
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/audit"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/costs"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/mempool"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/profiler"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/reporter"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-challenger/version"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// ErrPrestateMismatch is returned when the trace provider's absolute prestate doesn't match the game's.
+var ErrPrestateMismatch = errors.New("trace provider's absolute prestate does not match onchain absolute prestate")
+
+// minOutputRootAgreement is the number of rollup nodes that must return the same output root before it is used.
+const minOutputRootAgreement = 2
+
 type Loader interface {
 	FetchAbsolutePrestateHash(ctx context.Context) ([]byte, error)
 }
 
 type Service struct {
-	logger  log.Logger
-	metrics metrics.Metricer
-	monitor *gameMonitor
-	sched   *scheduler.Scheduler
+	logger   log.Logger
+	metrics  metrics.Metricer
+	monitor  *gameMonitor
+	sched    *scheduler.Scheduler
+	server   *rpc.Server
+	verify   *rpc.Server
+	reporter *reporter.Reporter
+	rollup   *outputs.Source
+	tuner    *concurrencyTuner
+	mempool  *mempool.Watcher
+	costs    *costs.Ledger
+	alerts   *alert.Dispatcher
+	profiler *profiler.AnomalyProfiler
+	attacks  *panicMode
+	disk     *diskManager
+}
+
+// ServiceOption configures optional behaviour of a [Service].
+type ServiceOption func(opts *serviceOptions)
+
+type serviceOptions struct {
+	clock   clock.Clock
+	players map[uint8]GamePlayerCreator
+}
+
+// GameServices are the services shared by all game players, available to players of other dispute protocols.
+type GameServices struct {
+	Logger   log.Logger
+	Clock    clock.Clock
+	Metrics  metrics.Metricer
+	TxMgr    txmgr.TxManager
+	L1Client *ethclient.Client
+	AuditLog audit.Recorder
+	Alerter  alert.Alerter
+}
+
+// GamePlayerCreator creates the player for a game. dir is the directory allocated for the game's data, which is removed
+// once the game is no longer being played.
+type GamePlayerCreator func(ctx context.Context, services GameServices, addr common.Address, dir string) (scheduler.GamePlayer, error)
+
+// WithClock sets the clock used for game deadlines, chess clocks, fee urgency, polling and other timers throughout the
+// service. Defaults to the system clock. Tests can use a [clock.DeterministicClock] to advance time without sleeping.
+func WithClock(cl clock.Clock) ServiceOption {
+	return func(opts *serviceOptions) {
+		opts.clock = cl
+	}
+}
+
+// WithGamePlayer plays games of gameType with players created by create instead of the fault dispute game player.
+// This allows games of other dispute protocols to be played using the same game monitoring, scheduling, disk
+// management and transaction manager. Players of other game types are never watched by the defense watch.
+func WithGamePlayer(gameType uint8, create GamePlayerCreator) ServiceOption {
+	return func(opts *serviceOptions) {
+		opts.players[gameType] = create
+	}
+}
+
+func newServiceOptions(opts ...ServiceOption) *serviceOptions {
+	options := &serviceOptions{
+		clock:   clock.SystemClock,
+		players: make(map[uint8]GamePlayerCreator),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
 }
 
 // NewService creates a new Service.
-func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Service, error) {
-	cl := clock.SystemClock
+func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, opts ...ServiceOption) (*Service, error) {
+	options := newServiceOptions(opts...)
+	cl := options.clock
 	m := metrics.NewMetrics()
-	txMgr, err := txmgr.NewSimpleTxManager("challenger", logger, &m.TxMetrics, cfg.TxMgrConfig)
+	txMgrConfig, err := txmgr.NewConfig(cfg.TxMgrConfig, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the transaction manager: %w", err)
 	}
+	if cfg.TxRelayRpc != "" {
+		relayClient, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, cfg.TxRelayRpc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial the tx relay: %w", err)
+		}
+		txMgrConfig.Backend = newRelayBackend(logger, txMgrConfig.Backend, relayClient)
+		logger.Info("Sending transactions through private relay")
+	}
+	txMgr := txmgr.NewSimpleTxManagerFromConfig("challenger", logger, &m.TxMetrics, txMgrConfig)
 
-	client, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	l1Client, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, cfg.L1EthRpc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial L1: %w", err)
 	}
 
+	var gameCaller bind.ContractCaller = l1Client
+	if len(cfg.L1QuorumRpcs) > 0 {
+		callers := []bind.ContractCaller{l1Client}
+		for _, url := range cfg.L1QuorumRpcs {
+			quorumClient, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, url)
+			if err != nil {
+				return nil, fmt.Errorf("failed to dial L1 quorum endpoint: %w", err)
+			}
+			callers = append(callers, quorumClient)
+		}
+		gameCaller = newQuorumCaller(logger, m, callers, int(cfg.L1QuorumThreshold), l1Client.BlockNumber)
+	}
+	confirmations := newConfirmedHead(cfg.ConfirmationDepth)
+	// headCaller reads the latest state so claims pending confirmation can be reported
+	var headCaller bind.ContractCaller
+	if cfg.ConfirmationDepth > 0 {
+		headCaller = gameCaller
+		gameCaller = newConfirmedCaller(gameCaller, confirmations)
+	}
+
 	pprofConfig := cfg.PprofConfig
 	if pprofConfig.Enabled {
 		logger.Info("starting pprof", "addr", pprofConfig.ListenAddr, "port", pprofConfig.ListenPort)
@@ -63,39 +177,319 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 				logger.Error("error starting metrics server", "err", err)
 			}
 		}()
-		m.StartBalanceMetrics(ctx, logger, client, txMgr.From())
+		m.StartBalanceMetrics(ctx, logger, l1Client, txMgr.From())
 	}
 
-	factory, err := bindings.NewDisputeGameFactory(cfg.GameFactoryAddress, client)
+	factory, err := bindings.NewDisputeGameFactory(cfg.GameFactoryAddress, l1Client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind the fault dispute game factory contract: %w", err)
 	}
 	loader := NewGameLoader(factory)
+	factoryGames := newFactoryGames(factory, func(game common.Address) (GameDataCaller, error) {
+		return bindings.NewFaultDisputeGameCaller(game, gameCaller)
+	})
+
+	var rollupNodes *outputs.Source
+	var rollupClient SyncStatusProvider
+	var outputRoots OutputRootSource
+	if len(cfg.RollupRpcs) > 0 {
+		names := make([]string, len(cfg.RollupRpcs))
+		clients := make([]outputs.RollupClient, len(cfg.RollupRpcs))
+		for i, rpcUrl := range cfg.RollupRpcs {
+			rc, err := client.DialRollupClientWithTimeout(client.DefaultDialTimeout, logger, rpcUrl)
+			if err != nil {
+				return nil, fmt.Errorf("failed to dial rollup node %v: %w", i, err)
+			}
+			// Identify nodes by index rather than URL to avoid logging any credentials in the URL
+			names[i] = fmt.Sprintf("rollup-%v", i)
+			clients[i] = rc
+		}
+		minAgreement := minOutputRootAgreement
+		if cfg.L2GethRpc != "" && len(clients) < minAgreement {
+			// op-geth provides the second output root, computed independently of the rollup node
+			minAgreement = len(clients)
+		}
+		rollupNodes = outputs.NewSource(logger, cl, minAgreement, names, clients)
+		rollupClient = rollupNodes
+		if cfg.OutputRootAgreement {
+			outputRoots = rollupNodes
+		}
+		if cfg.OutputRootAgreement && cfg.L2GethRpc != "" {
+			gethClient, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, cfg.L2GethRpc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to dial l2 geth: %w", err)
+			}
+			outputRoots = outputs.NewVerifiedSource(logger, rollupNodes, outputs.NewGethSource(outputs.NewGethClient(gethClient)))
+			logger.Info("Verifying output roots against op-geth state")
+		}
+	}
+	halt := newHaltDetector(logger, cl, m, rollupClient, cfg.L1HaltThreshold, cfg.L2HaltThreshold, cfg.UrgentClaimAge)
+	runtimeCfg := newRuntimeConfig(logger, m, cfg.RuntimeConfigAddress, l1Client)
+	pause := combinedPause{halt, runtimeCfg}
 
-	disk := newDiskManager(cfg.Datadir)
-	sched := scheduler.NewScheduler(
-		logger,
-		disk,
-		cfg.MaxConcurrency,
+	status := newStatusRegistry(cl)
+	pauseAdmin := &adminPause{}
+	disk := newDiskManager(logger, cl, cfg.Datadir, systemResources{}, cfg.TraceTypes)
+	cache := newClaimCache(logger, cl, func(game common.Address) (GameStateLoader, error) {
+		return NewLoaderFromBindings(game, gameCaller)
+	})
+	ledger, err := costs.NewLedger(logger, cl, m, disk.CostsDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cost ledger: %w", err)
+	}
+	var auditKey *ecdsa.PrivateKey
+	if cfg.AuditSign {
+		auditKey, err = crypto.HexToECDSA(strings.TrimPrefix(cfg.TxMgrConfig.PrivateKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the private key to sign the audit log: %w", err)
+		}
+	}
+	auditLog, err := audit.Open(logger, cl, disk.AuditDir(), auditKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log: %w", err)
+	}
+	outcomes := multiOutcomeReporter{ledger}
+	var outcomeReporter *reporter.Reporter
+	if cfg.OutcomeReportURL != "" {
+		outcomeReporter, err = reporter.NewReporter(logger, cl, cfg.OutcomeReportURL, cfg.OutcomeReportSecret, txMgr.From(), disk.OutcomesDir())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the outcome reporter: %w", err)
+		}
+		outcomes = append(outcomes, outcomeReporter)
+	}
+	var senders []alert.Sender
+	if cfg.AlertSlackWebhook != "" {
+		senders = append(senders, alert.NewSlackSender(cfg.AlertSlackWebhook))
+	}
+	if cfg.AlertOpsgenieAPIKey != "" {
+		senders = append(senders, alert.NewOpsgenieSender(cfg.AlertOpsgenieURL, cfg.AlertOpsgenieAPIKey))
+	}
+	var alerts *alert.Dispatcher
+	var alerter alert.Alerter = alert.NoopAlerter
+	if len(senders) > 0 {
+		alerts = alert.NewDispatcher(logger, cl, alert.DefaultRepeatInterval, senders...)
+		alerter = alerts
+	}
+	balance := newBalanceGuardian(logger, m, l1Client, erc20BalanceReader{l1Client}, txMgr.From(), cfg.MinBalance, alerter)
+	var pendingMoves *mempool.Watcher
+	if cfg.MempoolLookahead {
+		pendingMoves, err = mempool.NewWatcher(logger, cl, mempool.NewRPCSubscriber(l1Client.Client()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the mempool watcher: %w", err)
+		}
+	}
+	var stuckTxs *responder.StuckTxMonitor
+	if cfg.StuckTxFraction > 0 {
+		broadcasters := make([]responder.TxBroadcaster, 0, len(cfg.TxRebroadcastRpcs))
+		for i, url := range cfg.TxRebroadcastRpcs {
+			rebroadcastClient, err := client.DialEthClientWithTimeout(client.DefaultDialTimeout, logger, url)
+			if err != nil {
+				// Identify endpoints by index rather than URL to avoid including any credentials in the error
+				return nil, fmt.Errorf("failed to dial rebroadcast endpoint %v: %w", i, err)
+			}
+			broadcasters = append(broadcasters, rebroadcastClient)
+		}
+		stuckTxs = responder.NewStuckTxMonitor(logger, cl, m, alerter, cfg.StuckTxFraction, broadcasters)
+	}
+	maxConcurrency := cfg.MaxConcurrency
+	memoryPerGame := uint64(defaultMemoryPerGame)
+	if cfg.Cannon.MaxMemory != 0 {
+		memoryPerGame = uint64(cfg.Cannon.MaxMemory) * 1024 * 1024
+	}
+	if cfg.AutoConcurrency {
+		if limit := autoConcurrency(logger, systemResources{}, cfg.Datadir, memoryPerGame); limit < maxConcurrency {
+			maxConcurrency = limit
+		}
+		logger.Info("Derived max concurrency from system resources", "maxConcurrency", maxConcurrency)
+	}
+	m.RecordMaxConcurrency(maxConcurrency)
+	var anomalies *profiler.AnomalyProfiler
+	var cycles cycleRecorder
+	if cfg.AnomalyProfiles {
+		anomalies = profiler.NewAnomalyProfiler(logger, cl, m, disk.ProfilesDir(), cfg.AnomalyMaxProfiles, cfg.AnomalySlowCycle, uint64(cfg.AnomalyMemoryLimit)*1024*1024)
+		cycles = anomalies
+	}
+	// Players are only created after the scheduler starts, so they use the tuned metrics if auto concurrency is enabled
+	// and share the panic mode detector once it has been added to deps.
+	playerMetrics := metrics.Metricer(m)
+	providers := newProviderRegistry()
+	deps := PlayerDeps{
+		TxMgr:        txMgr,
+		Client:       gameCaller,
+		HeadClient:   headCaller,
+		Pause:        pause,
+		Status:       status,
+		Cache:        cache,
+		Outcomes:     outcomes,
+		Outputs:      outputRoots,
+		PendingMoves: pendingMoves,
+		StuckTxs:     stuckTxs,
+		Tokens:       balance,
+		AuditLog:     auditLog,
+		Providers:    providers,
+		Alerter:      alerter,
+	}
+	var createWatch scheduler.WatchCreator
+	if cfg.DefenseWatch {
+		createWatch = func(addr common.Address) (scheduler.WatchPlayer, error) {
+			watch, err := NewDefenseWatch(ctx, logger, playerMetrics, cl, cfg, disk.DirForGame(addr), disk.LogFileForGame(addr), addr, deps)
+			if watch == nil {
+				// Avoid returning a typed nil so the scheduler creates a full player
+				return nil, err
+			}
+			return watch, nil
+		}
+	}
+	// Fault dispute games are played by default. Other dispute protocols register their players by game type.
+	players := scheduler.NewPlayerRegistry(
+		func(addr common.Address) (uint8, error) {
+			loader, err := NewLoaderFromBindings(addr, gameCaller)
+			if err != nil {
+				return 0, err
+			}
+			return loader.FetchGameType(ctx)
+		},
 		func(addr common.Address, dir string) (scheduler.GamePlayer, error) {
-			return NewGamePlayer(ctx, logger, cfg, dir, addr, txMgr, client)
-		})
+			player, err := NewGamePlayer(ctx, logger, playerMetrics, cl, cfg, dir, disk.LogFileForGame(addr), addr, deps)
+			if err != nil {
+				return nil, err
+			}
+			disk.SetTraceType(addr, player.TraceType())
+			return player, nil
+		},
+		createWatch)
+	services := GameServices{
+		Logger:   logger,
+		Clock:    cl,
+		Metrics:  m,
+		TxMgr:    txMgr,
+		L1Client: l1Client,
+		AuditLog: auditLog,
+		Alerter:  alerter,
+	}
+	for gameType, create := range options.players {
+		create := create
+		players.RegisterGameType(gameType, func(addr common.Address, dir string) (scheduler.GamePlayer, error) {
+			return create(ctx, services, addr, dir)
+		}, nil)
+		logger.Info("Registered custom game player", "gameType", gameType)
+	}
+	sched := scheduler.NewScheduler(logger, m, disk, maxConcurrency, cfg.MaxScheduledGames, players.CreatePlayer, players.CreateWatch)
+	var tuner *concurrencyTuner
+	if cfg.AutoConcurrency {
+		tuner = newConcurrencyTuner(logger, cl, m, systemResources{}, sched, maxConcurrency, memoryPerGame)
+		playerMetrics = &tunedMetrics{Metricer: m, tuner: tuner}
+	}
+	var attacks *panicMode
+	var gameCreations gameCreationRecorder
+	if cfg.PanicGameThreshold > 0 || cfg.PanicClaimThreshold > 0 {
+		attacks = newPanicMode(logger, cl, m, alerter, sched, cfg.PanicWindow, cfg.PanicDuration, cfg.PanicGameThreshold, cfg.PanicClaimThreshold, cfg.PanicMaxConcurrency)
+		gameCreations = attacks
+		deps.Attacks = attacks
+	}
+	if anomalies != nil {
+		playerMetrics = &profiledMetrics{Metricer: playerMetrics, anomalies: anomalies}
+	}
 
-	monitor := newGameMonitor(logger, cl, loader, sched, cfg.GameWindow, client.BlockNumber, cfg.GameAllowlist)
+	var server *rpc.Server
+	rpcCfg := cfg.RPCConfig
+	if rpcCfg.ServerEnabled() {
+		server = rpc.NewServer(logger, rpcCfg.ListenAddr, rpcCfg.ListenPort, &healthCheck{sched})
+		if rpcCfg.SocketPath != "" {
+			server.EnableSocket(rpcCfg.SocketPath, rpcCfg.SocketMode)
+		}
+		if rpcCfg.DisableTCP {
+			server.DisableTCP()
+		}
+	}
+	if rpcCfg.EnableAdmin {
+		admin := &adminBackend{
+			statusRegistry: status,
+			adminPause:     pauseAdmin,
+			logger:         logger,
+			clock:          cl,
+			client:         l1Client,
+			from:           txMgr.From(),
+			games:          factoryGames,
+			createLoader: func(game common.Address) (AdminGameLoader, error) {
+				return NewLoaderFromBindings(game, gameCaller)
+			},
+			createResolver: func(game common.Address) (GameResolver, error) {
+				return responder.NewFaultResponder(logger, txMgr, game, m, responder.ResponderOptions{MaxBond: cfg.MaxBond, AuditLog: auditLog})
+			},
+			gameDir: disk.DirForGame,
+		}
+		if err := server.EnableAdminAPI(admin); err != nil {
+			return nil, err
+		}
+	}
+	var verifyServer *rpc.Server
+	if rpcCfg.EnableVerify {
+		// The verify API is served separately so it can be exposed to third parties without the admin API.
+		verifyServer = rpc.NewServer(logger, rpcCfg.VerifyListenAddr, rpcCfg.VerifyListenPort, &healthCheck{sched})
+		verifier := newClaimVerifier(logger, factoryGames, disk, providers,
+			func(game common.Address) (VerifierGameLoader, error) {
+				return NewLoaderFromBindings(game, gameCaller)
+			},
+			func(ctx context.Context, game common.Address, gameType uint8, dir string, gameDepth uint64) (types.TraceProvider, error) {
+				traceType, err := cfg.TraceTypeForGame(gameType)
+				if err != nil {
+					return nil, err
+				}
+				return NewTraceProvider(ctx, logger, m, cl, cfg, traceType, gameCaller, dir, game, gameDepth)
+			})
+		if err := verifyServer.EnableVerifyAPI(verifier); err != nil {
+			return nil, err
+		}
+	}
+
+	fetchBlockTimestamp := func(ctx context.Context, number uint64) (uint64, error) {
+		header, err := l1Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return 0, err
+		}
+		return header.Time, nil
+	}
+	monitor := newGameMonitor(logger, cl, loader, sched, l1Client.BlockNumber, monitorDeps{
+		GameWindow:          cfg.GameWindow,
+		AllowedGames:        cfg.GameAllowlist,
+		Halt:                halt,
+		Balance:             balance,
+		Runtime:             runtimeCfg,
+		Admin:               pauseAdmin,
+		Cache:               cache,
+		Confirmations:       confirmations,
+		PendingGames:        status,
+		Cycles:              cycles,
+		GameCreations:       gameCreations,
+		BackfillFromBlock:   cfg.BackfillFromBlock,
+		FetchBlockTimestamp: fetchBlockTimestamp,
+	})
 
 	m.RecordInfo(version.SimpleWithMeta)
 	m.RecordUp()
 
 	return &Service{
-		logger:  logger,
-		metrics: m,
-		monitor: monitor,
-		sched:   sched,
+		logger:   logger,
+		metrics:  m,
+		monitor:  monitor,
+		sched:    sched,
+		server:   server,
+		verify:   verifyServer,
+		reporter: outcomeReporter,
+		rollup:   rollupNodes,
+		tuner:    tuner,
+		mempool:  pendingMoves,
+		costs:    ledger,
+		alerts:   alerts,
+		profiler: anomalies,
+		attacks:  attacks,
+		disk:     disk,
 	}, nil
 }
 
 // ValidateAbsolutePrestate validates the absolute prestate of the fault game.
-func ValidateAbsolutePrestate(ctx context.Context, trace types.TraceProvider, loader Loader) error {
+func ValidateAbsolutePrestate(ctx context.Context, trace types.PrestateProvider, loader Loader) error {
 	providerPrestate, err := trace.AbsolutePreState(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get the trace provider's absolute prestate: %w", err)
@@ -106,13 +500,62 @@ func ValidateAbsolutePrestate(ctx context.Context, trace types.TraceProvider, lo
 		return fmt.Errorf("failed to get the onchain absolute prestate: %w", err)
 	}
 	if !bytes.Equal(providerPrestateHash, onchainPrestate) {
-		return fmt.Errorf("trace provider's absolute prestate does not match onchain absolute prestate")
+		return ErrPrestateMismatch
 	}
 	return nil
 }
 
 // MonitorGame monitors the fault dispute game and attempts to progress it.
 func (s *Service) MonitorGame(ctx context.Context) error {
+	if s.server != nil {
+		if err := s.server.Start(); err != nil {
+			return fmt.Errorf("error starting RPC server: %w", err)
+		}
+		s.logger.Info("started RPC server", "endpoint", s.server.Endpoint(), "socket", s.server.SocketPath())
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := s.server.Stop(ctx); err != nil {
+				s.logger.Error("error stopping RPC server", "err", err)
+			}
+		}()
+	}
+	if s.verify != nil {
+		if err := s.verify.Start(); err != nil {
+			return fmt.Errorf("error starting verify RPC server: %w", err)
+		}
+		s.logger.Info("started verify RPC server", "endpoint", s.verify.Endpoint())
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := s.verify.Stop(ctx); err != nil {
+				s.logger.Error("error stopping verify RPC server", "err", err)
+			}
+		}()
+	}
+	s.costs.Start(ctx)
+	s.disk.Start(ctx)
+	if s.alerts != nil {
+		s.alerts.Start(ctx)
+	}
+	if s.reporter != nil {
+		s.reporter.Start(ctx)
+	}
+	if s.rollup != nil {
+		s.rollup.Start(ctx)
+	}
+	if s.tuner != nil {
+		s.tuner.Start(ctx)
+	}
+	if s.mempool != nil {
+		s.mempool.Start(ctx)
+	}
+	if s.profiler != nil {
+		s.profiler.Start(ctx)
+	}
+	if s.attacks != nil {
+		s.attacks.Start(ctx)
+	}
 	s.sched.Start(ctx)
 	defer s.sched.Close()
 	return s.monitor.MonitorGames(ctx)
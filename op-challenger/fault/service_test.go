@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -17,6 +20,26 @@ var (
 	mockLoaderError        = fmt.Errorf("mock loader error")
 )
 
+func TestServiceOptions(t *testing.T) {
+	require.Equal(t, clock.SystemClock, newServiceOptions().clock)
+
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	require.Same(t, cl, newServiceOptions(WithClock(cl)).clock)
+
+	require.Empty(t, newServiceOptions().players)
+	created := 0
+	create := func(_ context.Context, _ GameServices, _ common.Address, _ string) (scheduler.GamePlayer, error) {
+		created++
+		return nil, nil
+	}
+	players := newServiceOptions(WithGamePlayer(5, create), WithGamePlayer(7, create)).players
+	require.Len(t, players, 2)
+	_, err := players[5](context.Background(), GameServices{}, common.Address{}, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+	require.Contains(t, players, uint8(7))
+}
+
 // TestValidateAbsolutePrestate tests that the absolute prestate is validated
 // correctly by the service component.
 func TestValidateAbsolutePrestate(t *testing.T) {
@@ -49,7 +72,7 @@ func TestValidateAbsolutePrestate(t *testing.T) {
 		mockTraceProvider := newMockTraceProvider(false, []byte{0x00, 0x01, 0x02, 0x03})
 		mockLoader := newMockLoader(false, []byte{0x00})
 		err := ValidateAbsolutePrestate(context.Background(), mockTraceProvider, mockLoader)
-		require.Error(t, err)
+		require.ErrorIs(t, err, ErrPrestateMismatch)
 	})
 }
 
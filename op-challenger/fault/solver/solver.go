@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -75,15 +78,14 @@ func (s *Solver) AttemptStep(ctx context.Context, claim types.Claim, agreeWithCl
 
 	if !claimCorrect {
 		// Attack the claim by executing step index, so we need to get the pre-state of that index
-		preState, proofData, oracleData, err = s.trace.GetStepData(ctx, index)
+		preState, proofData, oracleData, err = s.trace.GetStepData(ctx, providerIndex(index))
 		if err != nil {
 			return StepData{}, err
 		}
 	} else {
 		// We agree with the claim so Defend and use this claim as the starting point to execute the step after
 		// Thus we need the pre-state of the next step
-		// Note: This makes our maximum depth 63 because we need to add 1 without overflowing.
-		preState, proofData, oracleData, err = s.trace.GetStepData(ctx, index+1)
+		preState, proofData, oracleData, err = s.trace.GetStepData(ctx, providerIndex(new(big.Int).Add(index, big.NewInt(1))))
 		if err != nil {
 			return StepData{}, err
 		}
@@ -98,6 +100,15 @@ func (s *Solver) AttemptStep(ctx context.Context, claim types.Claim, agreeWithCl
 	}, nil
 }
 
+// StepPostState returns the claim value of the state the step is expected to transition to.
+func (s *Solver) StepPostState(ctx context.Context, step StepData) (common.Hash, error) {
+	index := step.LeafClaim.TraceIndex(s.gameDepth)
+	if !step.IsAttack {
+		index = new(big.Int).Add(index, big.NewInt(1))
+	}
+	return s.trace.Get(ctx, providerIndex(index))
+}
+
 // attack returns a response that attacks the claim.
 func (s *Solver) attack(ctx context.Context, claim types.Claim) (*types.Claim, error) {
 	position := claim.Attack()
@@ -136,8 +147,19 @@ func (s *Solver) agreeWithClaim(ctx context.Context, claim types.ClaimData) (boo
 }
 
 // traceAtPosition returns the [common.Hash] from internal [TraceProvider] at the given [Position].
-func (s *Solver) traceAtPosition(ctx context.Context, p types.Position) (common.Hash, error) {
+func (s *Solver) traceAtPosition(ctx context.Context, p positions.Position) (common.Hash, error) {
 	index := p.TraceIndex(s.gameDepth)
-	hash, err := s.trace.Get(ctx, index)
+	hash, err := s.trace.Get(ctx, providerIndex(index))
 	return hash, err
 }
+
+// providerIndex converts a trace index to the uint64 index used by [types.TraceProvider].
+// Indices that don't fit in a uint64 are capped to the largest uint64 rather than overflowing, so the provider
+// treats them like any other index past its maximum: the cannon provider returns the final state and the
+// alphabet provider returns ErrIndexTooLarge.
+func providerIndex(index *big.Int) uint64 {
+	if !index.IsUint64() {
+		return math.MaxUint64
+	}
+	return index.Uint64()
+}
@@ -3,11 +3,15 @@ package solver_test
 import (
 	"context"
 	"errors"
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -217,3 +221,73 @@ func TestAttemptStep(t *testing.T) {
 		})
 	}
 }
+
+func TestStepPostState(t *testing.T) {
+	maxDepth := 3
+	builder := test.NewAlphabetClaimBuilder(t, maxDepth)
+	s := solver.NewSolver(maxDepth, builder.CorrectTraceProvider())
+
+	t.Run("Attack", func(t *testing.T) {
+		step, err := s.AttemptStep(context.Background(), builder.CreateLeafClaim(4, false), false)
+		require.NoError(t, err)
+		require.True(t, step.IsAttack)
+		postState, err := s.StepPostState(context.Background(), step)
+		require.NoError(t, err)
+		require.Equal(t, builder.CorrectClaim(4), postState)
+	})
+
+	t.Run("Defend", func(t *testing.T) {
+		step, err := s.AttemptStep(context.Background(), builder.CreateLeafClaim(4, true), false)
+		require.NoError(t, err)
+		require.False(t, step.IsAttack)
+		postState, err := s.StepPostState(context.Background(), step)
+		require.NoError(t, err)
+		require.Equal(t, builder.CorrectClaim(5), postState)
+	})
+}
+
+func TestDeepGameCapsTraceIndex(t *testing.T) {
+	maxDepth := 70
+	provider := &indexRecordingProvider{}
+	s := solver.NewSolver(maxDepth, provider)
+	root := types.Claim{
+		ClaimData: types.ClaimData{Value: common.Hash{0xaa}, Position: positions.NewPosition(0, big.NewInt(0))},
+	}
+	move, err := s.NextMove(context.Background(), root, false)
+	require.NoError(t, err)
+	require.NotNil(t, move)
+	require.Equal(t, 1, move.Depth())
+	// Both the root and attack trace indices are beyond the range of a uint64
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64}, provider.requested)
+
+	// Agreeing with the last leaf requires the step data for the index after it, which must not overflow.
+	lastIndex := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(maxDepth)), big.NewInt(1))
+	leaf := types.Claim{
+		ClaimData: types.ClaimData{Value: common.Hash{0x01}, Position: positions.NewPosition(maxDepth, lastIndex)},
+	}
+	provider.requested = nil
+	step, err := s.AttemptStep(context.Background(), leaf, false)
+	require.NoError(t, err)
+	require.False(t, step.IsAttack)
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64}, provider.requested)
+}
+
+// indexRecordingProvider is a [types.TraceProvider] that records the requested indices and returns a
+// value that never matches the claim, so the solver always disagrees with it.
+type indexRecordingProvider struct {
+	requested []uint64
+}
+
+func (p *indexRecordingProvider) Get(_ context.Context, i uint64) (common.Hash, error) {
+	p.requested = append(p.requested, i)
+	return common.Hash{0x01}, nil
+}
+
+func (p *indexRecordingProvider) GetStepData(_ context.Context, i uint64) ([]byte, []byte, *types.PreimageOracleData, error) {
+	p.requested = append(p.requested, i)
+	return []byte{0x01}, nil, nil, nil
+}
+
+func (p *indexRecordingProvider) AbsolutePreState(_ context.Context) ([]byte, error) {
+	return []byte{0x00}, nil
+}
@@ -0,0 +1,138 @@
+package fault
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// maxRecentResolutions is the number of completed games retained by the [statusRegistry].
+const maxRecentResolutions = 20
+
+// PendingMoves reports the number of transactions sent for a game that have not yet been included.
+type PendingMoves interface {
+	PendingMoves() uint64
+}
+
+// StatusRecorder receives updates from game players so the state of each game can be reported.
+type StatusRecorder interface {
+	UpdateGame(info rpc.GameInfo, pending PendingMoves)
+	GameResolved(addr common.Address, status types.GameStatus, won bool)
+	RemoveGame(addr common.Address)
+}
+
+type gameEntry struct {
+	info    rpc.GameInfo
+	pending PendingMoves
+}
+
+// statusRegistry tracks the latest known state of each game being played and the most recently completed games.
+// It is updated by the game players and read by the admin RPC API so is safe for concurrent use.
+type statusRegistry struct {
+	mu          sync.Mutex
+	clock       clock.Clock
+	games       map[common.Address]*gameEntry
+	resolutions []rpc.GameResolution
+
+	// pendingGames are games that have been created but don't yet have the minimum confirmations
+	pendingGames     []common.Address
+	pendingUpdatedAt uint64
+}
+
+func newStatusRegistry(cl clock.Clock) *statusRegistry {
+	return &statusRegistry{
+		clock: cl,
+		games: make(map[common.Address]*gameEntry),
+	}
+}
+
+// UpdateGame records the latest state of an in progress game.
+// The number of pending moves is read from pending each time the games are listed so it is always current.
+func (r *statusRegistry) UpdateGame(info rpc.GameInfo, pending PendingMoves) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	info.UpdatedAt = uint64(r.clock.Now().Unix())
+	r.games[info.Address] = &gameEntry{info: info, pending: pending}
+}
+
+// GameResolved records that a game has completed and stops reporting it as in progress.
+func (r *statusRegistry) GameResolved(addr common.Address, status types.GameStatus, won bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.games, addr)
+	resolution := rpc.GameResolution{
+		Address:    addr,
+		Status:     status,
+		Won:        won,
+		ResolvedAt: uint64(r.clock.Now().Unix()),
+	}
+	r.resolutions = append([]rpc.GameResolution{resolution}, r.resolutions...)
+	if len(r.resolutions) > maxRecentResolutions {
+		r.resolutions = r.resolutions[:maxRecentResolutions]
+	}
+}
+
+// RemoveGame stops reporting a game, typically because it is no longer being played.
+func (r *statusRegistry) RemoveGame(addr common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.games, addr)
+}
+
+// UpdatePendingGames replaces the set of games that don't yet have the minimum confirmations.
+func (r *statusRegistry) UpdatePendingGames(games []common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pendingGames = append([]common.Address(nil), games...)
+	r.pendingUpdatedAt = uint64(r.clock.Now().Unix())
+}
+
+// Games returns the in progress games, ordered by deadline with the most urgent first.
+// Games pending confirmation are listed after all other games.
+func (r *statusRegistry) Games() []rpc.GameInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	games := make([]rpc.GameInfo, 0, len(r.games)+len(r.pendingGames))
+	for _, entry := range r.games {
+		info := entry.info
+		if entry.pending != nil {
+			info.PendingMoves = entry.pending.PendingMoves()
+		}
+		games = append(games, info)
+	}
+	for _, addr := range r.pendingGames {
+		if _, ok := r.games[addr]; ok {
+			continue
+		}
+		games = append(games, rpc.GameInfo{
+			Address:     addr,
+			Status:      types.GameStatusInProgress,
+			BondedValue: (*hexutil.Big)(big.NewInt(0)),
+			UpdatedAt:   r.pendingUpdatedAt,
+			Pending:     true,
+		})
+	}
+	sort.Slice(games, func(i, j int) bool {
+		if games[i].Pending != games[j].Pending {
+			return !games[i].Pending
+		}
+		if games[i].Deadline != games[j].Deadline {
+			return games[i].Deadline < games[j].Deadline
+		}
+		return games[i].Address.Hex() < games[j].Address.Hex()
+	})
+	return games
+}
+
+// RecentResolutions returns the most recently completed games, newest first.
+func (r *statusRegistry) RecentResolutions() []rpc.GameResolution {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]rpc.GameResolution(nil), r.resolutions...)
+}
@@ -0,0 +1,89 @@
+package fault
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusRegistry_Games(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	registry := newStatusRegistry(cl)
+	require.Empty(t, registry.Games())
+
+	pending := &stubPendingMoves{}
+	registry.UpdateGame(rpc.GameInfo{Address: common.Address{0xaa}, Deadline: 500, ClaimCount: 1}, pending)
+	registry.UpdateGame(rpc.GameInfo{Address: common.Address{0xbb}, Deadline: 200, ClaimCount: 2}, nil)
+
+	games := registry.Games()
+	require.Len(t, games, 2)
+	require.Equal(t, common.Address{0xbb}, games[0].Address, "most urgent game first")
+	require.Equal(t, common.Address{0xaa}, games[1].Address)
+	require.Equal(t, uint64(1000), games[0].UpdatedAt)
+
+	// Pending moves are read when the games are listed
+	pending.count = 2
+	require.Equal(t, uint64(2), registry.Games()[1].PendingMoves)
+
+	// Updates replace the previous state
+	cl.AdvanceTime(10 * time.Second)
+	registry.UpdateGame(rpc.GameInfo{Address: common.Address{0xbb}, Deadline: 200, ClaimCount: 5}, nil)
+	games = registry.Games()
+	require.Len(t, games, 2)
+	require.Equal(t, uint64(5), games[0].ClaimCount)
+	require.Equal(t, uint64(1010), games[0].UpdatedAt)
+
+	registry.RemoveGame(common.Address{0xbb})
+	games = registry.Games()
+	require.Len(t, games, 1)
+	require.Equal(t, common.Address{0xaa}, games[0].Address)
+}
+
+func TestStatusRegistry_PendingGames(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	registry := newStatusRegistry(cl)
+	registry.UpdateGame(rpc.GameInfo{Address: common.Address{0xaa}, Deadline: 500}, nil)
+	registry.UpdatePendingGames([]common.Address{{0xcc}, {0xaa}})
+
+	games := registry.Games()
+	require.Len(t, games, 2, "should not list a game that is being played as pending")
+	require.Equal(t, common.Address{0xaa}, games[0].Address)
+	require.False(t, games[0].Pending)
+	require.Equal(t, rpc.GameInfo{
+		Address:     common.Address{0xcc},
+		Status:      types.GameStatusInProgress,
+		BondedValue: (*hexutil.Big)(big.NewInt(0)),
+		UpdatedAt:   1000,
+		Pending:     true,
+	}, games[1], "should list pending games last")
+
+	registry.UpdatePendingGames(nil)
+	require.Len(t, registry.Games(), 1)
+}
+
+func TestStatusRegistry_RecentResolutions(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	registry := newStatusRegistry(cl)
+	require.Empty(t, registry.RecentResolutions())
+
+	registry.UpdateGame(rpc.GameInfo{Address: common.Address{0xaa}}, nil)
+	registry.GameResolved(common.Address{0xaa}, types.GameStatusChallengerWon, true)
+	require.Empty(t, registry.Games(), "resolved game is no longer in progress")
+	require.Equal(t, []rpc.GameResolution{
+		{Address: common.Address{0xaa}, Status: types.GameStatusChallengerWon, Won: true, ResolvedAt: 1000},
+	}, registry.RecentResolutions())
+
+	for i := 0; i < maxRecentResolutions+5; i++ {
+		registry.GameResolved(common.Address{byte(i)}, types.GameStatusDefenderWon, false)
+	}
+	resolutions := registry.RecentResolutions()
+	require.Len(t, resolutions, maxRecentResolutions)
+	require.Equal(t, common.Address{byte(maxRecentResolutions + 4)}, resolutions[0].Address, "newest first")
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -58,31 +59,33 @@ func (c *ClaimBuilder) CorrectOracleData(idx uint64) *types.PreimageOracleData {
 	return data
 }
 
-func (c *ClaimBuilder) incorrectClaim(idx uint64) common.Hash {
-	return common.BigToHash(new(big.Int).SetUint64(idx))
+func (c *ClaimBuilder) incorrectClaim(idx *big.Int) common.Hash {
+	return common.BigToHash(idx)
 }
 
-func (c *ClaimBuilder) claim(idx uint64, correct bool) common.Hash {
+func (c *ClaimBuilder) claim(idx *big.Int, correct bool) common.Hash {
 	if correct {
-		return c.CorrectClaim(idx)
+		c.require.Truef(idx.IsUint64(), "trace index %v too large for trace provider", idx)
+		return c.CorrectClaim(idx.Uint64())
 	} else {
 		return c.incorrectClaim(idx)
 	}
 }
 
 func (c *ClaimBuilder) CreateRootClaim(correct bool) types.Claim {
-	value := c.claim((1<<c.maxDepth)-1, correct)
+	rootPos := positions.NewPosition(0, big.NewInt(0))
+	value := c.claim(rootPos.TraceIndex(c.maxDepth), correct)
 	return types.Claim{
 		ClaimData: types.ClaimData{
 			Value:    value,
-			Position: types.NewPosition(0, 0),
+			Position: rootPos,
 		},
 	}
 }
 
 func (c *ClaimBuilder) CreateLeafClaim(traceIndex uint64, correct bool) types.Claim {
-	parentPos := types.NewPosition(c.maxDepth-1, 0)
-	pos := types.NewPosition(c.maxDepth, int(traceIndex))
+	parentPos := positions.NewPosition(c.maxDepth-1, big.NewInt(0))
+	pos := positions.NewPosition(c.maxDepth, new(big.Int).SetUint64(traceIndex))
 	return types.Claim{
 		ClaimData: types.ClaimData{
 			Value:    c.claim(pos.TraceIndex(c.maxDepth), correct),
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// Clock is the chess clock of a claim.
+// The FaultDisputeGame contract packs it into a uint128 with the duration in the upper 64 bits and the timestamp
+// in the lower 64 bits, both in seconds.
+type Clock struct {
+	// Duration is the total time the team that posted the claim had used when it was posted.
+	Duration time.Duration
+	// Timestamp is the time the claim was posted.
+	Timestamp time.Time
+}
+
+// NewClock creates a new [Clock] from a duration and unix timestamp in seconds.
+func NewClock(duration uint64, timestamp uint64) Clock {
+	return Clock{
+		Duration:  time.Duration(duration) * time.Second,
+		Timestamp: time.Unix(int64(timestamp), 0),
+	}
+}
+
+// ClockFromPacked unpacks a [Clock] from the uint128 representation used by the FaultDisputeGame contract.
+func ClockFromPacked(packed *big.Int) Clock {
+	timestamp := new(big.Int).And(packed, new(big.Int).SetUint64(math.MaxUint64))
+	duration := new(big.Int).Rsh(packed, 64)
+	return NewClock(duration.Uint64(), timestamp.Uint64())
+}
+
+// Packed returns the uint128 representation of the clock used by the FaultDisputeGame contract.
+func (c Clock) Packed() *big.Int {
+	packed := new(big.Int).SetUint64(uint64(c.Duration / time.Second))
+	packed.Lsh(packed, 64)
+	return packed.Or(packed, new(big.Int).SetUint64(uint64(c.Timestamp.Unix())))
+}
+
+// ChessClock applies the chess clock rules of the FaultDisputeGame contract.
+// Each team may use at most half of the game duration in total. When a claim is countered, the countering team
+// inherits the time it had already used when it posted the claim's parent and is charged the time since the claim
+// was posted.
+type ChessClock struct {
+	// MaxDuration is the maximum time each team may use.
+	MaxDuration time.Duration
+}
+
+// NewChessClock creates a [ChessClock] for a game with the specified duration.
+func NewChessClock(gameDuration time.Duration) ChessClock {
+	return ChessClock{MaxDuration: gameDuration / 2}
+}
+
+// ElapsedToCounter returns the time the countering team will have used if claim is countered at now.
+// parent is the clock of the claim's parent or nil if the claim is the root claim.
+func (c ChessClock) ElapsedToCounter(claim Clock, parent *Clock, now time.Time) time.Duration {
+	var inherited time.Duration
+	if parent != nil {
+		inherited = parent.Duration
+	}
+	return inherited + now.Sub(claim.Timestamp)
+}
+
+// RemainingToCounter returns the time left to counter claim before the countering team's clock expires.
+// Returns 0 if the clock has already expired.
+// parent is the clock of the claim's parent or nil if the claim is the root claim.
+func (c ChessClock) RemainingToCounter(claim Clock, parent *Clock, now time.Time) time.Duration {
+	remaining := c.MaxDuration - c.ElapsedToCounter(claim, parent, now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// CanCounter returns true if claim can still be countered at now.
+// parent is the clock of the claim's parent or nil if the claim is the root claim.
+func (c ChessClock) CanCounter(claim Clock, parent *Clock, now time.Time) bool {
+	return c.ElapsedToCounter(claim, parent, now) <= c.MaxDuration
+}
+
+// Expired returns true if the clock has run out at now, which the contract requires before resolving the game.
+// No claim's clock expires before the root claim's clock, so the game can't be resolved until the root clock expires.
+func (c ChessClock) Expired(clock Clock, now time.Time) bool {
+	return clock.Duration+now.Sub(clock.Timestamp) > c.MaxDuration
+}
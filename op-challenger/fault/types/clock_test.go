@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockFromPacked(t *testing.T) {
+	// Duration of 0x64 seconds in the upper 64 bits, timestamp of 0x1234 in the lower 64 bits
+	packed, ok := new(big.Int).SetString("0000000000000064"+"0000000000001234", 16)
+	require.True(t, ok)
+	clock := ClockFromPacked(packed)
+	require.Equal(t, 100*time.Second, clock.Duration)
+	require.Equal(t, time.Unix(0x1234, 0), clock.Timestamp)
+	require.Equal(t, packed, clock.Packed())
+}
+
+func TestClockPackedRoundTrip(t *testing.T) {
+	for _, clock := range []Clock{NewClock(0, 0), NewClock(1, 2), NewClock(302400, 1_700_000_000), NewClock(1<<63, 1<<62)} {
+		require.Equal(t, clock, ClockFromPacked(clock.Packed()))
+	}
+}
+
+func TestChessClock(t *testing.T) {
+	chessClock := NewChessClock(200 * time.Second)
+	require.Equal(t, 100*time.Second, chessClock.MaxDuration)
+
+	root := NewClock(0, 1000)
+	child := NewClock(30, 1030)
+
+	t.Run("CounterRoot", func(t *testing.T) {
+		now := time.Unix(1040, 0)
+		require.Equal(t, 40*time.Second, chessClock.ElapsedToCounter(root, nil, now))
+		require.Equal(t, 60*time.Second, chessClock.RemainingToCounter(root, nil, now))
+		require.True(t, chessClock.CanCounter(root, nil, now))
+	})
+
+	t.Run("InheritParentDuration", func(t *testing.T) {
+		// The team countering child posted the root claim, which had used no time.
+		now := time.Unix(1050, 0)
+		require.Equal(t, 20*time.Second, chessClock.ElapsedToCounter(child, &root, now))
+
+		grandchild := NewClock(50, 1060)
+		// The team countering grandchild posted child, having used 30 seconds.
+		require.Equal(t, 40*time.Second, chessClock.ElapsedToCounter(grandchild, &child, time.Unix(1070, 0)))
+		require.Equal(t, 60*time.Second, chessClock.RemainingToCounter(grandchild, &child, time.Unix(1070, 0)))
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		require.True(t, chessClock.CanCounter(child, &root, time.Unix(1130, 0)), "can counter with exactly no time remaining")
+		require.Zero(t, chessClock.RemainingToCounter(child, &root, time.Unix(1130, 0)))
+		require.False(t, chessClock.CanCounter(child, &root, time.Unix(1131, 0)))
+		require.Zero(t, chessClock.RemainingToCounter(child, &root, time.Unix(2000, 0)), "should not return negative durations")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		require.False(t, chessClock.Expired(root, time.Unix(1100, 0)))
+		require.True(t, chessClock.Expired(root, time.Unix(1101, 0)))
+		require.False(t, chessClock.Expired(child, time.Unix(1100, 0)))
+		require.True(t, chessClock.Expired(child, time.Unix(1101, 0)))
+	})
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -28,6 +30,10 @@ type Game interface {
 
 	// AgreeWithClaimLevel returns if the game state agrees with the provided claim level.
 	AgreeWithClaimLevel(claim Claim) bool
+
+	// GetParent returns the parent of the provided [Claim].
+	// Returns [ErrClaimNotFound] for the root claim or if the parent is not in the game state.
+	GetParent(claim Claim) (Claim, error)
 }
 
 type extendedClaim struct {
@@ -40,15 +46,15 @@ type extendedClaim struct {
 type gameState struct {
 	agreeWithProposedOutput bool
 	root                    ClaimData
-	claims                  map[ClaimData]*extendedClaim
+	claims                  map[common.Hash]*extendedClaim
 	depth                   uint64
 }
 
 // NewGameState returns a new game state.
 // The provided [Claim] is used as the root node.
 func NewGameState(agreeWithProposedOutput bool, root Claim, depth uint64) *gameState {
-	claims := make(map[ClaimData]*extendedClaim)
-	claims[root.ClaimData] = &extendedClaim{
+	claims := make(map[common.Hash]*extendedClaim)
+	claims[root.ID()] = &extendedClaim{
 		self:     root,
 		children: make([]ClaimData, 0),
 	}
@@ -88,13 +94,13 @@ func (g *gameState) Put(claim Claim) error {
 	if claim.IsRoot() || g.IsDuplicate(claim) {
 		return ErrClaimExists
 	}
-	parent, ok := g.claims[claim.Parent]
+	parent, ok := g.claims[claim.Parent.ID()]
 	if !ok {
 		return errors.New("no parent claim")
 	} else {
 		parent.children = append(parent.children, claim.ClaimData)
 	}
-	g.claims[claim.ClaimData] = &extendedClaim{
+	g.claims[claim.ID()] = &extendedClaim{
 		self:     claim,
 		children: make([]ClaimData, 0),
 	}
@@ -102,7 +108,7 @@ func (g *gameState) Put(claim Claim) error {
 }
 
 func (g *gameState) IsDuplicate(claim Claim) bool {
-	_, ok := g.claims[claim.ClaimData]
+	_, ok := g.claims[claim.ID()]
 	return ok
 }
 
@@ -113,20 +119,20 @@ func (g *gameState) Claims() []Claim {
 		item := queue[0]
 		queue = queue[1:]
 		queue = append(queue, g.getChildren(item)...)
-		out = append(out, g.claims[item].self)
+		out = append(out, g.claims[item.ID()].self)
 	}
 	return out
 }
 
 func (g *gameState) getChildren(c ClaimData) []ClaimData {
-	return g.claims[c].children
+	return g.claims[c.ID()].children
 }
 
-func (g *gameState) getParent(claim Claim) (Claim, error) {
+func (g *gameState) GetParent(claim Claim) (Claim, error) {
 	if claim.IsRoot() {
 		return Claim{}, ErrClaimNotFound
 	}
-	if parent, ok := g.claims[claim.Parent]; !ok {
+	if parent, ok := g.claims[claim.Parent.ID()]; !ok {
 		return Claim{}, ErrClaimNotFound
 	} else {
 		return parent.self, nil
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
@@ -15,21 +17,21 @@ func createTestClaims() (Claim, Claim, Claim, Claim) {
 	root := Claim{
 		ClaimData: ClaimData{
 			Value:    common.HexToHash("0x000000000000000000000000000000000000000000000000000000000000077a"),
-			Position: NewPosition(0, 0),
+			Position: positions.NewPosition(0, big.NewInt(0)),
 		},
 		// Root claim has no parent
 	}
 	top := Claim{
 		ClaimData: ClaimData{
 			Value:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000364"),
-			Position: NewPosition(1, 0),
+			Position: positions.NewPosition(1, big.NewInt(0)),
 		},
 		Parent: root.ClaimData,
 	}
 	middle := Claim{
 		ClaimData: ClaimData{
 			Value:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000578"),
-			Position: NewPosition(2, 2),
+			Position: positions.NewPosition(2, big.NewInt(2)),
 		},
 		Parent: top.ClaimData,
 	}
@@ -37,7 +39,7 @@ func createTestClaims() (Claim, Claim, Claim, Claim) {
 	bottom := Claim{
 		ClaimData: ClaimData{
 			Value:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000465"),
-			Position: NewPosition(3, 4),
+			Position: positions.NewPosition(3, big.NewInt(4)),
 		},
 		Parent: middle.ClaimData,
 	}
@@ -104,20 +106,20 @@ func TestGame_PutAll_ParentsAndChildren(t *testing.T) {
 	g := NewGameState(false, root, testMaxDepth)
 
 	// We should not be able to get the parent of the root claim.
-	parent, err := g.getParent(root)
+	parent, err := g.GetParent(root)
 	require.ErrorIs(t, err, ErrClaimNotFound)
 	require.Equal(t, parent, Claim{})
 
 	// Put the rest of the claims in the state.
 	err = g.PutAll([]Claim{top, middle, bottom})
 	require.NoError(t, err)
-	parent, err = g.getParent(top)
+	parent, err = g.GetParent(top)
 	require.NoError(t, err)
 	require.Equal(t, parent, root)
-	parent, err = g.getParent(middle)
+	parent, err = g.GetParent(middle)
 	require.NoError(t, err)
 	require.Equal(t, parent, top)
-	parent, err = g.getParent(bottom)
+	parent, err = g.GetParent(bottom)
 	require.NoError(t, err)
 	require.Equal(t, parent, middle)
 }
@@ -145,28 +147,28 @@ func TestGame_Put_ParentsAndChildren(t *testing.T) {
 	g := NewGameState(false, root, testMaxDepth)
 
 	// We should not be able to get the parent of the root claim.
-	parent, err := g.getParent(root)
+	parent, err := g.GetParent(root)
 	require.ErrorIs(t, err, ErrClaimNotFound)
 	require.Equal(t, parent, Claim{})
 
 	// Put + Check Top
 	err = g.Put(top)
 	require.NoError(t, err)
-	parent, err = g.getParent(top)
+	parent, err = g.GetParent(top)
 	require.NoError(t, err)
 	require.Equal(t, parent, root)
 
 	// Put + Check Top Middle
 	err = g.Put(middle)
 	require.NoError(t, err)
-	parent, err = g.getParent(middle)
+	parent, err = g.GetParent(middle)
 	require.NoError(t, err)
 	require.Equal(t, parent, top)
 
 	// Put + Check Top Bottom
 	err = g.Put(bottom)
 	require.NoError(t, err)
-	parent, err = g.getParent(bottom)
+	parent, err = g.GetParent(bottom)
 	require.NoError(t, err)
 	require.Equal(t, parent, middle)
 }
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RootParentIndex is the parent index the FaultDisputeGame contract stores for the root claim.
+const RootParentIndex = math.MaxUint32
+
+var ErrMoveMismatch = errors.New("move event does not match claim")
+
+// ClaimDataBinding is the claim data returned by the claimData method of the FaultDisputeGame bindings.
+// The bindings return an unnamed struct, which can be passed directly wherever a ClaimDataBinding is expected.
+type ClaimDataBinding = struct {
+	ParentIndex uint32
+	Countered   bool
+	Claim       [32]byte
+	Position    *big.Int
+	Clock       *big.Int
+}
+
+// GameClaim is a claim as stored in the claimData array of the FaultDisputeGame contract.
+type GameClaim struct {
+	// Index is the index of the claim in the claimData array.
+	Index uint64
+	// ParentIndex is the index of the claim's parent, or [RootParentIndex] for the root claim.
+	ParentIndex uint64
+	Value       common.Hash
+	Position    positions.Position
+	Countered   bool
+	Clock       Clock
+	// Bond is the value bonded when the claim was posted. Nil if unknown, as not all contract versions record it.
+	Bond *big.Int
+	// Claimant is the account that posted the claim. The zero address if unknown, as it is only available from the
+	// game's Move events.
+	Claimant common.Address
+}
+
+// NewGameClaim decodes the claim at index from the data returned by the FaultDisputeGame bindings.
+func NewGameClaim(index uint64, data ClaimDataBinding) GameClaim {
+	return GameClaim{
+		Index:       index,
+		ParentIndex: uint64(data.ParentIndex),
+		Value:       data.Claim,
+		Position:    positions.NewPositionFromGIndex(data.Position),
+		Countered:   data.Countered,
+		Clock:       ClockFromPacked(data.Clock),
+	}
+}
+
+// IsRoot returns true if this is the root claim of the game.
+func (c GameClaim) IsRoot() bool {
+	return c.Position.IsRootPosition()
+}
+
+// ClaimData returns the value and position of the claim.
+func (c GameClaim) ClaimData() ClaimData {
+	return ClaimData{Value: c.Value, Position: c.Position}
+}
+
+// ToClaim converts the claim to the [Claim] used by the solver.
+// parent is the claim's parent and is ignored for the root claim.
+func (c GameClaim) ToClaim(parent GameClaim) Claim {
+	claim := Claim{
+		ClaimData:           c.ClaimData(),
+		Countered:           c.Countered,
+		Clock:               c.Clock,
+		ContractIndex:       int(c.Index),
+		ParentContractIndex: int(c.ParentIndex),
+	}
+	if !c.IsRoot() {
+		claim.Parent = parent.ClaimData()
+	}
+	return claim
+}
+
+// ApplyMoves sets the claimant of each claim from the game's Move events, in the order they were emitted.
+// Each move adds the next claim after the root claim, so the claimant of the root claim is not set.
+// Returns [ErrMoveMismatch] if a move doesn't match the claim it should have added.
+func ApplyMoves(claims []GameClaim, moves []*bindings.FaultDisputeGameMove) error {
+	for i, move := range moves {
+		index := i + 1
+		if index >= len(claims) {
+			return fmt.Errorf("%w: no claim at index %v", ErrMoveMismatch, index)
+		}
+		claim := &claims[index]
+		if claim.Value != move.Claim || move.ParentIndex == nil || claim.ParentIndex != move.ParentIndex.Uint64() {
+			return fmt.Errorf("%w: claim %v", ErrMoveMismatch, index)
+		}
+		claim.Claimant = move.Claimant
+	}
+	return nil
+}
+
+// gameClaimJSON is the JSON encoding of a [GameClaim], using the same field names as the admin API.
+type gameClaimJSON struct {
+	Index         uint64         `json:"index"`
+	ParentIndex   uint64         `json:"parentIndex"`
+	Value         common.Hash    `json:"value"`
+	Position      *hexutil.Big   `json:"position"` // Generalized index of the claim's position
+	Countered     bool           `json:"countered"`
+	Clock         uint64         `json:"clock"`         // Unix timestamp of when the claim was posted
+	ClockDuration uint64         `json:"clockDuration"` // Seconds the claim's team had used when the claim was posted
+	Bond          *hexutil.Big   `json:"bond,omitempty"`
+	Claimant      common.Address `json:"claimant"`
+}
+
+func (c GameClaim) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gameClaimJSON{
+		Index:         c.Index,
+		ParentIndex:   c.ParentIndex,
+		Value:         c.Value,
+		Position:      (*hexutil.Big)(c.Position.ToGIndex()),
+		Countered:     c.Countered,
+		Clock:         uint64(c.Clock.Timestamp.Unix()),
+		ClockDuration: uint64(c.Clock.Duration.Seconds()),
+		Bond:          (*hexutil.Big)(c.Bond),
+		Claimant:      c.Claimant,
+	})
+}
+
+func (c *GameClaim) UnmarshalJSON(data []byte) error {
+	var dec gameClaimJSON
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if dec.Position == nil {
+		return errors.New("missing claim position")
+	}
+	*c = GameClaim{
+		Index:       dec.Index,
+		ParentIndex: dec.ParentIndex,
+		Value:       dec.Value,
+		Position:    positions.NewPositionFromGIndex(dec.Position.ToInt()),
+		Countered:   dec.Countered,
+		Clock:       NewClock(dec.ClockDuration, dec.Clock),
+		Bond:        (*big.Int)(dec.Bond),
+		Claimant:    dec.Claimant,
+	}
+	return nil
+}
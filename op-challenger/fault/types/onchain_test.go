@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGameClaim(t *testing.T) {
+	clock := NewClock(30, 1000)
+	data := ClaimDataBinding{
+		ParentIndex: 1,
+		Countered:   true,
+		Claim:       common.Hash{0xaa},
+		Position:    big.NewInt(5),
+		Clock:       clock.Packed(),
+	}
+	claim := NewGameClaim(2, data)
+	require.Equal(t, GameClaim{
+		Index:       2,
+		ParentIndex: 1,
+		Value:       common.Hash{0xaa},
+		Position:    positions.NewPosition(2, big.NewInt(1)),
+		Countered:   true,
+		Clock:       clock,
+	}, claim)
+	require.False(t, claim.IsRoot())
+}
+
+func TestGameClaimToClaim(t *testing.T) {
+	root := GameClaim{
+		ParentIndex: RootParentIndex,
+		Value:       common.Hash{0x01},
+		Position:    positions.NewPositionFromGIndex(big.NewInt(1)),
+		Clock:       NewClock(0, 100),
+	}
+	child := GameClaim{
+		Index:     1,
+		Value:     common.Hash{0x02},
+		Position:  positions.NewPosition(1, big.NewInt(0)),
+		Countered: true,
+		Clock:     NewClock(10, 200),
+	}
+
+	t.Run("Root", func(t *testing.T) {
+		require.True(t, root.IsRoot())
+		require.Equal(t, Claim{
+			ClaimData:           ClaimData{Value: common.Hash{0x01}, Position: root.Position},
+			Clock:               root.Clock,
+			ParentContractIndex: RootParentIndex,
+		}, root.ToClaim(child))
+	})
+
+	t.Run("Child", func(t *testing.T) {
+		require.Equal(t, Claim{
+			ClaimData:     ClaimData{Value: common.Hash{0x02}, Position: child.Position},
+			Parent:        root.ClaimData(),
+			Countered:     true,
+			Clock:         child.Clock,
+			ContractIndex: 1,
+		}, child.ToClaim(root))
+	})
+}
+
+func TestApplyMoves(t *testing.T) {
+	claims := func() []GameClaim {
+		return []GameClaim{
+			{ParentIndex: RootParentIndex, Value: common.Hash{0x01}, Position: positions.NewPositionFromGIndex(big.NewInt(1))},
+			{Index: 1, Value: common.Hash{0x02}, Position: positions.NewPosition(1, big.NewInt(0))},
+			{Index: 2, ParentIndex: 1, Value: common.Hash{0x03}, Position: positions.NewPosition(2, big.NewInt(0))},
+		}
+	}
+	moves := []*bindings.FaultDisputeGameMove{
+		{ParentIndex: big.NewInt(0), Claim: common.Hash{0x02}, Claimant: common.Address{0xaa}},
+		{ParentIndex: big.NewInt(1), Claim: common.Hash{0x03}, Claimant: common.Address{0xbb}},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		claims := claims()
+		require.NoError(t, ApplyMoves(claims, moves))
+		require.Equal(t, common.Address{}, claims[0].Claimant)
+		require.Equal(t, common.Address{0xaa}, claims[1].Claimant)
+		require.Equal(t, common.Address{0xbb}, claims[2].Claimant)
+	})
+
+	t.Run("ValueMismatch", func(t *testing.T) {
+		invalid := []*bindings.FaultDisputeGameMove{{ParentIndex: big.NewInt(0), Claim: common.Hash{0xff}}}
+		require.ErrorIs(t, ApplyMoves(claims(), invalid), ErrMoveMismatch)
+	})
+
+	t.Run("ParentMismatch", func(t *testing.T) {
+		invalid := []*bindings.FaultDisputeGameMove{{ParentIndex: big.NewInt(1), Claim: common.Hash{0x02}}}
+		require.ErrorIs(t, ApplyMoves(claims(), invalid), ErrMoveMismatch)
+	})
+
+	t.Run("TooManyMoves", func(t *testing.T) {
+		require.ErrorIs(t, ApplyMoves(claims()[:2], moves), ErrMoveMismatch)
+	})
+}
+
+func TestGameClaimJSON(t *testing.T) {
+	claim := GameClaim{
+		Index:       2,
+		ParentIndex: 1,
+		Value:       common.Hash{0xaa},
+		Position:    positions.NewPosition(2, big.NewInt(1)),
+		Countered:   true,
+		Clock:       NewClock(30, 1000),
+		Bond:        big.NewInt(500),
+		Claimant:    common.Address{0xbb},
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		data, err := json.Marshal(claim)
+		require.NoError(t, err)
+		var decoded GameClaim
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, claim, decoded)
+	})
+
+	t.Run("Fields", func(t *testing.T) {
+		data, err := json.Marshal(claim)
+		require.NoError(t, err)
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(data, &fields))
+		require.Equal(t, "0x5", fields["position"])
+		require.Equal(t, float64(1000), fields["clock"])
+		require.Equal(t, float64(30), fields["clockDuration"])
+		require.Equal(t, "0x1f4", fields["bond"])
+	})
+
+	t.Run("NoBond", func(t *testing.T) {
+		claim := claim
+		claim.Bond = nil
+		data, err := json.Marshal(claim)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "bond")
+		var decoded GameClaim
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Nil(t, decoded.Bond)
+	})
+
+	t.Run("MissingPosition", func(t *testing.T) {
+		var decoded GameClaim
+		require.ErrorContains(t, json.Unmarshal([]byte(`{"index":1}`), &decoded), "position")
+	})
+}
@@ -2,11 +2,14 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -43,6 +46,20 @@ func GameStatusFromUint8(i uint8) (GameStatus, error) {
 	return GameStatus(i), nil
 }
 
+// UnmarshalJSON decodes the numeric JSON representation of a game status, rejecting unknown statuses.
+func (s *GameStatus) UnmarshalJSON(data []byte) error {
+	var i uint8
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fmt.Errorf("invalid game status: %w", err)
+	}
+	status, err := GameStatusFromUint8(i)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
 // PreimageOracleData encapsulates the preimage oracle data
 // to load into the onchain oracle.
 type PreimageOracleData struct {
@@ -91,8 +108,16 @@ type OracleUpdater interface {
 	UpdateOracle(ctx context.Context, data *PreimageOracleData) error
 }
 
+// PrestateProvider provides the absolute pre-state of a trace.
+type PrestateProvider interface {
+	// AbsolutePreState is the pre-image value of the trace that transitions to the trace value at index 0
+	AbsolutePreState(ctx context.Context) (preimage []byte, err error)
+}
+
 // TraceProvider is a generic way to get a claim value at a specific step in the trace.
 type TraceProvider interface {
+	PrestateProvider
+
 	// Get returns the claim value at the requested index.
 	// Get(i) = Keccak256(GetPreimage(i))
 	Get(ctx context.Context, i uint64) (common.Hash, error)
@@ -102,15 +127,18 @@ type TraceProvider interface {
 	// and any pre-image data that needs to be loaded into the oracle prior to execution (may be nil)
 	// The prestate returned from GetStepData for trace 10 should be the pre-image of the claim from trace 9
 	GetStepData(ctx context.Context, i uint64) (prestate []byte, proofData []byte, preimageData *PreimageOracleData, err error)
-
-	// AbsolutePreState is the pre-image value of the trace that transitions to the trace value at index 0
-	AbsolutePreState(ctx context.Context) (preimage []byte, err error)
 }
 
 // ClaimData is the core of a claim. It must be unique inside a specific game.
 type ClaimData struct {
 	Value common.Hash
-	Position
+	positions.Position
+}
+
+// ID returns an identifier for the claim that is unique within a game.
+// ClaimData can't be used as a map key directly as its Position contains a [big.Int].
+func (c ClaimData) ID() common.Hash {
+	return crypto.Keccak256Hash(c.Value.Bytes(), common.BigToHash(c.ToGIndex()).Bytes())
 }
 
 func (c *ClaimData) ValueBytes() [32]byte {
@@ -131,7 +159,7 @@ type Claim struct {
 	//       When caching is implemented for the Challenger, this will need
 	//       to be changed/removed to avoid invalid/stale contract state.
 	Countered bool
-	Clock     uint64
+	Clock     Clock
 	Parent    ClaimData
 	// Location of the claim & it's parent inside the contract. Does not exist
 	// for claims that have not made it to the contract.
@@ -147,5 +175,5 @@ func (c *Claim) IsRoot() bool {
 // DefendsParent returns true if the the claim is a defense (i.e. goes right) of the
 // parent. It returns false if the claim is an attack (i.e. goes left) of the parent.
 func (c *Claim) DefendsParent() bool {
-	return (c.IndexAtDepth() >> 1) != c.Parent.IndexAtDepth()
+	return new(big.Int).Rsh(c.IndexAtDepth(), 1).Cmp(c.Parent.IndexAtDepth()) != 0
 }
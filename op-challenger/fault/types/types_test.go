@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +33,26 @@ func TestGameStatusFromUint8(t *testing.T) {
 	})
 }
 
+func TestGameStatusUnmarshalJSON(t *testing.T) {
+	for _, status := range validGameStatuses {
+		t.Run(fmt.Sprintf("Valid Game Status %v", status), func(t *testing.T) {
+			var parsed GameStatus
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprint(uint8(status))), &parsed))
+			require.Equal(t, status, parsed)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		var parsed GameStatus
+		require.Error(t, json.Unmarshal([]byte("3"), &parsed))
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		var parsed GameStatus
+		require.Error(t, json.Unmarshal([]byte("256"), &parsed))
+	})
+}
+
 func TestNewPreimageOracleData(t *testing.T) {
 	t.Run("LocalData", func(t *testing.T) {
 		data := NewPreimageOracleData([]byte{1, 2, 3}, []byte{4, 5, 6}, 7)
@@ -46,3 +70,11 @@ func TestNewPreimageOracleData(t *testing.T) {
 		require.Equal(t, uint32(7), data.OracleOffset)
 	})
 }
+
+func TestClaimDataID(t *testing.T) {
+	value := common.Hash{0xaa}
+	deep := ClaimData{Value: value, Position: positions.NewPosition(100, new(big.Int).Lsh(big.NewInt(1), 99))}
+	require.Equal(t, deep.ID(), ClaimData{Value: value, Position: positions.NewPositionFromGIndex(deep.ToGIndex())}.ID())
+	require.NotEqual(t, deep.ID(), ClaimData{Value: value, Position: deep.Attack()}.ID())
+	require.NotEqual(t, deep.ID(), ClaimData{Value: common.Hash{0xbb}, Position: deep.Position}.ID())
+}
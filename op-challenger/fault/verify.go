@@ -0,0 +1,145 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrClaimIndexOutOfRange = errors.New("claim index out of range")
+	ErrTraceIndexTooLarge   = errors.New("trace index too large")
+	ErrVerifierBusy         = errors.New("another claim is already being verified")
+)
+
+// VerifierGameLoader loads the game data required to verify a claim.
+type VerifierGameLoader interface {
+	Loader
+	ClaimLoader
+	FetchGameDepth(ctx context.Context) (uint64, error)
+	FetchGameType(ctx context.Context) (uint8, error)
+}
+
+// GameChecker checks that a game was created by the dispute game factory.
+type GameChecker interface {
+	CheckGame(ctx context.Context, game common.Address) error
+}
+
+// VerifierDisk provides the data directories of games.
+type VerifierDisk interface {
+	DirForGame(addr common.Address) string
+	Reserve(addr common.Address) error
+}
+
+type verifierLoaderCreator func(game common.Address) (VerifierGameLoader, error)
+type traceProviderCreator func(ctx context.Context, game common.Address, gameType uint8, dir string, gameDepth uint64) (types.TraceProvider, error)
+
+// claimVerifier computes the challenger's verdict on claims for the verify API.
+// Only games created by the dispute game factory can be verified. Trace data is generated in the game's data directory
+// using the same provider as the game's player, so a claim is never computed twice and the disk space used is reserved
+// like any other game. Only one claim is verified at a time and other requests are rejected rather than queued, so
+// third-party requests can't exhaust the resources needed to play games.
+type claimVerifier struct {
+	logger         log.Logger
+	games          GameChecker
+	disk           VerifierDisk
+	providers      *providerRegistry
+	createLoader   verifierLoaderCreator
+	createProvider traceProviderCreator
+	sem            chan struct{}
+}
+
+func newClaimVerifier(
+	logger log.Logger,
+	games GameChecker,
+	disk VerifierDisk,
+	providers *providerRegistry,
+	createLoader verifierLoaderCreator,
+	createProvider traceProviderCreator,
+) *claimVerifier {
+	return &claimVerifier{
+		logger:         logger.New("component", "verifier"),
+		games:          games,
+		disk:           disk,
+		providers:      providers,
+		createLoader:   createLoader,
+		createProvider: createProvider,
+		sem:            make(chan struct{}, 1),
+	}
+}
+
+// VerifyClaim compares the value of the claim at claimIndex in the game with the value expected by the challenger's
+// trace provider at the same trace index.
+// Returns [ErrUnknownGame] if the game was not created by the factory and [ErrVerifierBusy] if another claim is being
+// verified.
+func (v *claimVerifier) VerifyClaim(ctx context.Context, game common.Address, claimIndex uint64) (rpc.Verdict, error) {
+	select {
+	case v.sem <- struct{}{}:
+		defer func() { <-v.sem }()
+	default:
+		return rpc.Verdict{}, ErrVerifierBusy
+	}
+	if err := v.games.CheckGame(ctx, game); err != nil {
+		return rpc.Verdict{}, err
+	}
+	loader, err := v.createLoader(game)
+	if err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to create loader for game %v: %w", game, err)
+	}
+	claims, err := loader.FetchClaims(ctx)
+	if err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to fetch claims: %w", err)
+	}
+	if claimIndex >= uint64(len(claims)) {
+		return rpc.Verdict{}, fmt.Errorf("%w: %v, game has %v claims", ErrClaimIndexOutOfRange, claimIndex, len(claims))
+	}
+	claim := claims[claimIndex]
+	gameDepth, err := loader.FetchGameDepth(ctx)
+	if err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to fetch the game depth: %w", err)
+	}
+	traceIndex := claim.TraceIndex(int(gameDepth))
+	if !traceIndex.IsUint64() {
+		return rpc.Verdict{}, fmt.Errorf("%w: %v", ErrTraceIndexTooLarge, traceIndex)
+	}
+	gameType, err := loader.FetchGameType(ctx)
+	if err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to fetch the game type: %w", err)
+	}
+
+	if err := v.disk.Reserve(game); err != nil {
+		return rpc.Verdict{}, err
+	}
+	provider, release, err := v.providers.Acquire(game, func() (types.TraceProvider, error) {
+		return v.createProvider(ctx, game, gameType, v.disk.DirForGame(game), gameDepth)
+	})
+	if err != nil {
+		return rpc.Verdict{}, err
+	}
+	defer release()
+	if err := ValidateAbsolutePrestate(ctx, provider, loader); err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to validate absolute prestate: %w", err)
+	}
+	expected, err := provider.Get(ctx, traceIndex.Uint64())
+	if err != nil {
+		return rpc.Verdict{}, fmt.Errorf("failed to get the expected value at trace index %v: %w", traceIndex, err)
+	}
+	verdict := rpc.Verdict{
+		Game:          game,
+		ClaimIndex:    claimIndex,
+		Value:         claim.Value,
+		Position:      (*hexutil.Big)(claim.ToGIndex()),
+		Depth:         uint64(claim.Depth()),
+		TraceIndex:    traceIndex.Uint64(),
+		ExpectedValue: expected,
+		Agree:         claim.Value == expected,
+	}
+	v.logger.Info("Verified claim", "game", game, "claim", claimIndex, "agree", verdict.Agree)
+	return verdict, nil
+}
@@ -0,0 +1,208 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyClaim(t *testing.T) {
+	maxDepth := 3
+	game := common.Address{0xaa}
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	first := builder.AttackClaim(root, false)
+	first.ContractIndex = 1
+
+	setup := func(t *testing.T) (*claimVerifier, *stubVerifierLoader, *stubProviderCreator) {
+		prestate, err := builder.CorrectTraceProvider().AbsolutePreState(context.Background())
+		require.NoError(t, err)
+		loader := &stubVerifierLoader{
+			claims:   []types.Claim{root, first},
+			depth:    uint64(maxDepth),
+			gameType: 255,
+			prestate: crypto.Keccak256(prestate),
+		}
+		providers := &stubProviderCreator{provider: builder.CorrectTraceProvider()}
+		games := &stubGameChecker{known: []common.Address{game}}
+		disk := &stubVerifierDisk{dir: t.TempDir()}
+		verifier := newClaimVerifier(testlog.Logger(t, log.LvlInfo), games, disk, newProviderRegistry(), func(addr common.Address) (VerifierGameLoader, error) {
+			require.Equal(t, game, addr)
+			return loader, nil
+		}, providers.create)
+		return verifier, loader, providers
+	}
+
+	t.Run("Agree", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		verdict, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.NoError(t, err)
+		require.True(t, verdict.Agree)
+		require.Equal(t, game, verdict.Game)
+		require.Zero(t, verdict.ClaimIndex)
+		require.Equal(t, root.Value, verdict.Value)
+		require.Equal(t, root.Value, verdict.ExpectedValue)
+		require.Equal(t, root.TraceIndex(maxDepth).Uint64(), verdict.TraceIndex)
+		require.Equal(t, root.ToGIndex(), verdict.Position.ToInt())
+		require.Zero(t, verdict.Depth)
+		require.Equal(t, uint8(255), providers.gameType)
+		require.Equal(t, uint64(maxDepth), providers.depth)
+	})
+
+	t.Run("Disagree", func(t *testing.T) {
+		verifier, _, _ := setup(t)
+		verdict, err := verifier.VerifyClaim(context.Background(), game, 1)
+		require.NoError(t, err)
+		require.False(t, verdict.Agree)
+		require.Equal(t, first.Value, verdict.Value)
+		traceIndex := first.TraceIndex(maxDepth).Uint64()
+		require.Equal(t, builder.CorrectClaim(traceIndex), verdict.ExpectedValue)
+		require.Equal(t, traceIndex, verdict.TraceIndex)
+		require.Equal(t, uint64(1), verdict.Depth)
+	})
+
+	t.Run("ClaimIndexOutOfRange", func(t *testing.T) {
+		verifier, _, _ := setup(t)
+		_, err := verifier.VerifyClaim(context.Background(), game, 2)
+		require.ErrorIs(t, err, ErrClaimIndexOutOfRange)
+	})
+
+	t.Run("PrestateMismatch", func(t *testing.T) {
+		verifier, loader, _ := setup(t)
+		loader.prestate = []byte{0x01}
+		_, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.ErrorContains(t, err, "absolute prestate")
+	})
+
+	t.Run("ProviderError", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		providers.err = errors.New("boom")
+		_, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.ErrorIs(t, err, providers.err)
+	})
+
+	t.Run("UnknownGame", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		_, err := verifier.VerifyClaim(context.Background(), common.Address{0xbb}, 0)
+		require.ErrorIs(t, err, ErrUnknownGame)
+		require.Zero(t, providers.created)
+	})
+
+	t.Run("UseGameDir", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		_, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.NoError(t, err)
+		disk := verifier.disk.(*stubVerifierDisk)
+		require.Equal(t, disk.DirForGame(game), providers.dir)
+		require.Equal(t, []common.Address{game}, disk.reserved)
+	})
+
+	t.Run("InsufficientDisk", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		verifier.disk.(*stubVerifierDisk).err = ErrInsufficientDisk
+		_, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.ErrorIs(t, err, ErrInsufficientDisk)
+		require.Zero(t, providers.created)
+	})
+
+	t.Run("ReusePlayerProvider", func(t *testing.T) {
+		verifier, _, providers := setup(t)
+		_, release, err := verifier.providers.Acquire(game, func() (types.TraceProvider, error) {
+			return builder.CorrectTraceProvider(), nil
+		})
+		require.NoError(t, err)
+		defer release()
+		verdict, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.NoError(t, err)
+		require.True(t, verdict.Agree)
+		require.Zero(t, providers.created)
+	})
+
+	t.Run("RejectWhileBusy", func(t *testing.T) {
+		verifier, _, _ := setup(t)
+		verifier.sem <- struct{}{}
+		_, err := verifier.VerifyClaim(context.Background(), game, 0)
+		require.ErrorIs(t, err, ErrVerifierBusy)
+	})
+}
+
+type stubGameChecker struct {
+	known []common.Address
+}
+
+func (s *stubGameChecker) CheckGame(_ context.Context, game common.Address) error {
+	for _, known := range s.known {
+		if known == game {
+			return nil
+		}
+	}
+	return ErrUnknownGame
+}
+
+type stubVerifierDisk struct {
+	dir      string
+	err      error
+	reserved []common.Address
+}
+
+func (s *stubVerifierDisk) DirForGame(addr common.Address) string {
+	return filepath.Join(s.dir, gameDirPrefix+addr.Hex())
+}
+
+func (s *stubVerifierDisk) Reserve(addr common.Address) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.reserved = append(s.reserved, addr)
+	return nil
+}
+
+type stubVerifierLoader struct {
+	claims   []types.Claim
+	depth    uint64
+	gameType uint8
+	prestate []byte
+}
+
+func (s *stubVerifierLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, nil
+}
+
+func (s *stubVerifierLoader) FetchGameDepth(_ context.Context) (uint64, error) {
+	return s.depth, nil
+}
+
+func (s *stubVerifierLoader) FetchGameType(_ context.Context) (uint8, error) {
+	return s.gameType, nil
+}
+
+func (s *stubVerifierLoader) FetchAbsolutePrestateHash(_ context.Context) ([]byte, error) {
+	return s.prestate, nil
+}
+
+type stubProviderCreator struct {
+	provider types.TraceProvider
+	err      error
+	gameType uint8
+	dir      string
+	depth    uint64
+	created  int
+}
+
+func (s *stubProviderCreator) create(_ context.Context, _ common.Address, gameType uint8, dir string, gameDepth uint64) (types.TraceProvider, error) {
+	s.gameType = gameType
+	s.dir = dir
+	s.depth = gameDepth
+	s.created++
+	return s.provider, s.err
+}
@@ -0,0 +1,196 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alert"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errWatchOnly = errors.New("trace not available while watching game")
+
+// DefenseWatch plays a game the challenger agrees with the root claim of without reserving disk space or generating
+// a trace. Only the responder's activity is stored in the game's data directory. It resolves the game once the root claim's clock expires. If another party counters a claim the challenger
+// supports, it stops acting and requires a full [GamePlayer] to take over.
+type DefenseWatch struct {
+	*GamePlayer
+	watch *watchAgent
+}
+
+// NewDefenseWatch creates a [DefenseWatch] for the game if the challenger agrees with its root claim, based on the
+// output roots reported by the rollup nodes.
+// Returns nil if the game requires a full player because the challenger disagrees with the root claim.
+func NewDefenseWatch(
+	ctx context.Context,
+	logger log.Logger,
+	m metrics.Metricer,
+	cl clock.Clock,
+	cfg *config.Config,
+	dir string,
+	logFile string,
+	addr common.Address,
+	deps PlayerDeps,
+) (watch *DefenseWatch, err error) {
+	if deps.Alerter == nil {
+		deps.Alerter = alert.NoopAlerter
+	}
+	contract, err := bindings.NewFaultDisputeGameCaller(addr, deps.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
+	}
+	loader := NewLoader(contract)
+
+	agreeWithProposedOutput, err := agreeWithDisputedOutput(ctx, loader, deps.Outputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the disputed output root: %w", err)
+	}
+	if agreeWithProposedOutput {
+		// The root claim must be countered so a trace is required
+		logger.Debug("Disagree with root claim, game requires a full player", "game", addr)
+		return nil, nil
+	}
+	gameType, err := loader.FetchGameType(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the game type: %w", err)
+	}
+	if _, err := cfg.TraceTypeForGame(gameType); err != nil {
+		return nil, err
+	}
+
+	logger, logCloser := newGameLogger(logger, logFile, cfg.GameLogMaxSize, cfg.GameLogMaxBackups)
+	defer func() {
+		if err != nil {
+			_ = logCloser.Close()
+		}
+	}()
+	logger = logger.New("game", addr)
+
+	params, err := fetchGameParams(ctx, loader)
+	if err != nil {
+		return nil, err
+	}
+	chessClock := types.NewChessClock(params.duration)
+
+	var attackState responder.AttackState
+	var attackDetector AttackDetector
+	if deps.Attacks != nil {
+		attackState = deps.Attacks
+		attackDetector = deps.Attacks.ForGame(addr)
+	}
+	urgency := responder.NewUrgencyPolicy(cl, params.deadline, cfg.UrgentMoveWindow, cfg.EconomicalResolutionWindow, attackState)
+	responder, err := responder.NewFaultResponder(logger, deps.TxMgr, addr, m, responder.ResponderOptions{
+		MaxBond:  cfg.MaxBond,
+		Urgency:  urgency,
+		Tokens:   deps.Tokens,
+		AuditLog: deps.AuditLog,
+		Activity: responder.NewFileActivityStore(dir),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the responder: %w", err)
+	}
+
+	claims := deps.Cache.ClaimLoader(addr, loader)
+	agent := newWatchAgent(claims, int(params.depth),
+		NewAgent(claims, int(params.depth), watchOnlyTrace{}, responder, nil, false, cl, m, logger, AgentOptions{Pause: deps.Pause, ChessClock: &chessClock, Attacks: attackDetector, TimeSlice: cfg.ActTimeSlice}),
+		logger)
+	logger.Info("Agree with root claim, watching game")
+
+	return &DefenseWatch{
+		GamePlayer: &GamePlayer{
+			agent:                   agent,
+			agreeWithProposedOutput: false,
+			loader:                  loader,
+			logger:                  logger,
+			metrics:                 m,
+			bonds:                   responder,
+			logFile:                 logCloser,
+			addr:                    addr,
+			deadline:                params.deadline,
+			createdAt:               params.createdAt(),
+			status:                  deps.Status,
+			pending:                 responder,
+
+			clock:     cl,
+			rootClaim: params.rootClaim,
+			activity:  responder,
+			outcomes:  deps.Outcomes,
+			alerter:   deps.Alerter,
+			credit:    responder,
+			attacks:   attackDetector,
+		},
+		watch: agent,
+	}, nil
+}
+
+// RequiresFullPlayer returns true once another party has countered a claim the challenger supports.
+func (w *DefenseWatch) RequiresFullPlayer() bool {
+	return w.watch.countered
+}
+
+// watchAgent acts on a game through its agent only while every claim in the game is one the challenger supports.
+type watchAgent struct {
+	loader   ClaimLoader
+	maxDepth uint64
+	agent    Actor
+	log      log.Logger
+
+	// countered is set once a claim the challenger supports has been countered.
+	countered bool
+}
+
+func newWatchAgent(loader ClaimLoader, maxDepth int, agent Actor, log log.Logger) *watchAgent {
+	return &watchAgent{
+		loader:   loader,
+		maxDepth: uint64(maxDepth),
+		agent:    agent,
+		log:      log,
+	}
+}
+
+func (w *watchAgent) Act(ctx context.Context) error {
+	if w.countered {
+		return nil
+	}
+	claims, err := w.loader.FetchClaims(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch claims: %w", err)
+	}
+	if len(claims) == 0 {
+		return errors.New("no claims")
+	}
+	game := types.NewGameState(false, claims[0], w.maxDepth)
+	for _, claim := range claims[1:] {
+		if !game.AgreeWithClaimLevel(claim) {
+			w.log.Info("Claim supported by the challenger countered, game requires a full player",
+				"depth", claim.Depth(), "index_at_depth", claim.IndexAtDepth(), "value", claim.Value)
+			w.countered = true
+			return nil
+		}
+	}
+	return w.agent.Act(ctx)
+}
+
+// watchOnlyTrace is the trace provider used while watching a game.
+// The agent only needs a trace to counter claims, which a watched game never requires.
+type watchOnlyTrace struct{}
+
+func (watchOnlyTrace) Get(_ context.Context, _ uint64) (common.Hash, error) {
+	return common.Hash{}, errWatchOnly
+}
+
+func (watchOnlyTrace) GetStepData(_ context.Context, _ uint64) ([]byte, []byte, *types.PreimageOracleData, error) {
+	return nil, nil, nil, errWatchOnly
+}
+
+func (watchOnlyTrace) AbsolutePreState(_ context.Context) ([]byte, error) {
+	return nil, errWatchOnly
+}
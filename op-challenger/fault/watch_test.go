@@ -0,0 +1,82 @@
+package fault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchAgent(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	maxDepth := 3
+	builder := test.NewClaimBuilder(t, maxDepth, alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth)))
+	root := builder.CreateRootClaim(true)
+	attack := builder.AttackClaim(root, false)
+	attack.ContractIndex = 1
+	counter := builder.AttackClaim(attack, true)
+	counter.ContractIndex = 2
+	counter.ParentContractIndex = 1
+
+	t.Run("ActWhileUncontested", func(t *testing.T) {
+		inner := &stubGameState{}
+		watch := newWatchAgent(&stubClaimLoader{claims: []types.Claim{root}}, maxDepth, inner, logger)
+		require.NoError(t, watch.Act(context.Background()))
+		require.NoError(t, watch.Act(context.Background()))
+		require.Equal(t, 2, inner.callCount)
+		require.False(t, watch.countered)
+	})
+
+	t.Run("StopWhenSupportedClaimCountered", func(t *testing.T) {
+		inner := &stubGameState{}
+		loader := &stubClaimLoader{claims: []types.Claim{root, attack}}
+		watch := newWatchAgent(loader, maxDepth, inner, logger)
+		require.NoError(t, watch.Act(context.Background()))
+		require.Zero(t, inner.callCount, "should not act once a full player is required")
+		require.True(t, watch.countered)
+
+		// Remains countered even if claims are later loaded from a stale source
+		loader.claims = []types.Claim{root}
+		require.NoError(t, watch.Act(context.Background()))
+		require.Zero(t, inner.callCount)
+		require.True(t, (&DefenseWatch{watch: watch}).RequiresFullPlayer())
+	})
+
+	t.Run("StopWhenCounteredDeeper", func(t *testing.T) {
+		inner := &stubGameState{}
+		watch := newWatchAgent(&stubClaimLoader{claims: []types.Claim{root, attack, counter}}, maxDepth, inner, logger)
+		require.NoError(t, watch.Act(context.Background()))
+		require.True(t, watch.countered)
+	})
+
+	t.Run("NoClaims", func(t *testing.T) {
+		watch := newWatchAgent(&stubClaimLoader{}, maxDepth, &stubGameState{}, logger)
+		require.ErrorContains(t, watch.Act(context.Background()), "no claims")
+	})
+
+	t.Run("ResolveWithoutTrace", func(t *testing.T) {
+		resp := &stubResponder{}
+		loader := &stubClaimLoader{claims: []types.Claim{root}}
+		agent := NewAgent(loader, maxDepth, watchOnlyTrace{}, resp, nil, false, clock.SystemClock, metrics.NoopMetrics, logger, AgentOptions{})
+		watch := newWatchAgent(loader, maxDepth, agent, logger)
+		require.NoError(t, watch.Act(context.Background()))
+		require.Equal(t, 1, resp.callResolves)
+		require.Zero(t, resp.responses, "should not counter the root claim")
+	})
+}
+
+func TestWatchOnlyTrace(t *testing.T) {
+	_, err := watchOnlyTrace{}.Get(context.Background(), 0)
+	require.ErrorIs(t, err, errWatchOnly)
+	_, _, _, err = watchOnlyTrace{}.GetStepData(context.Background(), 0)
+	require.ErrorIs(t, err, errWatchOnly)
+	_, err = watchOnlyTrace{}.AbsolutePreState(context.Background())
+	require.ErrorIs(t, err, errWatchOnly)
+}
@@ -2,13 +2,17 @@ package flags
 
 import (
 	"fmt"
+	"math/big"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/slices"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
@@ -44,14 +48,15 @@ var (
 			"If empty, the challenger will play all games.",
 		EnvVars: prefixEnvVars("GAME_ALLOWLIST"),
 	}
-	TraceTypeFlag = &cli.GenericFlag{
-		Name:    "trace-type",
-		Usage:   "The trace type. Valid options: " + openum.EnumString(config.TraceTypes),
+	TraceTypeFlag = &cli.StringSliceFlag{
+		Name: "trace-type",
+		Usage: "The trace types to support. May be specified multiple times to play several game types. " +
+			"Valid options: " + openum.EnumString(config.TraceTypes),
 		EnvVars: prefixEnvVars("TRACE_TYPE"),
-		Value: func() *config.TraceType {
-			out := config.TraceType("") // No default value
-			return &out
-		}(),
+		Action: func(_ *cli.Context, values []string) error {
+			_, err := parseTraceTypes(values)
+			return err
+		},
 	}
 	AgreeWithProposedOutputFlag = &cli.BoolFlag{
 		Name:    "agree-with-proposed-output",
@@ -64,11 +69,23 @@ var (
 		EnvVars: prefixEnvVars("DATADIR"),
 	}
 	// Optional Flags
-	MaxConcurrencyFlag = &cli.UintFlag{
-		Name:    "max-concurrency",
-		Usage:   "Maximum number of threads to use when progressing games",
+	MaxConcurrencyFlag = &cli.StringFlag{
+		Name: "max-concurrency",
+		Usage: "Maximum number of threads to use when progressing games. Set to \"auto\" to derive it from the available " +
+			"CPU cores, memory and disk space and adjust it at runtime.",
 		EnvVars: prefixEnvVars("MAX_CONCURRENCY"),
-		Value:   uint(runtime.NumCPU()),
+		Value:   strconv.Itoa(runtime.NumCPU()),
+	}
+	MaxScheduledGamesFlag = &cli.UintFlag{
+		Name:    "max-scheduled-games",
+		Usage:   "Maximum number of games to progress in each update. Excess games are shed until a later update. 0 is unlimited",
+		EnvVars: prefixEnvVars("MAX_SCHEDULED_GAMES"),
+	}
+	ActTimeSliceFlag = &cli.DurationFlag{
+		Name: "act-time-slice",
+		Usage: "Maximum time to spend acting on the claims of a game in each update. Remaining claims are processed, most " +
+			"urgent first, in later updates. 0 is unlimited",
+		EnvVars: prefixEnvVars("ACT_TIME_SLICE"),
 	}
 	AlphabetFlag = &cli.StringFlag{
 		Name:    "alphabet",
@@ -116,12 +133,262 @@ var (
 		EnvVars: prefixEnvVars("CANNON_SNAPSHOT_FREQ"),
 		Value:   config.DefaultCannonSnapshotFreq,
 	}
+	CannonMaxMemoryFlag = &cli.UintFlag{
+		Name:    "cannon-max-memory",
+		Usage:   "Maximum virtual memory in megabytes for each cannon execution, enforced with rlimits on linux. 0 is unlimited (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MAX_MEMORY"),
+	}
+	CannonMaxCPUTimeFlag = &cli.DurationFlag{
+		Name:    "cannon-max-cpu-time",
+		Usage:   "Maximum CPU time for each cannon execution, enforced with rlimits on linux. 0 is unlimited (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MAX_CPU_TIME"),
+	}
+	CannonTimeoutFlag = &cli.DurationFlag{
+		Name:    "cannon-timeout",
+		Usage:   "Maximum time for each cannon execution before it is stopped. 0 is unlimited (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_TIMEOUT"),
+	}
+	CannonMaxRestartsFlag = &cli.UintFlag{
+		Name:    "cannon-max-restarts",
+		Usage:   "Number of times a failed cannon execution is restarted from the latest snapshot before giving up (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MAX_RESTARTS"),
+		Value:   config.DefaultCannonMaxRestarts,
+	}
+	CannonStallThresholdFlag = &cli.DurationFlag{
+		Name:    "cannon-stall-threshold",
+		Usage:   "Time after which a running cannon execution is reported as stalled, without stopping it. 0 disables (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_STALL_THRESHOLD"),
+	}
+	MaxBondFlag = &cli.StringFlag{
+		Name:    "max-bond",
+		Usage:   "Maximum bond in wei to attach to a single move. Moves requiring a larger bond are not made.",
+		EnvVars: prefixEnvVars("MAX_BOND"),
+		Value:   config.DefaultMaxBond.String(),
+	}
+	RollupRpcFlag = &cli.StringSliceFlag{
+		Name: "rollup-rpc",
+		Usage: "HTTP provider URL for a rollup node. Used to detect when the L2 chain has halted and to fetch output roots. " +
+			"May be specified multiple times to fail over between nodes, in order of preference.",
+		EnvVars: prefixEnvVars("ROLLUP_RPC"),
+	}
+	OutputRootAgreementFlag = &cli.BoolFlag{
+		Name: "output-root-agreement",
+		Usage: "Agree or disagree with each game's proposed output based on the output root from the rollup nodes, " +
+			"instead of --agree-with-proposed-output. Output roots must match between at least two rollup nodes, or " +
+			"one rollup node and the op-geth node set by --l2-geth-rpc.",
+		EnvVars: prefixEnvVars("OUTPUT_ROOT_AGREEMENT"),
+	}
+	L2GethRpcFlag = &cli.StringFlag{
+		Name: "l2-geth-rpc",
+		Usage: "HTTP provider URL for an archive op-geth node. Output roots from the rollup nodes are only used once they " +
+			"match the output root computed from op-geth's state. Requires --output-root-agreement.",
+		EnvVars: prefixEnvVars("L2_GETH_RPC"),
+	}
+	DefenseWatchFlag = &cli.BoolFlag{
+		Name: "defense-watch",
+		Usage: "Only watch games the challenger agrees with the root claim of, without reserving disk space or " +
+			"generating a trace, until another party counters a claim the challenger supports. " +
+			"Requires --output-root-agreement.",
+		EnvVars: prefixEnvVars("DEFENSE_WATCH"),
+	}
+	L1HaltThresholdFlag = &cli.DurationFlag{
+		Name:    "l1-halt-threshold",
+		Usage:   "Time without a new L1 block before the challenger soft-pauses new trace generation. 0 disables.",
+		EnvVars: prefixEnvVars("L1_HALT_THRESHOLD"),
+		Value:   config.DefaultL1HaltThreshold,
+	}
+	L2HaltThresholdFlag = &cli.DurationFlag{
+		Name:    "l2-halt-threshold",
+		Usage:   "Time without a new unsafe L2 block before the challenger soft-pauses new trace generation. 0 disables.",
+		EnvVars: prefixEnvVars("L2_HALT_THRESHOLD"),
+		Value:   config.DefaultL2HaltThreshold,
+	}
+	UrgentClaimAgeFlag = &cli.DurationFlag{
+		Name:    "urgent-claim-age",
+		Usage:   "Age after which the challenger responds to a claim even while soft-paused.",
+		EnvVars: prefixEnvVars("URGENT_CLAIM_AGE"),
+		Value:   config.DefaultUrgentClaimAge,
+	}
+	UrgentMoveWindowFlag = &cli.DurationFlag{
+		Name: "urgent-move-window",
+		Usage: "Time before a game's deadline from which moves are sent with higher fees and bumped more aggressively. " +
+			"0 disables.",
+		EnvVars: prefixEnvVars("URGENT_MOVE_WINDOW"),
+		Value:   config.DefaultUrgentMoveWindow,
+	}
+	EconomicalResolutionWindowFlag = &cli.DurationFlag{
+		Name: "economical-resolution-window",
+		Usage: "Time after a game's deadline during which resolutions are sent with economical fees and bumped slowly. " +
+			"0 disables.",
+		EnvVars: prefixEnvVars("ECONOMICAL_RESOLUTION_WINDOW"),
+		Value:   config.DefaultEconomicalResolutionWindow,
+	}
+	StuckTxFractionFlag = &cli.Float64Flag{
+		Name: "stuck-tx-fraction",
+		Usage: "Fraction of the time remaining on a claim's clock that a move or step may be pending for before it is " +
+			"considered stuck and escalated with much higher fees. 0 disables stuck transaction monitoring.",
+		EnvVars: prefixEnvVars("STUCK_TX_FRACTION"),
+		Value:   config.DefaultStuckTxFraction,
+	}
+	TxRebroadcastRpcFlag = &cli.StringSliceFlag{
+		Name:    "tx-rebroadcast-rpc",
+		Usage:   "Additional L1 HTTP provider URLs that stuck moves and steps are rebroadcast through.",
+		EnvVars: prefixEnvVars("TX_REBROADCAST_RPC"),
+	}
+	TxRelayRpcFlag = &cli.StringFlag{
+		Name: "tx-relay-rpc",
+		Usage: "Private transaction relay URL, such as a Flashbots Protect RPC, that all transactions are sent through " +
+			"instead of the L1 node, so they are not visible in the public mempool before being included.",
+		EnvVars: prefixEnvVars("TX_RELAY_RPC"),
+	}
+	RuntimeConfigAddressFlag = &cli.StringFlag{
+		Name: "runtime-config-address",
+		Usage: "Address of the runtime config contract, checked each block to determine if the challenger is paused " +
+			"or restricted to resolving games. If not set, the challenger always runs normally.",
+		EnvVars: prefixEnvVars("RUNTIME_CONFIG_ADDRESS"),
+	}
+	GameLogMaxSizeFlag = &cli.IntFlag{
+		Name:    "game-log-max-size",
+		Usage:   "Maximum size in megabytes of each game's log file before it is rotated. 0 disables per-game log files.",
+		EnvVars: prefixEnvVars("GAME_LOG_MAX_SIZE"),
+		Value:   config.DefaultGameLogMaxSize,
+	}
+	GameLogMaxBackupsFlag = &cli.IntFlag{
+		Name:    "game-log-max-backups",
+		Usage:   "Maximum number of rotated log files to retain for each game.",
+		EnvVars: prefixEnvVars("GAME_LOG_MAX_BACKUPS"),
+		Value:   config.DefaultGameLogMaxBackups,
+	}
+	L1QuorumRpcFlag = &cli.StringSliceFlag{
+		Name: "l1-quorum-rpc",
+		Usage: "Additional HTTP provider URLs for L1. If set, game claim data and status are read from every L1 " +
+			"endpoint and the challenger refuses to act unless l1-quorum-threshold endpoints agree.",
+		EnvVars: prefixEnvVars("L1_QUORUM_RPC"),
+	}
+	L1QuorumThresholdFlag = &cli.UintFlag{
+		Name:    "l1-quorum-threshold",
+		Usage:   "Number of L1 endpoints, including l1-eth-rpc, that must return the same game data. 0 requires all endpoints to agree.",
+		EnvVars: prefixEnvVars("L1_QUORUM_THRESHOLD"),
+	}
+	OutcomeReportURLFlag = &cli.StringFlag{
+		Name: "outcome-report-url",
+		Usage: "HTTP endpoint to POST the outcome of each completed game to. Reports are queued in the datadir and " +
+			"retried until sent. If not set, outcomes are not reported.",
+		EnvVars: prefixEnvVars("OUTCOME_REPORT_URL"),
+	}
+	OutcomeReportSecretFlag = &cli.StringFlag{
+		Name:    "outcome-report-secret",
+		Usage:   "Secret used to sign outcome reports with HMAC-SHA256. The signature is sent in the X-Challenger-Signature header.",
+		EnvVars: prefixEnvVars("OUTCOME_REPORT_SECRET"),
+	}
+	AlertSlackWebhookFlag = &cli.StringFlag{
+		Name:    "alert-slack-webhook",
+		Usage:   "Slack incoming webhook URL to post alerts for critical conditions to. If not set, alerts are not sent to Slack.",
+		EnvVars: prefixEnvVars("ALERT_SLACK_WEBHOOK"),
+	}
+	AlertOpsgenieAPIKeyFlag = &cli.StringFlag{
+		Name:    "alert-opsgenie-api-key",
+		Usage:   "Opsgenie API key to create alerts for critical conditions with. If not set, alerts are not sent to Opsgenie.",
+		EnvVars: prefixEnvVars("ALERT_OPSGENIE_API_KEY"),
+	}
+	AlertOpsgenieURLFlag = &cli.StringFlag{
+		Name:    "alert-opsgenie-url",
+		Usage:   "Opsgenie API URL to create alerts through, e.g. https://api.eu.opsgenie.com for the EU region.",
+		EnvVars: prefixEnvVars("ALERT_OPSGENIE_URL"),
+		Value:   config.DefaultAlertOpsgenieURL,
+	}
+	MinBalanceFlag = &cli.StringFlag{
+		Name:    "min-balance",
+		Usage:   "Balance in wei of the challenger's account below which a low balance alert is raised. If not set, balance alerts are disabled.",
+		EnvVars: prefixEnvVars("MIN_BALANCE"),
+	}
+	AnomalyProfilesFlag = &cli.BoolFlag{
+		Name: "anomaly-profiles",
+		Usage: "Capture CPU, heap and goroutine pprof profiles in the datadir when a monitor cycle is slow, memory use " +
+			"is above the limit or a cannon execution stalls.",
+		EnvVars: prefixEnvVars("ANOMALY_PROFILES"),
+	}
+	AnomalySlowCycleFlag = &cli.DurationFlag{
+		Name:    "anomaly-slow-cycle",
+		Usage:   "Monitor cycle duration above which profiles are captured. 0 disables (requires --anomaly-profiles)",
+		EnvVars: prefixEnvVars("ANOMALY_SLOW_CYCLE"),
+		Value:   config.DefaultAnomalySlowCycle,
+	}
+	AnomalyMemoryLimitFlag = &cli.UintFlag{
+		Name:    "anomaly-memory-limit",
+		Usage:   "Memory in megabytes used by the challenger above which profiles are captured. 0 disables (requires --anomaly-profiles)",
+		EnvVars: prefixEnvVars("ANOMALY_MEMORY_LIMIT"),
+	}
+	AnomalyMaxProfilesFlag = &cli.UintFlag{
+		Name:    "anomaly-max-profiles",
+		Usage:   "Maximum number of profile captures to retain, removing the oldest first (requires --anomaly-profiles)",
+		EnvVars: prefixEnvVars("ANOMALY_MAX_PROFILES"),
+		Value:   config.DefaultAnomalyMaxProfiles,
+	}
+	AuditSignFlag = &cli.BoolFlag{
+		Name: "audit-sign",
+		Usage: "Sign each entry of the audit log with the challenger's private key so it can be proven to have been " +
+			"recorded by the challenger. Requires the private-key flag to be set.",
+		EnvVars: prefixEnvVars("AUDIT_SIGN"),
+	}
+	PanicGameThresholdFlag = &cli.UintFlag{
+		Name: "panic-game-threshold",
+		Usage: "Number of games created within the panic window that puts the challenger into panic mode, prioritising " +
+			"countering claims over resolving games and claiming bonds. 0 disables",
+		EnvVars: prefixEnvVars("PANIC_GAME_THRESHOLD"),
+	}
+	PanicClaimThresholdFlag = &cli.UintFlag{
+		Name:    "panic-claim-threshold",
+		Usage:   "Number of claims the challenger disagrees with posted within the panic window that puts the challenger into panic mode. 0 disables",
+		EnvVars: prefixEnvVars("PANIC_CLAIM_THRESHOLD"),
+	}
+	PanicWindowFlag = &cli.DurationFlag{
+		Name:    "panic-window",
+		Usage:   "Window new games and hostile claims are counted over to detect a coordinated attack",
+		EnvVars: prefixEnvVars("PANIC_WINDOW"),
+		Value:   config.DefaultPanicWindow,
+	}
+	PanicDurationFlag = &cli.DurationFlag{
+		Name:    "panic-duration",
+		Usage:   "Time panic mode lasts after the panic thresholds were last exceeded",
+		EnvVars: prefixEnvVars("PANIC_DURATION"),
+		Value:   config.DefaultPanicDuration,
+	}
+	PanicMaxConcurrencyFlag = &cli.UintFlag{
+		Name:    "panic-max-concurrency",
+		Usage:   "Maximum number of games to progress concurrently while in panic mode. 0 leaves the concurrency unchanged",
+		EnvVars: prefixEnvVars("PANIC_MAX_CONCURRENCY"),
+	}
+	StepCorpusDirFlag = &cli.StringFlag{
+		Name: "step-corpus-dir",
+		Usage: "Directory to record the pre-state, proof and expected post-state of every step computed by the challenger " +
+			"in, for replaying as fault proof VM test cases. If not set, steps are not recorded.",
+		EnvVars: prefixEnvVars("STEP_CORPUS_DIR"),
+	}
+	MempoolLookaheadFlag = &cli.BoolFlag{
+		Name: "mempool-lookahead",
+		Usage: "Subscribe to the pending transactions of the L1 node and precompute responses to moves that have not yet " +
+			"been included in a block. Requires l1-eth-rpc to support subscriptions, e.g. a websocket endpoint.",
+		EnvVars: prefixEnvVars("MEMPOOL_LOOKAHEAD"),
+	}
 	GameWindowFlag = &cli.DurationFlag{
 		Name:    "game-window",
 		Usage:   "The time window which the challenger will look for games to progress.",
 		EnvVars: prefixEnvVars("GAME_WINDOW"),
 		Value:   config.DefaultGameWindow,
 	}
+	BackfillFromBlockFlag = &cli.Uint64Flag{
+		Name: "backfill-from-block",
+		Usage: "Also progress games created at or after this L1 block, even if they are older than the game window. " +
+			"Use to catch up on unresolved games after an outage. If not set, only games in the game window are progressed.",
+		EnvVars: prefixEnvVars("BACKFILL_FROM_BLOCK"),
+	}
+	ConfirmationDepthFlag = &cli.Uint64Flag{
+		Name: "confirmation-depth",
+		Usage: "Number of L1 blocks games and claims must be buried by before the challenger acts on them, to reduce " +
+			"exposure to L1 reorgs. More recent games and claims are reported as pending. 0 acts on the latest block.",
+		EnvVars: prefixEnvVars("CONFIRMATION_DEPTH"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -136,6 +403,8 @@ var requiredFlags = []cli.Flag{
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
 	MaxConcurrencyFlag,
+	MaxScheduledGamesFlag,
+	ActTimeSliceFlag,
 	AlphabetFlag,
 	GameAllowlistFlag,
 	CannonNetworkFlag,
@@ -146,7 +415,50 @@ var optionalFlags = []cli.Flag{
 	CannonPreStateFlag,
 	CannonL2Flag,
 	CannonSnapshotFreqFlag,
+	CannonMaxMemoryFlag,
+	CannonMaxCPUTimeFlag,
+	CannonTimeoutFlag,
+	CannonMaxRestartsFlag,
+	CannonStallThresholdFlag,
 	GameWindowFlag,
+	BackfillFromBlockFlag,
+	ConfirmationDepthFlag,
+	MaxBondFlag,
+	RollupRpcFlag,
+	OutputRootAgreementFlag,
+	L2GethRpcFlag,
+	DefenseWatchFlag,
+	L1HaltThresholdFlag,
+	L2HaltThresholdFlag,
+	UrgentClaimAgeFlag,
+	UrgentMoveWindowFlag,
+	EconomicalResolutionWindowFlag,
+	StuckTxFractionFlag,
+	TxRebroadcastRpcFlag,
+	TxRelayRpcFlag,
+	RuntimeConfigAddressFlag,
+	GameLogMaxSizeFlag,
+	GameLogMaxBackupsFlag,
+	L1QuorumRpcFlag,
+	L1QuorumThresholdFlag,
+	OutcomeReportURLFlag,
+	OutcomeReportSecretFlag,
+	AlertSlackWebhookFlag,
+	AlertOpsgenieAPIKeyFlag,
+	AlertOpsgenieURLFlag,
+	MinBalanceFlag,
+	AnomalyProfilesFlag,
+	AnomalySlowCycleFlag,
+	AnomalyMemoryLimitFlag,
+	AnomalyMaxProfilesFlag,
+	AuditSignFlag,
+	PanicGameThresholdFlag,
+	PanicClaimThresholdFlag,
+	PanicWindowFlag,
+	PanicDurationFlag,
+	PanicMaxConcurrencyFlag,
+	StepCorpusDirFlag,
+	MempoolLookaheadFlag,
 }
 
 func init() {
@@ -154,6 +466,7 @@ func init() {
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, rpc.CLIFlags(envVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -167,39 +480,65 @@ func CheckRequired(ctx *cli.Context) error {
 			return fmt.Errorf("flag %s is required", f.Names()[0])
 		}
 	}
-	gameType := config.TraceType(strings.ToLower(ctx.String(TraceTypeFlag.Name)))
-	switch gameType {
-	case config.TraceTypeCannon:
-		if !ctx.IsSet(CannonNetworkFlag.Name) &&
-			!(ctx.IsSet(CannonRollupConfigFlag.Name) && ctx.IsSet(CannonL2GenesisFlag.Name)) {
-			return fmt.Errorf("flag %v or %v and %v is required",
-				CannonNetworkFlag.Name, CannonRollupConfigFlag.Name, CannonL2GenesisFlag.Name)
-		}
-		if ctx.IsSet(CannonNetworkFlag.Name) &&
-			(ctx.IsSet(CannonRollupConfigFlag.Name) || ctx.IsSet(CannonL2GenesisFlag.Name)) {
-			return fmt.Errorf("flag %v can not be used with %v and %v",
-				CannonNetworkFlag.Name, CannonRollupConfigFlag.Name, CannonL2GenesisFlag.Name)
-		}
-		if !ctx.IsSet(CannonBinFlag.Name) {
-			return fmt.Errorf("flag %s is required", CannonBinFlag.Name)
-		}
-		if !ctx.IsSet(CannonServerFlag.Name) {
-			return fmt.Errorf("flag %s is required", CannonServerFlag.Name)
-		}
-		if !ctx.IsSet(CannonPreStateFlag.Name) {
-			return fmt.Errorf("flag %s is required", CannonPreStateFlag.Name)
+	traceTypes, err := parseTraceTypes(ctx.StringSlice(TraceTypeFlag.Name))
+	if err != nil {
+		return err
+	}
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case config.TraceTypeCannon:
+			if err := checkCannonFlags(ctx); err != nil {
+				return err
+			}
+		case config.TraceTypeAlphabet:
+			if !ctx.IsSet(AlphabetFlag.Name) {
+				return fmt.Errorf("flag %s is required", "alphabet")
+			}
 		}
-		if !ctx.IsSet(CannonL2Flag.Name) {
-			return fmt.Errorf("flag %s is required", CannonL2Flag.Name)
+	}
+	return nil
+}
+
+func checkCannonFlags(ctx *cli.Context) error {
+	if !ctx.IsSet(CannonNetworkFlag.Name) &&
+		!(ctx.IsSet(CannonRollupConfigFlag.Name) && ctx.IsSet(CannonL2GenesisFlag.Name)) {
+		return fmt.Errorf("flag %v or %v and %v is required",
+			CannonNetworkFlag.Name, CannonRollupConfigFlag.Name, CannonL2GenesisFlag.Name)
+	}
+	if ctx.IsSet(CannonNetworkFlag.Name) &&
+		(ctx.IsSet(CannonRollupConfigFlag.Name) || ctx.IsSet(CannonL2GenesisFlag.Name)) {
+		return fmt.Errorf("flag %v can not be used with %v and %v",
+			CannonNetworkFlag.Name, CannonRollupConfigFlag.Name, CannonL2GenesisFlag.Name)
+	}
+	if !ctx.IsSet(CannonBinFlag.Name) {
+		return fmt.Errorf("flag %s is required", CannonBinFlag.Name)
+	}
+	if !ctx.IsSet(CannonServerFlag.Name) {
+		return fmt.Errorf("flag %s is required", CannonServerFlag.Name)
+	}
+	if !ctx.IsSet(CannonPreStateFlag.Name) {
+		return fmt.Errorf("flag %s is required", CannonPreStateFlag.Name)
+	}
+	if !ctx.IsSet(CannonL2Flag.Name) {
+		return fmt.Errorf("flag %s is required", CannonL2Flag.Name)
+	}
+	return nil
+}
+
+// parseTraceTypes converts the trace type flag values to a list of unique, valid trace types.
+func parseTraceTypes(values []string) ([]config.TraceType, error) {
+	var traceTypes []config.TraceType
+	for _, value := range values {
+		traceType := config.TraceType(strings.ToLower(value))
+		if !config.ValidTraceType(traceType) {
+			return nil, fmt.Errorf("unknown trace type: %q", value)
 		}
-	case config.TraceTypeAlphabet:
-		if !ctx.IsSet(AlphabetFlag.Name) {
-			return fmt.Errorf("flag %s is required", "alphabet")
+		if slices.Contains(traceTypes, traceType) {
+			return nil, fmt.Errorf("duplicate trace type: %q", value)
 		}
-	default:
-		return fmt.Errorf("invalid trace type. must be one of %v", config.TraceTypes)
+		traceTypes = append(traceTypes, traceType)
 	}
-	return nil
+	return traceTypes, nil
 }
 
 // NewConfigFromCLI parses the Config from the provided flags or environment variables.
@@ -221,38 +560,131 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 			allowedGames = append(allowedGames, gameAddress)
 		}
 	}
+	var runtimeConfigAddress common.Address
+	if ctx.IsSet(RuntimeConfigAddressFlag.Name) {
+		runtimeConfigAddress, err = opservice.ParseAddress(ctx.String(RuntimeConfigAddressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	rpcConfig := rpc.ReadCLIConfig(ctx)
 
-	traceTypeFlag := config.TraceType(strings.ToLower(ctx.String(TraceTypeFlag.Name)))
+	traceTypes, err := parseTraceTypes(ctx.StringSlice(TraceTypeFlag.Name))
+	if err != nil {
+		return nil, err
+	}
 
-	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
+	var maxConcurrency uint
+	autoConcurrency := ctx.String(MaxConcurrencyFlag.Name) == config.AutoConcurrency
+	if autoConcurrency {
+		// The number of CPUs is the upper bound, which is further limited by available memory and disk space
+		maxConcurrency = uint(runtime.NumCPU())
+	} else {
+		parsed, err := strconv.ParseUint(ctx.String(MaxConcurrencyFlag.Name), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %v", MaxConcurrencyFlag.Name, ctx.String(MaxConcurrencyFlag.Name))
+		}
+		maxConcurrency = uint(parsed)
+	}
 	if maxConcurrency == 0 {
 		return nil, fmt.Errorf("%v must not be 0", MaxConcurrencyFlag.Name)
 	}
+	maxBond, ok := new(big.Int).SetString(ctx.String(MaxBondFlag.Name), 10)
+	if !ok || maxBond.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %v: %v", MaxBondFlag.Name, ctx.String(MaxBondFlag.Name))
+	}
+	var minBalance *big.Int
+	if ctx.IsSet(MinBalanceFlag.Name) {
+		minBalance, ok = new(big.Int).SetString(ctx.String(MinBalanceFlag.Name), 10)
+		if !ok || minBalance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %v: %v", MinBalanceFlag.Name, ctx.String(MinBalanceFlag.Name))
+		}
+	}
 	return &config.Config{
 		// Required Flags
 		L1EthRpc:                ctx.String(L1EthRpcFlag.Name),
-		TraceType:               traceTypeFlag,
+		L1QuorumRpcs:            ctx.StringSlice(L1QuorumRpcFlag.Name),
+		L1QuorumThreshold:       ctx.Uint(L1QuorumThresholdFlag.Name),
+		TraceTypes:              traceTypes,
 		GameFactoryAddress:      gameFactoryAddress,
 		GameAllowlist:           allowedGames,
 		GameWindow:              ctx.Duration(GameWindowFlag.Name),
+		BackfillFromBlock:       ctx.Uint64(BackfillFromBlockFlag.Name),
+		ConfirmationDepth:       ctx.Uint64(ConfirmationDepthFlag.Name),
 		MaxConcurrency:          maxConcurrency,
-		AlphabetTrace:           ctx.String(AlphabetFlag.Name),
-		CannonNetwork:           ctx.String(CannonNetworkFlag.Name),
-		CannonRollupConfigPath:  ctx.String(CannonRollupConfigFlag.Name),
-		CannonL2GenesisPath:     ctx.String(CannonL2GenesisFlag.Name),
-		CannonBin:               ctx.String(CannonBinFlag.Name),
-		CannonServer:            ctx.String(CannonServerFlag.Name),
-		CannonAbsolutePreState:  ctx.String(CannonPreStateFlag.Name),
+		AutoConcurrency:         autoConcurrency,
+		MaxScheduledGames:       ctx.Uint(MaxScheduledGamesFlag.Name),
+		ActTimeSlice:            ctx.Duration(ActTimeSliceFlag.Name),
+		MaxBond:                 maxBond,
+		RollupRpcs:              ctx.StringSlice(RollupRpcFlag.Name),
+		OutputRootAgreement:     ctx.Bool(OutputRootAgreementFlag.Name),
+		L2GethRpc:               ctx.String(L2GethRpcFlag.Name),
+		DefenseWatch:            ctx.Bool(DefenseWatchFlag.Name),
+		L1HaltThreshold:         ctx.Duration(L1HaltThresholdFlag.Name),
+		L2HaltThreshold:         ctx.Duration(L2HaltThresholdFlag.Name),
+		UrgentClaimAge:          ctx.Duration(UrgentClaimAgeFlag.Name),
+		RuntimeConfigAddress:    runtimeConfigAddress,
+		GameLogMaxSize:          ctx.Int(GameLogMaxSizeFlag.Name),
+		GameLogMaxBackups:       ctx.Int(GameLogMaxBackupsFlag.Name),
 		Datadir:                 ctx.String(DatadirFlag.Name),
-		CannonL2:                ctx.String(CannonL2Flag.Name),
-		CannonSnapshotFreq:      ctx.Uint(CannonSnapshotFreqFlag.Name),
 		AgreeWithProposedOutput: ctx.Bool(AgreeWithProposedOutputFlag.Name),
 		TxMgrConfig:             txMgrConfig,
 		MetricsConfig:           metricsConfig,
 		PprofConfig:             pprofConfig,
+		RPCConfig:               rpcConfig,
+
+		UrgentMoveWindow:           ctx.Duration(UrgentMoveWindowFlag.Name),
+		EconomicalResolutionWindow: ctx.Duration(EconomicalResolutionWindowFlag.Name),
+		StuckTxFraction:            ctx.Float64(StuckTxFractionFlag.Name),
+		TxRebroadcastRpcs:          ctx.StringSlice(TxRebroadcastRpcFlag.Name),
+		TxRelayRpc:                 ctx.String(TxRelayRpcFlag.Name),
+
+		OutcomeReportURL:    ctx.String(OutcomeReportURLFlag.Name),
+		OutcomeReportSecret: ctx.String(OutcomeReportSecretFlag.Name),
+
+		AlertSlackWebhook:   ctx.String(AlertSlackWebhookFlag.Name),
+		AlertOpsgenieAPIKey: ctx.String(AlertOpsgenieAPIKeyFlag.Name),
+		AlertOpsgenieURL:    ctx.String(AlertOpsgenieURLFlag.Name),
+		MinBalance:          minBalance,
+
+		AnomalyProfiles:    ctx.Bool(AnomalyProfilesFlag.Name),
+		AnomalySlowCycle:   ctx.Duration(AnomalySlowCycleFlag.Name),
+		AnomalyMemoryLimit: ctx.Uint(AnomalyMemoryLimitFlag.Name),
+		AnomalyMaxProfiles: ctx.Uint(AnomalyMaxProfilesFlag.Name),
+
+		AuditSign: ctx.Bool(AuditSignFlag.Name),
+
+		PanicGameThreshold:  ctx.Uint(PanicGameThresholdFlag.Name),
+		PanicClaimThreshold: ctx.Uint(PanicClaimThresholdFlag.Name),
+		PanicWindow:         ctx.Duration(PanicWindowFlag.Name),
+		PanicDuration:       ctx.Duration(PanicDurationFlag.Name),
+		PanicMaxConcurrency: ctx.Uint(PanicMaxConcurrencyFlag.Name),
+
+		StepCorpusDir: ctx.String(StepCorpusDirFlag.Name),
+
+		MempoolLookahead: ctx.Bool(MempoolLookaheadFlag.Name),
+
+		Alphabet: config.AlphabetConfig{
+			Trace: ctx.String(AlphabetFlag.Name),
+		},
+		Cannon: config.CannonConfig{
+			Bin:              ctx.String(CannonBinFlag.Name),
+			Server:           ctx.String(CannonServerFlag.Name),
+			AbsolutePreState: ctx.String(CannonPreStateFlag.Name),
+			Network:          ctx.String(CannonNetworkFlag.Name),
+			RollupConfigPath: ctx.String(CannonRollupConfigFlag.Name),
+			L2GenesisPath:    ctx.String(CannonL2GenesisFlag.Name),
+			L2:               ctx.String(CannonL2Flag.Name),
+			SnapshotFreq:     ctx.Uint(CannonSnapshotFreqFlag.Name),
+			MaxMemory:        ctx.Uint(CannonMaxMemoryFlag.Name),
+			MaxCPUTime:       ctx.Duration(CannonMaxCPUTimeFlag.Name),
+			Timeout:          ctx.Duration(CannonTimeoutFlag.Name),
+			MaxRestarts:      ctx.Uint(CannonMaxRestartsFlag.Name),
+			StallThreshold:   ctx.Duration(CannonStallThresholdFlag.Name),
+		},
 	}, nil
 }
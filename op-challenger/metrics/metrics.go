@@ -2,6 +2,8 @@ package metrics
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -18,6 +20,34 @@ type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
 
+	RecordBondPosted(amount *big.Int)
+	RecordBondsReleased(amount *big.Int)
+	RecordBondTokenBalance(token common.Address, balance *big.Int, decimals uint8)
+
+	RecordSoftPaused(paused bool)
+	RecordRuntimeMode(mode uint8)
+
+	RecordL1QuorumDisagreement()
+
+	RecordGamesShed(count int)
+	RecordSchedulerOverloaded(overloaded bool)
+	RecordMaxConcurrency(concurrency uint)
+	RecordPanicMode(active bool)
+
+	RecordCannonFailure(game common.Address, reason string)
+	RecordCannonExecutionTime(t time.Duration)
+	RecordCannonStall()
+
+	RecordAnomalyProfile(reason string)
+
+	RecordDuplicateMoveSkipped()
+	RecordActTimeSliceExhausted()
+
+	RecordStuckTxs(count int, oldest time.Duration)
+	RecordStuckTxAlert()
+
+	RecordDailyCosts(games uint64, gasCost *big.Int, bondsLocked *big.Int, bondsRecovered *big.Int, netProfit *big.Int, gameTime time.Duration)
+
 	// Record Tx metrics
 	txmetrics.TxMetricer
 }
@@ -31,6 +61,38 @@ type Metrics struct {
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
+
+	bondedValue      prometheus.Gauge
+	bondTokenBalance prometheus.GaugeVec
+	softPaused       prometheus.Gauge
+	runtimeMode      prometheus.Gauge
+
+	l1QuorumDisagreements prometheus.Counter
+
+	gamesShed           prometheus.Counter
+	schedulerOverloaded prometheus.Gauge
+	maxConcurrency      prometheus.Gauge
+	panicMode           prometheus.Gauge
+
+	cannonFailures      prometheus.CounterVec
+	cannonExecutionTime prometheus.Histogram
+	cannonStalls        prometheus.Counter
+
+	anomalyProfiles prometheus.CounterVec
+
+	duplicateMovesSkipped prometheus.Counter
+	actTimeSliceExhausted prometheus.Counter
+
+	stuckTxs         prometheus.Gauge
+	stuckTxOldestAge prometheus.Gauge
+	stuckTxAlerts    prometheus.Counter
+
+	dailyGames          prometheus.Gauge
+	dailyGasCost        prometheus.Gauge
+	dailyBondsLocked    prometheus.Gauge
+	dailyBondsRecovered prometheus.Gauge
+	dailyNetProfit      prometheus.Gauge
+	dailyGameTime       prometheus.Gauge
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -58,6 +120,134 @@ func NewMetrics() *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-challenger has finished starting up",
 		}),
+		bondedValue: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "bonded_value",
+			Help:      "Total value in ETH of bonds posted in games that have not yet completed",
+		}),
+		bondTokenBalance: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "bond_token_balance",
+			Help:      "Balance of the challenger's account in each token bonds are denominated in",
+		}, []string{
+			"token",
+		}),
+		softPaused: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "soft_paused",
+			Help:      "1 if new trace generation is paused because the chain appears halted",
+		}),
+		runtimeMode: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "runtime_mode",
+			Help:      "Mode loaded from the runtime config contract: 0 = normal, 1 = resolve-only, 2 = paused",
+		}),
+		l1QuorumDisagreements: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "l1_quorum_disagreements_total",
+			Help:      "Number of L1 reads where the configured quorum endpoints returned different results",
+		}),
+		gamesShed: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "games_shed_total",
+			Help:      "Number of times a game was not progressed because the scheduler was overloaded",
+		}),
+		schedulerOverloaded: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "scheduler_overloaded",
+			Help:      "1 if the scheduler shed games in its most recent update because there were too many to progress",
+		}),
+		maxConcurrency: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "max_concurrency",
+			Help:      "Current maximum number of games progressed concurrently",
+		}),
+		panicMode: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "panic_mode",
+			Help:      "1 if the challenger is in panic mode because a coordinated attack was detected",
+		}),
+		cannonFailures: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "cannon_failures_total",
+			Help:      "Number of cannon executions that failed after starting, by game and reason (exit, killed or timeout)",
+		}, []string{
+			"game",
+			"reason",
+		}),
+		cannonExecutionTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "cannon_execution_time_seconds",
+			Help:      "Wall clock time taken by successful cannon executions",
+			Buckets:   []float64{1, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		}),
+		cannonStalls: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "cannon_stalls_total",
+			Help:      "Number of cannon executions still running after the stall threshold",
+		}),
+		anomalyProfiles: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "anomaly_profiles_total",
+			Help:      "Number of times profiles were captured after an anomaly, by reason",
+		}, []string{
+			"reason",
+		}),
+		duplicateMovesSkipped: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "duplicate_moves_skipped_total",
+			Help:      "Number of moves not posted because another party had already posted an identical claim",
+		}),
+		actTimeSliceExhausted: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "act_time_slice_exhausted_total",
+			Help:      "Number of game updates stopped before all claims were processed because the time slice was used",
+		}),
+		stuckTxs: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "stuck_txs",
+			Help:      "Number of moves and steps that are stuck waiting to be included and are being escalated",
+		}),
+		stuckTxOldestAge: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "stuck_tx_oldest_age_seconds",
+			Help:      "Time since the oldest stuck move or step was first sent. 0 if there are no stuck transactions",
+		}),
+		stuckTxAlerts: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "stuck_tx_alerts_total",
+			Help:      "Number of stuck transactions that were still not included after escalation as their deadline approached",
+		}),
+		dailyGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_games_resolved",
+			Help:      "Number of games resolved today (UTC)",
+		}),
+		dailyGasCost: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_gas_cost",
+			Help:      "Total fees in ETH paid for games resolved today (UTC)",
+		}),
+		dailyBondsLocked: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_bonds_locked",
+			Help:      "Total value in ETH bonded in games resolved today (UTC)",
+		}),
+		dailyBondsRecovered: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_bonds_recovered",
+			Help:      "Total value in ETH of bonds expected to be returned from games resolved today (UTC)",
+		}),
+		dailyNetProfit: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_net_profit",
+			Help:      "Net profit in ETH from games resolved today (UTC). Negative for a loss",
+		}),
+		dailyGameTime: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "daily_game_time_seconds",
+			Help:      "Total time from creation to resolution of games resolved today (UTC)",
+		}),
 	}
 }
 
@@ -81,6 +271,123 @@ func (m *Metrics) RecordUp() {
 	m.up.Set(1)
 }
 
+// RecordBondPosted adds the bond amount (in wei) to the total bonded value at risk.
+func (m *Metrics) RecordBondPosted(amount *big.Int) {
+	m.bondedValue.Add(opmetrics.WeiToEther(amount))
+}
+
+// RecordBondsReleased removes the bond amount (in wei) from the total bonded value at risk.
+func (m *Metrics) RecordBondsReleased(amount *big.Int) {
+	m.bondedValue.Sub(opmetrics.WeiToEther(amount))
+}
+
+// RecordBondTokenBalance sets the balance of the challenger's account in a bond token.
+// The balance is in the token's base units and is reported in whole tokens using the token's decimals.
+func (m *Metrics) RecordBondTokenBalance(token common.Address, balance *big.Int, decimals uint8) {
+	num := new(big.Rat).SetInt(balance)
+	num.Quo(num, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	f, _ := num.Float64()
+	m.bondTokenBalance.WithLabelValues(token.Hex()).Set(f)
+}
+
+// RecordSoftPaused sets the soft_paused metric to 1 when paused and 0 otherwise.
+func (m *Metrics) RecordSoftPaused(paused bool) {
+	if paused {
+		m.softPaused.Set(1)
+	} else {
+		m.softPaused.Set(0)
+	}
+}
+
+// RecordRuntimeMode sets the runtime_mode metric to the mode loaded from the runtime config contract.
+func (m *Metrics) RecordRuntimeMode(mode uint8) {
+	m.runtimeMode.Set(float64(mode))
+}
+
+// RecordL1QuorumDisagreement increments the count of L1 reads where the quorum endpoints disagreed.
+func (m *Metrics) RecordL1QuorumDisagreement() {
+	m.l1QuorumDisagreements.Inc()
+}
+
+// RecordGamesShed increments the count of games that were not progressed because the scheduler was overloaded.
+func (m *Metrics) RecordGamesShed(count int) {
+	m.gamesShed.Add(float64(count))
+}
+
+// RecordSchedulerOverloaded sets the scheduler_overloaded metric to 1 when overloaded and 0 otherwise.
+func (m *Metrics) RecordSchedulerOverloaded(overloaded bool) {
+	if overloaded {
+		m.schedulerOverloaded.Set(1)
+	} else {
+		m.schedulerOverloaded.Set(0)
+	}
+}
+
+// RecordCannonFailure increments the count of failed cannon executions for the game.
+func (m *Metrics) RecordCannonFailure(game common.Address, reason string) {
+	m.cannonFailures.WithLabelValues(game.Hex(), reason).Inc()
+}
+
+// RecordMaxConcurrency sets the current maximum number of games progressed concurrently.
+func (m *Metrics) RecordMaxConcurrency(concurrency uint) {
+	m.maxConcurrency.Set(float64(concurrency))
+}
+
+// RecordPanicMode sets the panic_mode metric to 1 when panic mode is active and 0 otherwise.
+func (m *Metrics) RecordPanicMode(active bool) {
+	if active {
+		m.panicMode.Set(1)
+	} else {
+		m.panicMode.Set(0)
+	}
+}
+
+// RecordCannonExecutionTime records the wall clock time taken by a successful cannon execution.
+func (m *Metrics) RecordCannonExecutionTime(t time.Duration) {
+	m.cannonExecutionTime.Observe(t.Seconds())
+}
+
+// RecordCannonStall increments the count of cannon executions still running after the stall threshold.
+func (m *Metrics) RecordCannonStall() {
+	m.cannonStalls.Inc()
+}
+
+// RecordAnomalyProfile increments the count of profiles captured after an anomaly with the given reason.
+func (m *Metrics) RecordAnomalyProfile(reason string) {
+	m.anomalyProfiles.WithLabelValues(reason).Inc()
+}
+
+// RecordDuplicateMoveSkipped increments the count of moves skipped because another party already posted them.
+func (m *Metrics) RecordDuplicateMoveSkipped() {
+	m.duplicateMovesSkipped.Inc()
+}
+
+// RecordActTimeSliceExhausted increments the count of game updates stopped early because the time slice was used.
+func (m *Metrics) RecordActTimeSliceExhausted() {
+	m.actTimeSliceExhausted.Inc()
+}
+
+// RecordStuckTxs sets the number of stuck transactions and the time since the oldest was first sent.
+func (m *Metrics) RecordStuckTxs(count int, oldest time.Duration) {
+	m.stuckTxs.Set(float64(count))
+	m.stuckTxOldestAge.Set(oldest.Seconds())
+}
+
+// RecordStuckTxAlert increments the count of stuck transactions that were not included after escalation.
+func (m *Metrics) RecordStuckTxAlert() {
+	m.stuckTxAlerts.Inc()
+}
+
+// RecordDailyCosts sets the total costs of the games resolved today. Amounts are in wei.
+func (m *Metrics) RecordDailyCosts(games uint64, gasCost *big.Int, bondsLocked *big.Int, bondsRecovered *big.Int, netProfit *big.Int, gameTime time.Duration) {
+	m.dailyGames.Set(float64(games))
+	m.dailyGasCost.Set(opmetrics.WeiToEther(gasCost))
+	m.dailyBondsLocked.Set(opmetrics.WeiToEther(bondsLocked))
+	m.dailyBondsRecovered.Set(opmetrics.WeiToEther(bondsRecovered))
+	m.dailyNetProfit.Set(opmetrics.WeiToEther(netProfit))
+	m.dailyGameTime.Set(gameTime.Seconds())
+}
+
 func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
@@ -1,6 +1,11 @@
 package metrics
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
@@ -12,3 +17,33 @@ var NoopMetrics Metricer = new(noopMetrics)
 
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
+
+func (*noopMetrics) RecordBondPosted(_ *big.Int)                                  {}
+func (*noopMetrics) RecordBondsReleased(_ *big.Int)                               {}
+func (*noopMetrics) RecordBondTokenBalance(_ common.Address, _ *big.Int, _ uint8) {}
+
+func (*noopMetrics) RecordSoftPaused(_ bool)   {}
+func (*noopMetrics) RecordRuntimeMode(_ uint8) {}
+
+func (*noopMetrics) RecordL1QuorumDisagreement() {}
+
+func (*noopMetrics) RecordGamesShed(_ int)            {}
+func (*noopMetrics) RecordSchedulerOverloaded(_ bool) {}
+func (*noopMetrics) RecordMaxConcurrency(_ uint)      {}
+func (*noopMetrics) RecordPanicMode(_ bool)           {}
+
+func (*noopMetrics) RecordCannonFailure(_ common.Address, _ string) {}
+func (*noopMetrics) RecordCannonExecutionTime(_ time.Duration)      {}
+func (*noopMetrics) RecordCannonStall()                             {}
+
+func (*noopMetrics) RecordAnomalyProfile(_ string) {}
+
+func (*noopMetrics) RecordDuplicateMoveSkipped() {}
+
+func (*noopMetrics) RecordActTimeSliceExhausted() {}
+
+func (*noopMetrics) RecordStuckTxs(_ int, _ time.Duration) {}
+func (*noopMetrics) RecordStuckTxAlert()                   {}
+
+func (*noopMetrics) RecordDailyCosts(_ uint64, _ *big.Int, _ *big.Int, _ *big.Int, _ *big.Int, _ time.Duration) {
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type challengerAdmin interface {
+	Games() []GameInfo
+	RecentResolutions() []GameResolution
+	Wallet(ctx context.Context) (Wallet, error)
+	Claims(ctx context.Context, game common.Address) ([]Claim, error)
+	PauseScheduler()
+	ResumeScheduler()
+	SchedulerPaused() bool
+	ResolveGame(ctx context.Context, game common.Address) (types.GameStatus, error)
+	Diagnostics(ctx context.Context, game common.Address) ([]diagnostics.Bundle, error)
+}
+
+type adminAPI struct {
+	c challengerAdmin
+}
+
+func NewAdminAPI(c challengerAdmin) *adminAPI {
+	return &adminAPI{
+		c: c,
+	}
+}
+
+// ListGames returns the games currently being played.
+func (a *adminAPI) ListGames(_ context.Context) ([]GameInfo, error) {
+	return a.c.Games(), nil
+}
+
+// RecentResolutions returns the most recently completed games, newest first.
+func (a *adminAPI) RecentResolutions(_ context.Context) ([]GameResolution, error) {
+	return a.c.RecentResolutions(), nil
+}
+
+// Wallet returns the address and current balance of the account used to send transactions.
+func (a *adminAPI) Wallet(ctx context.Context) (Wallet, error) {
+	return a.c.Wallet(ctx)
+}
+
+// Claims returns the current claims in the specified game.
+func (a *adminAPI) Claims(ctx context.Context, game common.Address) ([]Claim, error) {
+	return a.c.Claims(ctx, game)
+}
+
+// PauseScheduler stops new games being scheduled for progression until ResumeScheduler is called.
+// Games that are already being progressed are not interrupted.
+func (a *adminAPI) PauseScheduler(_ context.Context) error {
+	a.c.PauseScheduler()
+	return nil
+}
+
+// ResumeScheduler resumes scheduling games after a call to PauseScheduler.
+func (a *adminAPI) ResumeScheduler(_ context.Context) error {
+	a.c.ResumeScheduler()
+	return nil
+}
+
+// SchedulerPaused returns true if scheduling has been paused with PauseScheduler.
+func (a *adminAPI) SchedulerPaused(_ context.Context) (bool, error) {
+	return a.c.SchedulerPaused(), nil
+}
+
+// ResolveGame sends a transaction to resolve the specified game, returning the resulting game status.
+// Fails without sending a transaction if the game cannot yet be resolved or was not created by the dispute game factory.
+func (a *adminAPI) ResolveGame(ctx context.Context, game common.Address) (types.GameStatus, error) {
+	return a.c.ResolveGame(ctx, game)
+}
+
+// Diagnostics returns the diagnostic bundles recorded for claims in the specified game that the challenger disagrees
+// with, ordered by claim index.
+func (a *adminAPI) Diagnostics(ctx context.Context, game common.Address) ([]diagnostics.Bundle, error) {
+	return a.c.Diagnostics(ctx, game)
+}
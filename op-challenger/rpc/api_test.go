@@ -0,0 +1,198 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/diagnostics"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminAPI(t *testing.T) {
+	status := &stubStatus{
+		games: []GameInfo{
+			{
+				Address:      common.Address{0xaa},
+				Status:       types.GameStatusInProgress,
+				ClaimCount:   3,
+				Deadline:     1000,
+				PendingMoves: 1,
+				BondedValue:  (*hexutil.Big)(big.NewInt(500)),
+				UpdatedAt:    900,
+			},
+		},
+		resolutions: []GameResolution{
+			{Address: common.Address{0xbb}, Status: types.GameStatusDefenderWon, Won: true, ResolvedAt: 800},
+		},
+		wallet: Wallet{Address: common.Address{0xcc}, Balance: (*hexutil.Big)(big.NewInt(42))},
+	}
+	client := setupAdminAPI(t, status)
+
+	t.Run("ListGames", func(t *testing.T) {
+		var games []GameInfo
+		require.NoError(t, client.Call(&games, "admin_listGames"))
+		require.Equal(t, status.games, games)
+	})
+
+	t.Run("RecentResolutions", func(t *testing.T) {
+		var resolutions []GameResolution
+		require.NoError(t, client.Call(&resolutions, "admin_recentResolutions"))
+		require.Equal(t, status.resolutions, resolutions)
+	})
+
+	t.Run("Wallet", func(t *testing.T) {
+		var wallet Wallet
+		require.NoError(t, client.Call(&wallet, "admin_wallet"))
+		require.Equal(t, status.wallet, wallet)
+	})
+
+	t.Run("WalletError", func(t *testing.T) {
+		status.walletErr = errors.New("boom")
+		var wallet Wallet
+		require.ErrorContains(t, client.Call(&wallet, "admin_wallet"), "boom")
+	})
+}
+
+func TestAdminAPI_Claims(t *testing.T) {
+	game := common.Address{0xaa}
+	status := &stubStatus{
+		claims: []Claim{
+			{Index: 0, ParentIndex: 0, Value: common.Hash{0x01}, Position: (*hexutil.Big)(big.NewInt(1)), Clock: 5},
+			{Index: 1, ParentIndex: 0, Value: common.Hash{0x02}, Position: (*hexutil.Big)(big.NewInt(2)), Depth: 1, Countered: true},
+		},
+	}
+	client := setupAdminAPI(t, status)
+	var claims []Claim
+	require.NoError(t, client.Call(&claims, "admin_claims", game))
+	require.Equal(t, status.claims, claims)
+	require.Equal(t, game, status.claimsGame)
+}
+
+func TestAdminAPI_PauseScheduler(t *testing.T) {
+	status := &stubStatus{}
+	client := setupAdminAPI(t, status)
+	var paused bool
+	require.NoError(t, client.Call(nil, "admin_pauseScheduler"))
+	require.NoError(t, client.Call(&paused, "admin_schedulerPaused"))
+	require.True(t, paused)
+
+	require.NoError(t, client.Call(nil, "admin_resumeScheduler"))
+	require.NoError(t, client.Call(&paused, "admin_schedulerPaused"))
+	require.False(t, paused)
+}
+
+func TestAdminAPI_ResolveGame(t *testing.T) {
+	game := common.Address{0xaa}
+	status := &stubStatus{resolveStatus: types.GameStatusDefenderWon}
+	client := setupAdminAPI(t, status)
+	var result types.GameStatus
+	require.NoError(t, client.Call(&result, "admin_resolveGame", game))
+	require.Equal(t, types.GameStatusDefenderWon, result)
+	require.Equal(t, game, status.resolvedGame)
+
+	status.resolveErr = errors.New("not resolvable")
+	require.ErrorContains(t, client.Call(&result, "admin_resolveGame", game), "not resolvable")
+}
+
+func TestAdminAPI_Diagnostics(t *testing.T) {
+	game := common.Address{0xaa}
+	status := &stubStatus{
+		diagnostics: []diagnostics.Bundle{
+			{
+				Game:       game,
+				ClaimIndex: 2,
+				Position:   (*hexutil.Big)(big.NewInt(4)),
+				Depth:      2,
+				TraceIndex: 3,
+				TheirValue: common.Hash{0x01},
+				OurValue:   common.Hash{0x02},
+				Trace:      []byte(`{"proofPath":"/data/proofs/3.json"}`),
+				RecordedAt: 100,
+			},
+		},
+	}
+	client := setupAdminAPI(t, status)
+	var bundles []diagnostics.Bundle
+	require.NoError(t, client.Call(&bundles, "admin_diagnostics", game))
+	require.Equal(t, status.diagnostics, bundles)
+	require.Equal(t, game, status.diagnosticsGame)
+}
+
+func setupAdminAPI(t *testing.T, status challengerAdmin) *gethrpc.Client {
+	server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+	require.NoError(t, server.EnableAdminAPI(status))
+	require.NoError(t, server.Start())
+	client, err := gethrpc.Dial("http://" + server.Endpoint())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.Close()
+		require.NoError(t, server.Stop(context.Background()))
+	})
+	return client
+}
+
+type stubStatus struct {
+	games       []GameInfo
+	resolutions []GameResolution
+	wallet      Wallet
+	walletErr   error
+
+	claims     []Claim
+	claimsGame common.Address
+
+	paused bool
+
+	resolvedGame  common.Address
+	resolveStatus types.GameStatus
+	resolveErr    error
+
+	diagnostics     []diagnostics.Bundle
+	diagnosticsGame common.Address
+}
+
+func (s *stubStatus) Games() []GameInfo {
+	return s.games
+}
+
+func (s *stubStatus) RecentResolutions() []GameResolution {
+	return s.resolutions
+}
+
+func (s *stubStatus) Wallet(_ context.Context) (Wallet, error) {
+	return s.wallet, s.walletErr
+}
+
+func (s *stubStatus) Claims(_ context.Context, game common.Address) ([]Claim, error) {
+	s.claimsGame = game
+	return s.claims, nil
+}
+
+func (s *stubStatus) PauseScheduler() {
+	s.paused = true
+}
+
+func (s *stubStatus) ResumeScheduler() {
+	s.paused = false
+}
+
+func (s *stubStatus) SchedulerPaused() bool {
+	return s.paused
+}
+
+func (s *stubStatus) ResolveGame(_ context.Context, game common.Address) (types.GameStatus, error) {
+	s.resolvedGame = game
+	return s.resolveStatus, s.resolveErr
+}
+
+func (s *stubStatus) Diagnostics(_ context.Context, game common.Address) ([]diagnostics.Bundle, error) {
+	s.diagnosticsGame = game
+	return s.diagnostics, nil
+}
@@ -0,0 +1,173 @@
+package rpc
+
+import (
+	"errors"
+	"math"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+)
+
+const (
+	EnabledFlagName          = "rpc.enabled"
+	EnableAdminFlagName      = "rpc.enable-admin"
+	EnableVerifyFlagName     = "rpc.enable-verify"
+	ListenAddrFlagName       = "rpc.addr"
+	PortFlagName             = "rpc.port"
+	VerifyListenAddrFlagName = "rpc.verify-addr"
+	VerifyPortFlagName       = "rpc.verify-port"
+	SocketFlagName           = "rpc.socket"
+	SocketModeFlagName       = "rpc.socket-mode"
+	DisableTCPFlagName       = "rpc.disable-tcp"
+	defaultListenAddr        = "127.0.0.1"
+	defaultListenPort        = 8545
+	defaultVerifyListenPort  = 8546
+	defaultSocketMode        = 0600
+)
+
+var (
+	ErrInvalidPort       = errors.New("invalid RPC port")
+	ErrInvalidSocketMode = errors.New("invalid RPC socket mode")
+	ErrMissingSocket     = errors.New("RPC socket path required when TCP is disabled")
+	ErrVerifyPortInUse   = errors.New("verify RPC server must listen on a different port to the RPC server")
+)
+
+func CLIFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    EnabledFlagName,
+			Usage:   "Enable the RPC server, serving the " + HealthPath + " endpoint",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ENABLED"),
+		},
+		&cli.BoolFlag{
+			Name:    EnableAdminFlagName,
+			Usage:   "Enable the RPC server and serve the admin API, used by the dashboard command",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ENABLE_ADMIN"),
+		},
+		&cli.BoolFlag{
+			Name: EnableVerifyFlagName,
+			Usage: "Enable the verify RPC server, serving the read-only verify API which reports the challenger's own " +
+				"verdict on any claim in a game. Served separately to the RPC server so it can be exposed without the admin API",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ENABLE_VERIFY"),
+		},
+		&cli.StringFlag{
+			Name:    ListenAddrFlagName,
+			Usage:   "RPC listening address",
+			Value:   defaultListenAddr,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ADDR"),
+		},
+		&cli.IntFlag{
+			Name:    PortFlagName,
+			Usage:   "RPC listening port",
+			Value:   defaultListenPort,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_PORT"),
+		},
+		&cli.StringFlag{
+			Name:    VerifyListenAddrFlagName,
+			Usage:   "Verify RPC server listening address",
+			Value:   defaultListenAddr,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_VERIFY_ADDR"),
+		},
+		&cli.IntFlag{
+			Name:    VerifyPortFlagName,
+			Usage:   "Verify RPC server listening port",
+			Value:   defaultVerifyListenPort,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_VERIFY_PORT"),
+		},
+		&cli.StringFlag{
+			Name:    SocketFlagName,
+			Usage:   "Path of a Unix domain socket to also serve the RPC server on",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_SOCKET"),
+		},
+		&cli.UintFlag{
+			Name:        SocketModeFlagName,
+			Usage:       "File permissions of the RPC Unix domain socket, in octal",
+			Value:       defaultSocketMode,
+			DefaultText: "0600",
+			EnvVars:     opservice.PrefixEnvVar(envPrefix, "RPC_SOCKET_MODE"),
+		},
+		&cli.BoolFlag{
+			Name:    DisableTCPFlagName,
+			Usage:   "Only serve the RPC server on the Unix domain socket set by --" + SocketFlagName,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_DISABLE_TCP"),
+		},
+	}
+}
+
+// CLIConfig configures the RPC server and the verify RPC server.
+// The RPC server is only started when Enabled or EnableAdmin is set. The verify RPC server is only started when
+// EnableVerify is set, and never serves the admin API.
+type CLIConfig struct {
+	Enabled      bool
+	EnableAdmin  bool
+	EnableVerify bool
+	ListenAddr   string
+	ListenPort   int
+
+	VerifyListenAddr string
+	VerifyListenPort int
+
+	// SocketPath is the path of a Unix domain socket to serve on in addition to TCP. Empty to disable.
+	SocketPath string
+	SocketMode os.FileMode
+	// DisableTCP disables serving over TCP, so the server is only available on the Unix domain socket.
+	DisableTCP bool
+}
+
+func DefaultCLIConfig() CLIConfig {
+	return CLIConfig{
+		ListenAddr:       defaultListenAddr,
+		ListenPort:       defaultListenPort,
+		VerifyListenAddr: defaultListenAddr,
+		VerifyListenPort: defaultVerifyListenPort,
+		SocketMode:       defaultSocketMode,
+	}
+}
+
+// ServerEnabled returns true if the RPC server should be started.
+func (c CLIConfig) ServerEnabled() bool {
+	return c.Enabled || c.EnableAdmin
+}
+
+func (c CLIConfig) Check() error {
+	if c.EnableVerify {
+		if c.VerifyListenPort < 0 || c.VerifyListenPort > math.MaxUint16 {
+			return ErrInvalidPort
+		}
+		if c.ServerEnabled() && !c.DisableTCP && c.VerifyListenPort != 0 && c.VerifyListenPort == c.ListenPort {
+			return ErrVerifyPortInUse
+		}
+	}
+	if !c.ServerEnabled() {
+		return nil
+	}
+	if c.ListenPort < 0 || c.ListenPort > math.MaxUint16 {
+		return ErrInvalidPort
+	}
+	if c.SocketMode&^os.ModePerm != 0 {
+		return ErrInvalidSocketMode
+	}
+	if c.DisableTCP && c.SocketPath == "" {
+		return ErrMissingSocket
+	}
+	return nil
+}
+
+func ReadCLIConfig(ctx *cli.Context) CLIConfig {
+	return CLIConfig{
+		Enabled:      ctx.Bool(EnabledFlagName),
+		EnableAdmin:  ctx.Bool(EnableAdminFlagName),
+		EnableVerify: ctx.Bool(EnableVerifyFlagName),
+		ListenAddr:   ctx.String(ListenAddrFlagName),
+		ListenPort:   ctx.Int(PortFlagName),
+
+		VerifyListenAddr: ctx.String(VerifyListenAddrFlagName),
+		VerifyListenPort: ctx.Int(VerifyPortFlagName),
+
+		SocketPath: ctx.String(SocketFlagName),
+		SocketMode: os.FileMode(ctx.Uint(SocketModeFlagName)),
+		DisableTCP: ctx.Bool(DisableTCPFlagName),
+	}
+}
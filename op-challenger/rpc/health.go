@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+)
+
+// HealthChecker reports the reasons the challenger is currently degraded, if any.
+type HealthChecker interface {
+	Degraded() []string
+}
+
+// Health is the response body served by the health endpoint.
+type Health struct {
+	Status  string   `json:"status"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// healthHandler serves the current health of the challenger.
+// A degraded challenger is still running so the endpoint responds with 200 OK in both cases.
+func healthHandler(checker HealthChecker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		health := Health{Status: HealthStatusOK}
+		if checker != nil {
+			if reasons := checker.Degraded(); len(reasons) > 0 {
+				health = Health{Status: HealthStatusDegraded, Reasons: reasons}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(health)
+	})
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth(t *testing.T) {
+	t.Run("NoChecker", func(t *testing.T) {
+		server := setupHealthServer(t, nil)
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchHealth(t, server))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		server := setupHealthServer(t, &stubHealthChecker{})
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchHealth(t, server))
+	})
+
+	t.Run("Degraded", func(t *testing.T) {
+		checker := &stubHealthChecker{reasons: []string{"overloaded", "behind"}}
+		server := setupHealthServer(t, checker)
+		require.Equal(t, Health{Status: HealthStatusDegraded, Reasons: checker.reasons}, fetchHealth(t, server))
+	})
+}
+
+func setupHealthServer(t *testing.T, checker HealthChecker) *Server {
+	server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, checker)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+	return server
+}
+
+func fetchHealth(t *testing.T, server *Server) Health {
+	resp, err := http.Get("http://" + server.Endpoint() + HealthPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var health Health
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&health))
+	return health
+}
+
+type stubHealthChecker struct {
+	reasons []string
+}
+
+func (s *stubHealthChecker) Degraded() []string {
+	return s.reasons
+}
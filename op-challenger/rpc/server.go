@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// HealthPath is the HTTP path the health endpoint is served on.
+const HealthPath = "/healthz"
+
+// Server serves the health endpoint and, if enabled, the admin and verify APIs over HTTP JSON-RPC.
+// The server listens on TCP and, if enabled with [Server.EnableSocket], a Unix domain socket.
+type Server struct {
+	log        log.Logger
+	endpoint   string
+	rpcServer  *gethrpc.Server
+	httpServer *http.Server
+	listener   net.Listener
+
+	disableTCP bool
+	socketPath string
+	socketMode os.FileMode
+	socket     net.Listener
+}
+
+// NewServer creates a new [Server] serving the health endpoint.
+// The admin and verify APIs are only served once enabled with [Server.EnableAdminAPI] and [Server.EnableVerifyAPI].
+func NewServer(logger log.Logger, host string, port int, health HealthChecker) *Server {
+	rpcServer := gethrpc.NewServer()
+	mux := http.NewServeMux()
+	mux.Handle(HealthPath, healthHandler(health))
+	mux.Handle("/", rpcServer)
+	return &Server{
+		log:        logger,
+		endpoint:   net.JoinHostPort(host, strconv.Itoa(port)),
+		rpcServer:  rpcServer,
+		httpServer: &http.Server{Handler: mux},
+	}
+}
+
+// EnableAdminAPI registers the admin API with the server.
+func (s *Server) EnableAdminAPI(admin challengerAdmin) error {
+	if err := s.rpcServer.RegisterName("admin", NewAdminAPI(admin)); err != nil {
+		return fmt.Errorf("failed to register admin API: %w", err)
+	}
+	return nil
+}
+
+// EnableVerifyAPI registers the read-only verify API with the server.
+func (s *Server) EnableVerifyAPI(verifier claimVerifier) error {
+	if err := s.rpcServer.RegisterName("verify", NewVerifyAPI(verifier)); err != nil {
+		return fmt.Errorf("failed to register verify API: %w", err)
+	}
+	return nil
+}
+
+// EnableSocket serves the server on a Unix domain socket at path, with the file permissions in mode, in addition to
+// TCP. Must be called before the server is started.
+func (s *Server) EnableSocket(path string, mode os.FileMode) {
+	s.socketPath = path
+	s.socketMode = mode
+}
+
+// DisableTCP stops the server listening on TCP, so it is only served on the Unix domain socket.
+// Must be called before the server is started.
+func (s *Server) DisableTCP() {
+	s.disableTCP = true
+}
+
+// SocketPath returns the path of the Unix domain socket the server is served on, or an empty string if disabled.
+func (s *Server) SocketPath() string {
+	return s.socketPath
+}
+
+// Endpoint returns the TCP address the server is listening on, or an empty string if TCP is disabled.
+// Prior to the server starting, this is the configured address which may not include the final port.
+func (s *Server) Endpoint() string {
+	if s.disableTCP {
+		return ""
+	}
+	if s.listener != nil {
+		return s.listener.Addr().String()
+	}
+	return s.endpoint
+}
+
+func (s *Server) Start() error {
+	var listeners []net.Listener
+	if !s.disableTCP {
+		listener, err := net.Listen("tcp", s.endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %v: %w", s.endpoint, err)
+		}
+		s.listener = listener
+		listeners = append(listeners, listener)
+	}
+	if s.socketPath != "" {
+		listener, err := listenSocket(s.socketPath, s.socketMode)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return err
+		}
+		s.socket = listener
+		listeners = append(listeners, listener)
+	}
+	for _, listener := range listeners {
+		listener := listener
+		go func() {
+			if err := s.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.log.Error("RPC server failed", "addr", listener.Addr(), "err", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// listenSocket listens on a Unix domain socket at path, with the file permissions in mode.
+// A socket left behind at path, for example if the challenger previously crashed, is replaced. The socket file is
+// removed when the listener is closed.
+func listenSocket(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("failed to listen on socket %v: file exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove existing socket %v: %w", path, err)
+		}
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on socket %v: %w", path, err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to set permissions of socket %v: %w", path, err)
+	}
+	return listener, nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	err := s.httpServer.Shutdown(ctx)
+	s.rpcServer.Stop()
+	// Shutdown only closes listeners the server has started serving, so close the socket explicitly to ensure the
+	// socket file is removed.
+	if s.socket != nil {
+		if closeErr := s.socket.Close(); closeErr != nil && !errors.Is(closeErr, net.ErrClosed) {
+			err = errors.Join(err, closeErr)
+		}
+	}
+	return err
+}
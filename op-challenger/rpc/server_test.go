@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocket(t *testing.T) {
+	t.Run("ServeOnSocketAndTCP", func(t *testing.T) {
+		path := socketPath(t)
+		server := setupSocketServer(t, path, 0600, false)
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchHealth(t, server))
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchSocketHealth(t, path))
+		require.Equal(t, path, server.SocketPath())
+	})
+
+	t.Run("Permissions", func(t *testing.T) {
+		path := socketPath(t)
+		setupSocketServer(t, path, 0660, false)
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0660), info.Mode().Perm())
+	})
+
+	t.Run("DisableTCP", func(t *testing.T) {
+		path := socketPath(t)
+		server := setupSocketServer(t, path, 0600, true)
+		require.Empty(t, server.Endpoint())
+		require.Nil(t, server.listener)
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchSocketHealth(t, path))
+	})
+
+	t.Run("ReplaceStaleSocket", func(t *testing.T) {
+		path := socketPath(t)
+		stale, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		// Leave the socket file behind as if the previous process crashed
+		stale.(*net.UnixListener).SetUnlinkOnClose(false)
+		require.NoError(t, stale.Close())
+
+		setupSocketServer(t, path, 0600, false)
+		require.Equal(t, Health{Status: HealthStatusOK}, fetchSocketHealth(t, path))
+	})
+
+	t.Run("RefuseToReplaceFile", func(t *testing.T) {
+		path := socketPath(t)
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+		server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+		server.EnableSocket(path, 0600)
+		require.ErrorContains(t, server.Start(), "not a socket")
+		require.FileExists(t, path)
+	})
+
+	t.Run("RemoveSocketOnStop", func(t *testing.T) {
+		path := socketPath(t)
+		server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+		server.EnableSocket(path, 0600)
+		require.NoError(t, server.Start())
+		require.NoError(t, server.Stop(context.Background()))
+		require.NoFileExists(t, path)
+	})
+}
+
+// socketPath returns a path for a socket in a new temporary directory.
+// The directory is created directly in the system temp dir as socket paths are limited to around 100 characters.
+func socketPath(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "rpc")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	return filepath.Join(dir, "challenger.sock")
+}
+
+func setupSocketServer(t *testing.T, path string, mode os.FileMode, disableTCP bool) *Server {
+	server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+	server.EnableSocket(path, mode)
+	if disableTCP {
+		server.DisableTCP()
+	}
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+	return server
+}
+
+func fetchSocketHealth(t *testing.T, path string) Health {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", path)
+			},
+		},
+	}
+	defer httpClient.CloseIdleConnections()
+	resp, err := httpClient.Get("http://localhost" + HealthPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var health Health
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&health))
+	return health
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GameInfo describes a game currently being played by the challenger.
+type GameInfo struct {
+	Address      common.Address   `json:"address"`
+	Status       types.GameStatus `json:"status"`
+	ClaimCount   uint64           `json:"claimCount"`
+	Deadline     uint64           `json:"deadline"`     // Unix timestamp after which the game can no longer be progressed
+	PendingMoves uint64           `json:"pendingMoves"` // Number of our transactions waiting to be included
+	BondedValue  *hexutil.Big     `json:"bondedValue"`  // Total value in wei of bonds we have posted in the game
+	UpdatedAt    uint64           `json:"updatedAt"`    // Unix timestamp of the last time the game was progressed
+	// Pending is true for games created too recently to have the minimum L1 confirmations. Pending games are
+	// reported but not played until they are confirmed.
+	Pending bool `json:"pending"`
+	// PendingClaims is the number of claims added too recently to have the minimum L1 confirmations.
+	PendingClaims uint64 `json:"pendingClaims"`
+}
+
+// GameResolution records the outcome of a game that has completed.
+type GameResolution struct {
+	Address    common.Address   `json:"address"`
+	Status     types.GameStatus `json:"status"`
+	Won        bool             `json:"won"`
+	ResolvedAt uint64           `json:"resolvedAt"` // Unix timestamp of when the challenger observed the game was complete
+}
+
+// Wallet describes the account the challenger sends transactions from.
+type Wallet struct {
+	Address common.Address `json:"address"`
+	Balance *hexutil.Big   `json:"balance"`
+}
+
+// Claim describes a claim in a game.
+type Claim struct {
+	Index       uint64       `json:"index"`
+	ParentIndex uint64       `json:"parentIndex"`
+	Value       common.Hash  `json:"value"`
+	Position    *hexutil.Big `json:"position"` // Generalized index of the claim's position
+	Depth       uint64       `json:"depth"`
+	Countered   bool         `json:"countered"`
+	Clock       uint64       `json:"clock"` // Unix timestamp of when the claim was posted
+
+	ClockDuration uint64 `json:"clockDuration"` // Seconds the claim's team had used when the claim was posted
+	RemainingTime uint64 `json:"remainingTime"` // Seconds left to counter the claim before the countering team's clock expires
+}
+
+// Verdict is the challenger's independent assessment of a claim, computed from its own trace provider.
+type Verdict struct {
+	Game          common.Address `json:"game"`
+	ClaimIndex    uint64         `json:"claimIndex"`
+	Value         common.Hash    `json:"value"` // Value of the claim in the game
+	Position      *hexutil.Big   `json:"position"`
+	Depth         uint64         `json:"depth"`
+	TraceIndex    uint64         `json:"traceIndex"`    // Index in the trace the claim commits to
+	ExpectedValue common.Hash    `json:"expectedValue"` // Value the challenger expects at the trace index
+	Agree         bool           `json:"agree"`         // Whether the claim's value matches the expected value
+}
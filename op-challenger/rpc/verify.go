@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type claimVerifier interface {
+	VerifyClaim(ctx context.Context, game common.Address, claimIndex uint64) (Verdict, error)
+}
+
+// verifyAPI is a read-only API that lets third parties use the challenger to check claims.
+type verifyAPI struct {
+	v claimVerifier
+}
+
+func NewVerifyAPI(v claimVerifier) *verifyAPI {
+	return &verifyAPI{
+		v: v,
+	}
+}
+
+// Claim returns the challenger's verdict on the claim at claimIndex in the specified game.
+// The expected value is computed from the challenger's trace provider so may take some time for cannon games.
+func (a *verifyAPI) Claim(ctx context.Context, game common.Address, claimIndex uint64) (Verdict, error) {
+	return a.v.VerifyClaim(ctx, game, claimIndex)
+}
@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAPI(t *testing.T) {
+	verifier := &stubVerifier{
+		verdict: Verdict{
+			Game:          common.Address{0xaa},
+			ClaimIndex:    2,
+			Value:         common.Hash{0x01},
+			Position:      (*hexutil.Big)(big.NewInt(5)),
+			Depth:         2,
+			TraceIndex:    3,
+			ExpectedValue: common.Hash{0x02},
+			Agree:         false,
+		},
+	}
+	client := setupVerifyAPI(t, verifier)
+
+	t.Run("Claim", func(t *testing.T) {
+		var verdict Verdict
+		require.NoError(t, client.Call(&verdict, "verify_claim", common.Address{0xaa}, 2))
+		require.Equal(t, verifier.verdict, verdict)
+		require.Equal(t, common.Address{0xaa}, verifier.game)
+		require.Equal(t, uint64(2), verifier.claimIndex)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		verifier.err = errors.New("boom")
+		var verdict Verdict
+		require.ErrorContains(t, client.Call(&verdict, "verify_claim", common.Address{0xaa}, 2), "boom")
+	})
+
+	t.Run("AdminNotEnabled", func(t *testing.T) {
+		var games []GameInfo
+		require.Error(t, client.Call(&games, "admin_listGames"))
+	})
+}
+
+func setupVerifyAPI(t *testing.T, verifier claimVerifier) *gethrpc.Client {
+	server := NewServer(testlog.Logger(t, log.LvlInfo), "127.0.0.1", 0, nil)
+	require.NoError(t, server.EnableVerifyAPI(verifier))
+	require.NoError(t, server.Start())
+	client, err := gethrpc.Dial("http://" + server.Endpoint())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.Close()
+		require.NoError(t, server.Stop(context.Background()))
+	})
+	return client
+}
+
+type stubVerifier struct {
+	verdict    Verdict
+	err        error
+	game       common.Address
+	claimIndex uint64
+}
+
+func (s *stubVerifier) VerifyClaim(_ context.Context, game common.Address, claimIndex uint64) (Verdict, error) {
+	s.game = game
+	s.claimIndex = claimIndex
+	return s.verdict, s.err
+}
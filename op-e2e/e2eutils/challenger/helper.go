@@ -63,8 +63,8 @@ func WithAgreeProposedOutput(agree bool) Option {
 
 func WithAlphabet(alphabet string) Option {
 	return func(c *config.Config) {
-		c.TraceType = config.TraceTypeAlphabet
-		c.AlphabetTrace = alphabet
+		c.TraceTypes = append(c.TraceTypes, config.TraceTypeAlphabet)
+		c.Alphabet.Trace = alphabet
 	}
 }
 
@@ -76,24 +76,24 @@ func WithCannon(
 ) Option {
 	return func(c *config.Config) {
 		require := require.New(t)
-		c.TraceType = config.TraceTypeCannon
-		c.CannonL2 = l2Endpoint
-		c.CannonBin = "../cannon/bin/cannon"
-		c.CannonServer = "../op-program/bin/op-program"
-		c.CannonAbsolutePreState = "../op-program/bin/prestate.json"
-		c.CannonSnapshotFreq = 10_000_000
+		c.TraceTypes = append(c.TraceTypes, config.TraceTypeCannon)
+		c.Cannon.L2 = l2Endpoint
+		c.Cannon.Bin = "../cannon/bin/cannon"
+		c.Cannon.Server = "../op-program/bin/op-program"
+		c.Cannon.AbsolutePreState = "../op-program/bin/prestate.json"
+		c.Cannon.SnapshotFreq = 10_000_000
 
 		genesisBytes, err := json.Marshal(l2Genesis)
 		require.NoError(err, "marshall l2 genesis config")
 		genesisFile := filepath.Join(c.Datadir, "l2-genesis.json")
 		require.NoError(os.WriteFile(genesisFile, genesisBytes, 0644))
-		c.CannonL2GenesisPath = genesisFile
+		c.Cannon.L2GenesisPath = genesisFile
 
 		rollupBytes, err := json.Marshal(rollupCfg)
 		require.NoError(err, "marshall rollup config")
 		rollupFile := filepath.Join(c.Datadir, "rollup.json")
 		require.NoError(os.WriteFile(rollupFile, rollupBytes, 0644))
-		c.CannonRollupConfigPath = rollupFile
+		c.Cannon.RollupConfigPath = rollupFile
 	}
 }
 
@@ -120,7 +120,7 @@ func NewChallenger(t *testing.T, ctx context.Context, l1Endpoint string, name st
 
 func NewChallengerConfig(t *testing.T, l1Endpoint string, options ...Option) *config.Config {
 	// Use the NewConfig method to ensure we pick up any defaults that are set.
-	cfg := config.NewConfig(common.Address{}, l1Endpoint, config.TraceTypeAlphabet, true, t.TempDir())
+	cfg := config.NewConfig(common.Address{}, l1Endpoint, true, t.TempDir())
 	cfg.TxMgrConfig.NumConfirmations = 1
 	cfg.TxMgrConfig.ReceiptQueryInterval = 1 * time.Second
 	if cfg.MaxConcurrency > 4 {
@@ -133,16 +133,16 @@ func NewChallengerConfig(t *testing.T, l1Endpoint string, options ...Option) *co
 	require.NotEmpty(t, cfg.TxMgrConfig.PrivateKey, "Missing private key for TxMgrConfig")
 	require.NoError(t, cfg.Check(), "op-challenger config should be valid")
 
-	if cfg.CannonBin != "" {
-		_, err := os.Stat(cfg.CannonBin)
+	if cfg.Cannon.Bin != "" {
+		_, err := os.Stat(cfg.Cannon.Bin)
 		require.NoError(t, err, "cannon should be built. Make sure you've run make cannon-prestate")
 	}
-	if cfg.CannonServer != "" {
-		_, err := os.Stat(cfg.CannonServer)
+	if cfg.Cannon.Server != "" {
+		_, err := os.Stat(cfg.Cannon.Server)
 		require.NoError(t, err, "op-program should be built. Make sure you've run make cannon-prestate")
 	}
-	if cfg.CannonAbsolutePreState != "" {
-		_, err := os.Stat(cfg.CannonAbsolutePreState)
+	if cfg.Cannon.AbsolutePreState != "" {
+		_, err := os.Stat(cfg.Cannon.AbsolutePreState)
 		require.NoError(t, err, "cannon pre-state should be built. Make sure you've run make cannon-prestate")
 	}
 	return &cfg
@@ -19,10 +19,10 @@ func (g *AlphabetGameHelper) StartChallenger(ctx context.Context, l1Endpoint str
 		func(c *config.Config) {
 			c.GameFactoryAddress = g.factoryAddr
 			c.GameAllowlist = []common.Address{g.addr}
-			c.TraceType = config.TraceTypeAlphabet
+			c.TraceTypes = []config.TraceType{config.TraceTypeAlphabet}
 			// By default the challenger agrees with the root claim (thus disagrees with the proposed output)
 			// This can be overridden by passing in options
-			c.AlphabetTrace = g.claimedAlphabet
+			c.Alphabet.Trace = g.claimedAlphabet
 			c.AgreeWithProposedOutput = false
 		},
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/stretchr/testify/require"
 )
@@ -20,11 +21,11 @@ func (h *HonestHelper) Attack(ctx context.Context, claimIdx int64) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 	claim := h.game.getClaim(ctx, claimIdx)
-	pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+	pos := positions.NewPositionFromGIndex(claim.Position)
 	attackPos := pos.Attack()
 	traceIdx := attackPos.TraceIndex(int(h.game.MaxDepth(ctx)))
 	h.t.Logf("Attacking at position %v using correct trace from index %v", attackPos.ToGIndex(), traceIdx)
-	value, err := h.correctTrace.Get(ctx, traceIdx)
+	value, err := h.correctTrace.Get(ctx, traceIdx.Uint64())
 	h.require.NoErrorf(err, "Get correct claim at trace index %v", traceIdx)
 	h.t.Log("Performing attack")
 	h.game.Attack(ctx, claimIdx, value)
@@ -35,10 +36,10 @@ func (h *HonestHelper) Defend(ctx context.Context, claimIdx int64) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 	claim := h.game.getClaim(ctx, claimIdx)
-	pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+	pos := positions.NewPositionFromGIndex(claim.Position)
 	defendPos := pos.Defend()
 	traceIdx := defendPos.TraceIndex(int(h.game.MaxDepth(ctx)))
-	value, err := h.correctTrace.Get(ctx, traceIdx)
+	value, err := h.correctTrace.Get(ctx, traceIdx.Uint64())
 	h.game.require.NoErrorf(err, "Get correct claim at trace index %v", traceIdx)
 	h.game.Defend(ctx, claimIdx, value)
 }
@@ -0,0 +1,257 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/positions"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// Strategy determines how an actor in a Scenario plays the game.
+type Strategy int
+
+const (
+	// StrategyHonest actors run a challenger with the correct trace from the start of the game.
+	StrategyHonest Strategy = iota
+	// StrategyDishonest actors run a challenger with the actor's incorrect Alphabet from the start of the game.
+	StrategyDishonest
+	// StrategyLazy actors run a challenger with the correct trace, but only once started by a Wake step.
+	StrategyLazy
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case StrategyHonest:
+		return "honest"
+	case StrategyDishonest:
+		return "dishonest"
+	case StrategyLazy:
+		return "lazy"
+	default:
+		return fmt.Sprintf("unknown strategy: %v", int(s))
+	}
+}
+
+// Actor is a challenger taking part in a Scenario.
+// Each actor agrees with the root claim if its trace has the same value as the root claim at the last trace index.
+type Actor struct {
+	Name     string
+	Strategy Strategy
+	// Alphabet is the trace used by dishonest actors. Honest and lazy actors use CorrectAlphabet.
+	Alphabet string
+	// Key is the private key the actor's challenger sends transactions from. Each actor must use a different key.
+	Key *ecdsa.PrivateKey
+	// Options are applied to the actor's challenger config after the options set by the scenario.
+	Options []challenger.Option
+}
+
+func (a Actor) trace() string {
+	if a.Strategy == StrategyDishonest {
+		return a.Alphabet
+	}
+	return CorrectAlphabet
+}
+
+// Step is a single scripted action in a Scenario.
+type Step struct {
+	Description string
+	run         func(ctx context.Context, r *ScenarioRunner)
+}
+
+// Scenario is a reproducible alphabet game played by several actors.
+// The game is created with a root claim from RootClaimAlphabet, the non-lazy actors are started and then each step
+// is run in order. The scenario passes if the game resolves with the Expected status after the final step.
+type Scenario struct {
+	RootClaimAlphabet string
+	Actors            []Actor
+	Steps             []Step
+	Expected          Status
+}
+
+// ScenarioRunner plays a Scenario.
+type ScenarioRunner struct {
+	t          *testing.T
+	require    *require.Assertions
+	game       *AlphabetGameHelper
+	l1Endpoint string
+	clock      *clock.AdvancingClock
+	scenario   Scenario
+	actors     map[string]Actor
+	running    map[string]*challenger.Helper
+}
+
+// RunScenario creates a new alphabet game and plays the scenario against it, returning the game once it has
+// resolved with the expected status. cl must be the clock used by the L1 node, which steps advance to expire the
+// chess clocks of the game.
+func (h *FactoryHelper) RunScenario(ctx context.Context, l1Endpoint string, cl *clock.AdvancingClock, scenario Scenario) *AlphabetGameHelper {
+	h.require.NotNil(cl, "L1 time travel must be supported to run scenarios")
+	r := &ScenarioRunner{
+		t:          h.t,
+		require:    h.require,
+		l1Endpoint: l1Endpoint,
+		clock:      cl,
+		scenario:   scenario,
+		actors:     make(map[string]Actor),
+		running:    make(map[string]*challenger.Helper),
+	}
+	for _, actor := range scenario.Actors {
+		_, exists := r.actors[actor.Name]
+		h.require.Falsef(exists, "duplicate actor %v", actor.Name)
+		h.require.NotNilf(actor.Key, "actor %v has no private key", actor.Name)
+		r.actors[actor.Name] = actor
+	}
+
+	r.game = h.StartAlphabetGame(ctx, scenario.RootClaimAlphabet)
+	for _, actor := range scenario.Actors {
+		if actor.Strategy != StrategyLazy {
+			r.start(ctx, actor)
+		}
+	}
+	for i, step := range scenario.Steps {
+		h.t.Logf("Scenario step %v: %v", i, step.Description)
+		step.run(ctx, r)
+	}
+	r.game.WaitForGameStatus(ctx, scenario.Expected)
+	return r.game
+}
+
+func (r *ScenarioRunner) start(ctx context.Context, actor Actor) {
+	_, running := r.running[actor.Name]
+	r.require.Falsef(running, "actor %v is already running", actor.Name)
+	trace := actor.trace()
+	agreeWithRoot := r.rootClaim(ctx, trace) == r.rootClaim(ctx, r.scenario.RootClaimAlphabet)
+	r.t.Logf("Starting %v actor %v with trace %v, agreeing with root claim: %v", actor.Strategy, actor.Name, trace, agreeWithRoot)
+	opts := []challenger.Option{
+		func(c *config.Config) {
+			c.Alphabet.Trace = trace
+			c.AgreeWithProposedOutput = !agreeWithRoot
+		},
+		challenger.WithPrivKey(actor.Key),
+	}
+	opts = append(opts, actor.Options...)
+	r.running[actor.Name] = r.game.StartChallenger(ctx, r.l1Endpoint, actor.Name, opts...)
+}
+
+func (r *ScenarioRunner) stop(name string) {
+	helper, ok := r.running[name]
+	r.require.Truef(ok, "actor %v is not running", name)
+	r.require.NoErrorf(helper.Close(), "stop actor %v", name)
+	delete(r.running, name)
+}
+
+// rootClaim returns the value of the root claim for a game with the trace.
+func (r *ScenarioRunner) rootClaim(ctx context.Context, trace string) common.Hash {
+	value, err := alphabet.NewTraceProvider(trace, alphabetGameDepth).Get(ctx, lastAlphabetTraceIndex)
+	r.require.NoErrorf(err, "get root claim for trace %v", trace)
+	return value
+}
+
+// move returns the value from the trace for a claim at pos.
+func (r *ScenarioRunner) move(ctx context.Context, trace string, pos positions.Position) common.Hash {
+	traceIdx := pos.TraceIndex(alphabetGameDepth)
+	value, err := alphabet.NewTraceProvider(trace, alphabetGameDepth).Get(ctx, traceIdx.Uint64())
+	r.require.NoErrorf(err, "get claim from trace %v at trace index %v", trace, traceIdx)
+	return value
+}
+
+// Wake starts the challenger of a lazy actor.
+func Wake(name string) Step {
+	return Step{
+		Description: fmt.Sprintf("wake %v", name),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			actor, ok := r.actors[name]
+			r.require.Truef(ok, "unknown actor %v", name)
+			r.start(ctx, actor)
+		},
+	}
+}
+
+// Stop stops the challenger of an actor, so it no longer responds to claims.
+func Stop(name string) Step {
+	return Step{
+		Description: fmt.Sprintf("stop %v", name),
+		run: func(_ context.Context, r *ScenarioRunner) {
+			r.stop(name)
+		},
+	}
+}
+
+// WaitForClaims waits until the game has count claims.
+func WaitForClaims(count int64) Step {
+	return Step{
+		Description: fmt.Sprintf("wait for %v claims", count),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			r.game.WaitForClaimCount(ctx, count)
+		},
+	}
+}
+
+// WaitForClaimAtMaxDepth waits until the game has a claim at the maximum depth with the countered status.
+func WaitForClaimAtMaxDepth(countered bool) Step {
+	return Step{
+		Description: fmt.Sprintf("wait for claim at max depth with countered=%v", countered),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			r.game.WaitForClaimAtMaxDepth(ctx, countered)
+		},
+	}
+}
+
+// Attack attacks the claim at claimIdx from the test's own account, using the value from trace.
+func Attack(claimIdx int64, trace string) Step {
+	return Step{
+		Description: fmt.Sprintf("attack claim %v using trace %v", claimIdx, trace),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			claim := r.game.getClaim(ctx, claimIdx)
+			pos := positions.NewPositionFromGIndex(claim.Position).Attack()
+			r.game.Attack(ctx, claimIdx, r.move(ctx, trace, pos))
+		},
+	}
+}
+
+// Defend defends the claim at claimIdx from the test's own account, using the value from trace.
+func Defend(claimIdx int64, trace string) Step {
+	return Step{
+		Description: fmt.Sprintf("defend claim %v using trace %v", claimIdx, trace),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			claim := r.game.getClaim(ctx, claimIdx)
+			pos := positions.NewPositionFromGIndex(claim.Position).Defend()
+			r.game.Defend(ctx, claimIdx, r.move(ctx, trace, pos))
+		},
+	}
+}
+
+// AdvanceTime advances the L1 clock by d and waits for the next L1 block, so the new time is visible on chain.
+func AdvanceTime(d time.Duration) Step {
+	return Step{
+		Description: fmt.Sprintf("advance time by %v", d),
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			r.advanceTime(ctx, d)
+		},
+	}
+}
+
+// ExpireClocks advances the L1 clock by the game duration, expiring the chess clocks of every claim so the game can
+// be resolved.
+func ExpireClocks() Step {
+	return Step{
+		Description: "expire clocks",
+		run: func(ctx context.Context, r *ScenarioRunner) {
+			r.advanceTime(ctx, r.game.GameDuration(ctx))
+		},
+	}
+}
+
+func (r *ScenarioRunner) advanceTime(ctx context.Context, d time.Duration) {
+	r.clock.AdvanceTime(d)
+	r.require.NoError(wait.ForNextBlock(ctx, r.game.client), "wait for next L1 block")
+}
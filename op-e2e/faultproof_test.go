@@ -3,6 +3,7 @@ package op_e2e
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
@@ -239,6 +240,84 @@ func TestChallengerCompleteDisputeGame(t *testing.T) {
 	}
 }
 
+func TestDisputeGameScenarios(t *testing.T) {
+	InitParallel(t)
+
+	dishonestAlphabet := "abcdexyz"
+	tests := []struct {
+		name     string
+		scenario func(sys *System) disputegame.Scenario
+	}{
+		{
+			name: "HonestDefeatsDishonestProposer",
+			scenario: func(sys *System) disputegame.Scenario {
+				return disputegame.Scenario{
+					RootClaimAlphabet: dishonestAlphabet,
+					Actors: []disputegame.Actor{
+						{Name: "HonestAlice", Strategy: disputegame.StrategyHonest, Key: sys.cfg.Secrets.Alice},
+						{Name: "DishonestMallory", Strategy: disputegame.StrategyDishonest, Alphabet: dishonestAlphabet, Key: sys.cfg.Secrets.Mallory},
+					},
+					Steps: []disputegame.Step{
+						disputegame.WaitForClaimAtMaxDepth(true),
+						disputegame.ExpireClocks(),
+					},
+					Expected: disputegame.StatusChallengerWins,
+				}
+			},
+		},
+		{
+			name: "LazyHonestWakesBeforeClocksExpire",
+			scenario: func(sys *System) disputegame.Scenario {
+				return disputegame.Scenario{
+					RootClaimAlphabet: dishonestAlphabet,
+					Actors: []disputegame.Actor{
+						{Name: "DishonestMallory", Strategy: disputegame.StrategyDishonest, Alphabet: dishonestAlphabet, Key: sys.cfg.Secrets.Mallory},
+						{Name: "LazyBob", Strategy: disputegame.StrategyLazy, Key: sys.cfg.Secrets.Bob},
+					},
+					Steps: []disputegame.Step{
+						disputegame.AdvanceTime(time.Minute),
+						disputegame.Wake("LazyBob"),
+						disputegame.WaitForClaimAtMaxDepth(true),
+						disputegame.ExpireClocks(),
+					},
+					Expected: disputegame.StatusChallengerWins,
+				}
+			},
+		},
+		{
+			name: "HonestCountersScriptedAttack",
+			scenario: func(sys *System) disputegame.Scenario {
+				return disputegame.Scenario{
+					RootClaimAlphabet: disputegame.CorrectAlphabet,
+					Actors: []disputegame.Actor{
+						{Name: "HonestAlice", Strategy: disputegame.StrategyHonest, Key: sys.cfg.Secrets.Alice},
+					},
+					Steps: []disputegame.Step{
+						disputegame.Attack(0, dishonestAlphabet),
+						disputegame.WaitForClaims(3),
+						disputegame.Stop("HonestAlice"),
+						disputegame.ExpireClocks(),
+					},
+					Expected: disputegame.StatusDefenderWins,
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			InitParallel(t)
+
+			ctx := context.Background()
+			sys, l1Client := startFaultDisputeSystem(t)
+			t.Cleanup(sys.Close)
+
+			disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, l1Client)
+			disputeGameFactory.RunScenario(ctx, sys.NodeEndpoint("l1"), sys.TimeTravelClock, test.scenario(sys))
+		})
+	}
+}
+
 func TestCannonDisputeGame(t *testing.T) {
 	InitParallel(t)
 
@@ -112,7 +112,12 @@ func NewSimpleTxManager(name string, l log.Logger, m metrics.TxMetricer, cfg CLI
 	if err != nil {
 		return nil, err
 	}
+	return NewSimpleTxManagerFromConfig(name, l, m, conf), nil
+}
 
+// NewSimpleTxManagerFromConfig initializes a new SimpleTxManager with the passed Config, allowing the backend
+// created by NewConfig to be replaced.
+func NewSimpleTxManagerFromConfig(name string, l log.Logger, m metrics.TxMetricer, conf Config) *SimpleTxManager {
 	return &SimpleTxManager{
 		chainID: conf.ChainID,
 		name:    name,
@@ -120,7 +125,7 @@ func NewSimpleTxManager(name string, l log.Logger, m metrics.TxMetricer, cfg CLI
 		backend: conf.Backend,
 		l:       l.New("service", name),
 		metr:    m,
-	}, nil
+	}
 }
 
 func (m *SimpleTxManager) From() common.Address {
@@ -140,6 +145,87 @@ type TxCandidate struct {
 	To *common.Address
 	// GasLimit is the gas limit to be used in the constructed tx.
 	GasLimit uint64
+	// Value is the amount of wei to send with the constructed tx. Nil means no value.
+	Value *big.Int
+	// FeeMultiplier is the percentage of the suggested gas tip and base fee used to set the fees of the tx.
+	// Zero uses the suggested values unchanged.
+	FeeMultiplier uint64
+	// FeeLimitMultiplier is the maximum multiple of the suggested fees that fee bumps can reach.
+	// Zero uses the default limit.
+	FeeLimitMultiplier uint64
+	// ResubmissionTimeout is the interval at which the tx is resubmitted with bumped fees if it has not been mined.
+	// Zero uses the configured resubmission timeout.
+	ResubmissionTimeout time.Duration
+	// Escalator, if set, may escalate the fee settings of the tx while it remains unconfirmed and is notified of
+	// each version of the tx that is published.
+	Escalator Escalator
+}
+
+// Escalator escalates the sending of a transaction that remains unconfirmed beyond the fee settings of its candidate.
+type Escalator interface {
+	// Publishing is called as each version of the transaction is published, including fee bumped replacements.
+	Publishing(ctx context.Context, tx *types.Transaction)
+	// Escalate is called before the transaction is resubmitted with bumped fees and returns the fee settings to use
+	// from then on. Zero values keep the current settings.
+	Escalate(ctx context.Context) FeeOverride
+}
+
+// FeeOverride replaces the fee settings of a transaction that is being sent. Zero values keep the current settings.
+type FeeOverride struct {
+	FeeMultiplier       uint64
+	FeeLimitMultiplier  uint64
+	ResubmissionTimeout time.Duration
+}
+
+// txFees holds the fee settings used to send and bump a single transaction.
+type txFees struct {
+	multiplier          int64 // Percentage of the suggested fees to use
+	limitMultiplier     int64 // Maximum multiple of the suggested fees
+	resubmissionTimeout time.Duration
+	escalator           Escalator
+}
+
+// escalate applies the fee settings from the escalator, if any.
+func (f *txFees) escalate(ctx context.Context) {
+	if f.escalator == nil {
+		return
+	}
+	override := f.escalator.Escalate(ctx)
+	if override.FeeMultiplier != 0 {
+		f.multiplier = int64(override.FeeMultiplier)
+	}
+	if override.FeeLimitMultiplier != 0 {
+		f.limitMultiplier = int64(override.FeeLimitMultiplier)
+	}
+	if override.ResubmissionTimeout != 0 {
+		f.resubmissionTimeout = override.ResubmissionTimeout
+	}
+}
+
+// feesFor returns the fee settings for the candidate, falling back to the defaults for any unset values.
+func (m *SimpleTxManager) feesFor(candidate TxCandidate) txFees {
+	fees := txFees{
+		multiplier:          100,
+		limitMultiplier:     feeLimitMultiplier,
+		resubmissionTimeout: m.cfg.ResubmissionTimeout,
+		escalator:           candidate.Escalator,
+	}
+	if candidate.FeeMultiplier != 0 {
+		fees.multiplier = int64(candidate.FeeMultiplier)
+	}
+	if candidate.FeeLimitMultiplier != 0 {
+		fees.limitMultiplier = int64(candidate.FeeLimitMultiplier)
+	}
+	if candidate.ResubmissionTimeout != 0 {
+		fees.resubmissionTimeout = candidate.ResubmissionTimeout
+	}
+	return fees
+}
+
+// scale returns the value multiplied by the fee multiplier percentage.
+func (f txFees) scale(value *big.Int) *big.Int {
+	scaled := new(big.Int).Mul(value, big.NewInt(f.multiplier))
+	return scaled.Div(scaled, oneHundred)
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -186,7 +272,7 @@ func (m *SimpleTxManager) send(ctx context.Context, candidate TxCandidate) (*typ
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the tx: %w", err)
 	}
-	return m.sendTx(ctx, tx)
+	return m.sendTx(ctx, tx, m.feesFor(candidate))
 }
 
 // craftTx creates the signed transaction
@@ -200,6 +286,8 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 		m.metr.RPCError()
 		return nil, fmt.Errorf("failed to get gas price info: %w", err)
 	}
+	fees := m.feesFor(candidate)
+	gasTipCap, basefee = fees.scale(gasTipCap), fees.scale(basefee)
 	gasFeeCap := calcGasFeeCap(basefee, gasTipCap)
 
 	nonce, err := m.nextNonce(ctx)
@@ -214,6 +302,7 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 		GasTipCap: gasTipCap,
 		GasFeeCap: gasFeeCap,
 		Data:      candidate.TxData,
+		Value:     candidate.Value,
 	}
 
 	m.l.Info("Creating tx", "to", rawTx.To, "from", m.cfg.From)
@@ -229,6 +318,7 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 			GasFeeCap: gasFeeCap,
 			GasTipCap: gasTipCap,
 			Data:      rawTx.Data,
+			Value:     rawTx.Value,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas: %w", err)
@@ -277,7 +367,7 @@ func (m *SimpleTxManager) resetNonce() {
 
 // send submits the same transaction several times with increasing gas prices as necessary.
 // It waits for the transaction to be confirmed on chain.
-func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction, fees txFees) (*types.Receipt, error) {
 	var wg sync.WaitGroup
 	defer wg.Wait()
 	ctx, cancel := context.WithCancel(ctx)
@@ -287,6 +377,9 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 	receiptChan := make(chan *types.Receipt, 1)
 	sendTxAsync := func(tx *types.Transaction) {
 		defer wg.Done()
+		if fees.escalator != nil {
+			fees.escalator.Publishing(ctx, tx)
+		}
 		m.publishAndWaitForTx(ctx, tx, sendState, receiptChan)
 	}
 
@@ -294,7 +387,7 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 	wg.Add(1)
 	go sendTxAsync(tx)
 
-	ticker := time.NewTicker(m.cfg.ResubmissionTimeout)
+	ticker := time.NewTicker(fees.resubmissionTimeout)
 	defer ticker.Stop()
 
 	bumpCounter := 0
@@ -310,8 +403,15 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 				m.l.Warn("Aborting transaction submission")
 				return nil, errors.New("aborted transaction sending")
 			}
+			if fees.escalator != nil {
+				prevTimeout := fees.resubmissionTimeout
+				fees.escalate(ctx)
+				if fees.resubmissionTimeout != prevTimeout {
+					ticker.Reset(fees.resubmissionTimeout)
+				}
+			}
 			// Increase the gas price & submit the new transaction
-			newTx, err := m.increaseGasPrice(ctx, tx)
+			newTx, err := m.increaseGasPrice(ctx, tx, fees)
 			if err != nil || sendState.IsWaitingForConfirmation() {
 				// there is a chance the previous tx goes into "waiting for confirmation" state
 				// during the increaseGasPrice call. In some (but not all) cases increaseGasPrice
@@ -460,25 +560,25 @@ func (m *SimpleTxManager) queryReceipt(ctx context.Context, txHash common.Hash,
 
 // increaseGasPrice takes the previous transaction, clones it, and returns it with fee values that
 // are at least `priceBump` percent higher than the previous ones to satisfy Geth's replacement
-// rules, and no lower than the values returned by the fee suggestion algorithm to ensure it
-// doesn't linger in the mempool. Finally to avoid runaway price increases, fees are capped at a
-// `feeLimitMultiplier` multiple of the suggested values.
-func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+// rules, and no lower than the values returned by the fee suggestion algorithm, scaled by the fee
+// multiplier, to ensure it doesn't linger in the mempool. Finally to avoid runaway price increases,
+// fees are capped at the fee limit multiple of the suggested values.
+func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction, fees txFees) (*types.Transaction, error) {
 	m.l.Info("bumping gas price for tx", "hash", tx.Hash(), "tip", tx.GasTipCap(), "fee", tx.GasFeeCap(), "gaslimit", tx.Gas())
 	tip, basefee, err := m.suggestGasPriceCaps(ctx)
 	if err != nil {
 		m.l.Warn("failed to get suggested gas tip and basefee", "err", err)
 		return nil, err
 	}
-	bumpedTip, bumpedFee := updateFees(tx.GasTipCap(), tx.GasFeeCap(), tip, basefee, m.l)
+	bumpedTip, bumpedFee := updateFees(tx.GasTipCap(), tx.GasFeeCap(), fees.scale(tip), fees.scale(basefee), m.l)
 
-	// Make sure increase is at most 5x the suggested values
-	maxTip := new(big.Int).Mul(tip, big.NewInt(feeLimitMultiplier))
+	// Make sure increase is at most the fee limit multiple of the suggested values
+	maxTip := new(big.Int).Mul(tip, big.NewInt(fees.limitMultiplier))
 	if bumpedTip.Cmp(maxTip) > 0 {
-		m.l.Warn(fmt.Sprintf("bumped tip getting capped at %dx multiple of the suggested value", feeLimitMultiplier), "bumped", bumpedTip, "suggestion", tip)
+		m.l.Warn(fmt.Sprintf("bumped tip getting capped at %dx multiple of the suggested value", fees.limitMultiplier), "bumped", bumpedTip, "suggestion", tip)
 		bumpedTip.Set(maxTip)
 	}
-	maxFee := calcGasFeeCap(new(big.Int).Mul(basefee, big.NewInt(feeLimitMultiplier)), maxTip)
+	maxFee := calcGasFeeCap(new(big.Int).Mul(basefee, big.NewInt(fees.limitMultiplier)), maxTip)
 	if bumpedFee.Cmp(maxFee) > 0 {
 		m.l.Warn("bumped fee getting capped at multiple of the implied suggested value", "bumped", bumpedFee, "suggestion", maxFee)
 		bumpedFee.Set(maxFee)
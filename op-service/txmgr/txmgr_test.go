@@ -282,7 +282,7 @@ func TestTxMgrConfirmAtMinGasPrice(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -310,7 +310,7 @@ func TestTxMgrNeverConfirmCancel(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
@@ -339,12 +339,74 @@ func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
 }
 
+// TestTxMgrEscalation asserts that the escalator is notified of each published tx and that its fee settings are
+// used for resubmissions.
+func TestTxMgrEscalation(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+
+	gasTipCap, gasFeeCap := h.gasPricer.sample()
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	})
+	escalator := &stubEscalator{override: FeeOverride{FeeMultiplier: 300, ResubmissionTimeout: 100 * time.Millisecond}}
+	sendTx := func(ctx context.Context, tx *types.Transaction) error {
+		// Only mine the first resubmission
+		if escalator.publishedCount() == 2 {
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap())
+		}
+		return nil
+	}
+	h.backend.setTxSender(sendTx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{Escalator: escalator}))
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.GreaterOrEqual(t, escalator.escalations, 1)
+	require.GreaterOrEqual(t, len(escalator.published), 2)
+	require.Equal(t, tx.Hash(), escalator.published[0].Hash())
+	require.GreaterOrEqual(t, escalator.published[1].GasTipCap().Cmp(new(big.Int).Mul(gasTipCap, big.NewInt(3))), 0,
+		"should use the escalated fee multiplier")
+}
+
+type stubEscalator struct {
+	mu          sync.Mutex
+	override    FeeOverride
+	escalations int
+	published   []*types.Transaction
+}
+
+func (s *stubEscalator) Publishing(_ context.Context, tx *types.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.published = append(s.published, tx)
+}
+
+func (s *stubEscalator) Escalate(_ context.Context) FeeOverride {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.escalations++
+	return s.override
+}
+
+func (s *stubEscalator) publishedCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.published)
+}
+
 // errRpcFailure is a sentinel error used in testing to fail publications.
 var errRpcFailure = errors.New("rpc failure")
 
@@ -370,7 +432,7 @@ func TestTxMgrBlocksOnFailingRpcCalls(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
@@ -420,6 +482,52 @@ func TestTxMgr_EstimateGas(t *testing.T) {
 	require.Equal(t, gasEstimate, tx.Gas())
 }
 
+// TestTxMgr_CraftTxWithValue ensures that the tx manager includes the
+// candidate value in the crafted transaction.
+func TestTxMgr_CraftTxWithValue(t *testing.T) {
+	t.Parallel()
+	h := newTestHarness(t)
+	candidate := h.createTxCandidate()
+	candidate.Value = big.NewInt(1234)
+
+	tx, err := h.mgr.craftTx(context.Background(), candidate)
+	require.Nil(t, err)
+	require.NotNil(t, tx)
+	require.Equal(t, big.NewInt(1234), tx.Value())
+}
+
+// TestTxMgr_CraftTxWithFeeMultiplier ensures that the tx manager scales the suggested
+// fees by the candidate fee multiplier.
+func TestTxMgr_CraftTxWithFeeMultiplier(t *testing.T) {
+	t.Parallel()
+	h := newTestHarness(t)
+	candidate := h.createTxCandidate()
+	candidate.FeeMultiplier = 200
+
+	gasTipCap, gasFeeCap := h.gasPricer.feesForEpoch(h.gasPricer.epoch + 1)
+	tx, err := h.mgr.craftTx(context.Background(), candidate)
+	require.Nil(t, err)
+	require.Equal(t, new(big.Int).Mul(gasTipCap, big.NewInt(2)), tx.GasTipCap())
+	require.Equal(t, new(big.Int).Mul(gasFeeCap, big.NewInt(2)), tx.GasFeeCap())
+}
+
+// TestTxMgr_FeesFor ensures that unset candidate fee settings use the defaults.
+func TestTxMgr_FeesFor(t *testing.T) {
+	t.Parallel()
+	h := newTestHarness(t)
+	require.Equal(t, txFees{
+		multiplier:          100,
+		limitMultiplier:     feeLimitMultiplier,
+		resubmissionTimeout: h.cfg.ResubmissionTimeout,
+	}, h.mgr.feesFor(TxCandidate{}))
+
+	require.Equal(t, txFees{
+		multiplier:          150,
+		limitMultiplier:     10,
+		resubmissionTimeout: time.Minute,
+	}, h.mgr.feesFor(TxCandidate{FeeMultiplier: 150, FeeLimitMultiplier: 10, ResubmissionTimeout: time.Minute}))
+}
+
 // TestTxMgrOnlyOnePublicationSucceeds asserts that the tx manager will return a
 // receipt so long as at least one of the publications is able to succeed with a
 // simulated rpc failure.
@@ -448,7 +556,7 @@ func TestTxMgrOnlyOnePublicationSucceeds(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Nil(t, err)
 
 	require.NotNil(t, receipt)
@@ -483,7 +591,7 @@ func TestTxMgrConfirmsMinGasPriceAfterBumping(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -528,7 +636,7 @@ func TestTxMgrDoesntAbortNonceTooLowAfterMiningTx(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, h.mgr.feesFor(TxCandidate{}))
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -743,7 +851,7 @@ func doGasPriceIncrease(t *testing.T, txTipCap, txFeeCap, newTip, newBaseFee int
 		GasTipCap: big.NewInt(txTipCap),
 		GasFeeCap: big.NewInt(txFeeCap),
 	})
-	newTx, err := mgr.increaseGasPrice(context.Background(), tx)
+	newTx, err := mgr.increaseGasPrice(context.Background(), tx, mgr.feesFor(TxCandidate{}))
 	require.NoError(t, err)
 	return tx, newTx
 }
@@ -848,7 +956,7 @@ func TestIncreaseGasPriceNotExponential(t *testing.T) {
 	var err error
 	for i := 0; i < 30; i++ {
 		ctx := context.Background()
-		tx, err = mgr.increaseGasPrice(ctx, tx)
+		tx, err = mgr.increaseGasPrice(ctx, tx, mgr.feesFor(TxCandidate{}))
 		require.NoError(t, err)
 	}
 	lastTip, lastFee := tx.GasTipCap(), tx.GasFeeCap()
@@ -857,13 +965,44 @@ func TestIncreaseGasPriceNotExponential(t *testing.T) {
 	// Confirm that fees stop rising
 	for i := 0; i < 5; i++ {
 		ctx := context.Background()
-		tx, err := mgr.increaseGasPrice(ctx, tx)
+		tx, err := mgr.increaseGasPrice(ctx, tx, mgr.feesFor(TxCandidate{}))
 		require.NoError(t, err)
 		require.True(t, tx.GasTipCap().Cmp(lastTip) == 0, "suggested tx tip must stop increasing")
 		require.True(t, tx.GasFeeCap().Cmp(lastFee) == 0, "suggested tx fee must stop increasing")
 	}
 }
 
+// TestIncreaseGasPriceCustomLimit asserts that fee bumps are capped at the fee limit multiplier of the candidate.
+func TestIncreaseGasPriceCustomLimit(t *testing.T) {
+	t.Parallel()
+
+	borkedTip := int64(10)
+	borkedFee := int64(45)
+	mgr := &SimpleTxManager{
+		cfg: Config{
+			Signer: func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return tx, nil
+			},
+		},
+		name:    "TEST",
+		backend: &failingBackend{gasTip: big.NewInt(borkedTip), baseFee: big.NewInt(borkedFee)},
+		l:       testlog.Logger(t, log.LvlCrit),
+		metr:    &metrics.NoopTxMetrics{},
+	}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: big.NewInt(10),
+		GasFeeCap: big.NewInt(100),
+	})
+	fees := mgr.feesFor(TxCandidate{FeeLimitMultiplier: 2})
+	var err error
+	for i := 0; i < 30; i++ {
+		tx, err = mgr.increaseGasPrice(context.Background(), tx, fees)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2*borkedTip, tx.GasTipCap().Int64())
+	require.Equal(t, 2*(borkedTip+2*borkedFee), tx.GasFeeCap().Int64())
+}
+
 func TestErrStringMatch(t *testing.T) {
 	tests := []struct {
 		err    error